@@ -0,0 +1,45 @@
+package memorable_ids
+
+import (
+	"io"
+	"math/rand"
+	"sync"
+)
+
+// AppendTo appends a generated ID to dst and returns the extended buffer,
+// so logging and serialization paths can emit IDs without an intermediate
+// string. On error, dst is returned unchanged.
+//
+// Example:
+//
+//	buf := []byte("user=")
+//	buf, _ = AppendTo(buf, GenerateOptions{Suffix: SuffixGenerators.Number})
+//	// "user=cute-rabbit-042"
+func AppendTo(dst []byte, options GenerateOptions) ([]byte, error) {
+	return appendGenerate(dst, GetDictionary(), options, rand.Intn)
+}
+
+// writeBuffers pools the buffers WriteTo generates into
+var writeBuffers = sync.Pool{
+	New: func() any {
+		buf := make([]byte, 0, 64)
+		return &buf
+	},
+}
+
+// WriteTo writes a generated ID to w and returns the number of bytes written
+//
+// Example:
+//
+//	WriteTo(os.Stdout, GenerateOptions{Components: 3}) // prints "large-fox-swim"
+func WriteTo(w io.Writer, options GenerateOptions) (int, error) {
+	buf := writeBuffers.Get().(*[]byte)
+	defer writeBuffers.Put(buf)
+
+	id, err := AppendTo((*buf)[:0], options)
+	*buf = id
+	if err != nil {
+		return 0, err
+	}
+	return w.Write(id)
+}
@@ -0,0 +1,75 @@
+package memorable_ids
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// errWriter is an io.Writer that always fails
+type errWriter struct{}
+
+func (errWriter) Write([]byte) (int, error) { return 0, errors.New("closed") }
+
+func TestAppendTo(t *testing.T) {
+	t.Run("should append an ID after existing content", func(t *testing.T) {
+		buf, err := AppendTo([]byte("user="), GenerateOptions{Components: 3, Suffix: SuffixGenerators.Number})
+		require.NoError(t, err)
+
+		id, found := strings.CutPrefix(string(buf), "user=")
+		require.True(t, found)
+		parsed := Parse(id, "-")
+		assert.NotNil(t, parsed.Suffix)
+		_, err = splitClassWords(GetDictionary(), strings.Join(parsed.Components, "-"), "-", wordClasses[:3])
+		assert.NoError(t, err, id)
+	})
+
+	t.Run("should use the separator", func(t *testing.T) {
+		buf, err := AppendTo(nil, GenerateOptions{Components: 2, Separator: "::"})
+		require.NoError(t, err)
+		assert.Len(t, strings.Split(string(buf), "::"), 2)
+	})
+
+	t.Run("should leave dst unchanged on error", func(t *testing.T) {
+		buf, err := AppendTo([]byte("user="), GenerateOptions{Components: 7})
+		assert.Error(t, err)
+		assert.Equal(t, "user=", string(buf))
+	})
+
+	t.Run("should not allocate with enough capacity", func(t *testing.T) {
+		buf := make([]byte, 0, 128)
+		allocs := testing.AllocsPerRun(100, func() {
+			buf, _ = AppendTo(buf[:0], GenerateOptions{Components: 3})
+		})
+		assert.Zero(t, allocs)
+	})
+}
+
+func TestWriteTo(t *testing.T) {
+	t.Run("should write an ID", func(t *testing.T) {
+		var out bytes.Buffer
+		n, err := WriteTo(&out, GenerateOptions{Suffix: SuffixGenerators.Hex})
+		require.NoError(t, err)
+		assert.Equal(t, out.Len(), n)
+		assert.Regexp(t, `^[a-z]+-[a-z]+(-pig)?-[0-9a-f]{2}$`, out.String())
+	})
+
+	t.Run("should report errors", func(t *testing.T) {
+		_, err := WriteTo(errWriter{}, GenerateOptions{})
+		assert.ErrorContains(t, err, "closed")
+
+		_, err = WriteTo(&bytes.Buffer{}, GenerateOptions{Components: 9})
+		assert.Error(t, err)
+	})
+}
+
+func BenchmarkAppendTo(b *testing.B) {
+	buf := make([]byte, 0, 64)
+	for i := 0; i < b.N; i++ {
+		buf, _ = AppendTo(buf[:0], GenerateOptions{Components: 3})
+	}
+}
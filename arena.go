@@ -0,0 +1,85 @@
+package memorable_ids
+
+import (
+	"sync"
+	"unsafe"
+)
+
+// arenaChunkSize is the size of the buffers arena strings are carved from
+const arenaChunkSize = 64 << 10
+
+// arenaChunks pools released arena buffers for reuse by later batches
+var arenaChunks = sync.Pool{
+	New: func() any {
+		chunk := make([]byte, 0, arenaChunkSize)
+		return &chunk
+	},
+}
+
+// arena hands out strings backed by a few large buffers instead of one
+// allocation per string
+type arena struct {
+	chunks []*[]byte
+}
+
+// intern copies b into the arena and returns a string referring to the copy
+func (a *arena) intern(b []byte) string {
+	if len(b) == 0 {
+		return ""
+	}
+	if len(a.chunks) == 0 || cap(*a.chunks[len(a.chunks)-1])-len(*a.chunks[len(a.chunks)-1]) < len(b) {
+		chunk := arenaChunks.Get().(*[]byte)
+		if cap(*chunk) < len(b) {
+			grown := make([]byte, 0, len(b))
+			chunk = &grown
+		}
+		a.chunks = append(a.chunks, chunk)
+	}
+
+	// Appending within capacity never moves earlier strings
+	chunk := a.chunks[len(a.chunks)-1]
+	start := len(*chunk)
+	*chunk = append(*chunk, b...)
+	return unsafe.String(&(*chunk)[start], len(b))
+}
+
+// release returns the arena's buffers to the pool
+func (a *arena) release() {
+	for _, chunk := range a.chunks {
+		if cap(*chunk) == arenaChunkSize {
+			*chunk = (*chunk)[:0]
+			arenaChunks.Put(chunk)
+		}
+	}
+	a.chunks = nil
+}
+
+// GenerateNArena creates n memorable IDs like GenerateN, but carves all
+// of them out of a few large shared buffers instead of allocating every
+// string separately, substantially reducing GC pressure in data-generation
+// jobs. Call release once the IDs are no longer needed: it recycles the
+// buffers for later batches, and the IDs must not be used afterwards, so
+// copy any that outlive the batch with strings.Clone. Unlike GenerateN,
+// no partial batch is returned on error.
+//
+// Example:
+//
+//	ids, release, err := GenerateNArena(1_000_000, GenerateOptions{Components: 3}, BatchOptions{})
+//	if err != nil {
+//	  return err
+//	}
+//	defer release()
+//	writeFixtures(ids)
+func GenerateNArena(n int, options GenerateOptions, batch BatchOptions) ([]string, func(), error) {
+	a := &arena{}
+	var once sync.Once
+	release := func() { once.Do(a.release) }
+
+	next := func(dst []byte) ([]byte, error) { return AppendTo(dst, options) }
+	ids, err := generateBatch(n, options, batch, next, a.intern)
+	if err != nil {
+		release()
+		return nil, func() {}, err
+	}
+	return ids, release, nil
+}
@@ -0,0 +1,76 @@
+package memorable_ids
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateNArena(t *testing.T) {
+	t.Run("should generate valid IDs", func(t *testing.T) {
+		ids, release, err := GenerateNArena(10000, GenerateOptions{Components: 3, Suffix: SuffixGenerators.Number}, BatchOptions{})
+		require.NoError(t, err)
+		defer release()
+
+		require.Len(t, ids, 10000)
+		for _, id := range ids {
+			parsed := Parse(id, "-")
+			require.NotNil(t, parsed.Suffix, id)
+			_, err := splitClassWords(GetDictionary(), strings.Join(parsed.Components, "-"), "-", wordClasses[:3])
+			require.NoError(t, err, id)
+		}
+	})
+
+	t.Run("should honour batch constraints", func(t *testing.T) {
+		ids, release, err := GenerateNArena(500, GenerateOptions{}, BatchOptions{Unique: true})
+		require.NoError(t, err)
+		defer release()
+
+		seen := make(map[string]struct{}, len(ids))
+		for _, id := range ids {
+			seen[id] = struct{}{}
+		}
+		assert.Len(t, seen, 500)
+	})
+
+	t.Run("should reuse released buffers", func(t *testing.T) {
+		first, release, err := GenerateNArena(100, GenerateOptions{}, BatchOptions{})
+		require.NoError(t, err)
+		kept := strings.Clone(first[0])
+		release()
+		release()
+
+		second, releaseSecond, err := GenerateNArena(100, GenerateOptions{}, BatchOptions{})
+		require.NoError(t, err)
+		defer releaseSecond()
+		assert.Len(t, second, 100)
+		assert.NotEmpty(t, kept)
+	})
+
+	t.Run("should allocate far less than GenerateN", func(t *testing.T) {
+		options := GenerateOptions{Components: 3}
+		arenaAllocs := testing.AllocsPerRun(5, func() {
+			_, release, _ := GenerateNArena(1000, options, BatchOptions{})
+			release()
+		})
+		plainAllocs := testing.AllocsPerRun(5, func() {
+			GenerateN(1000, options, BatchOptions{})
+		})
+		assert.Less(t, arenaAllocs*10, plainAllocs)
+	})
+
+	t.Run("should report errors", func(t *testing.T) {
+		_, release, err := GenerateNArena(10, GenerateOptions{Components: 8}, BatchOptions{})
+		assert.Error(t, err)
+		release()
+	})
+}
+
+func BenchmarkGenerateNArena(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_, release, _ := GenerateNArena(10000, GenerateOptions{Components: 3}, BatchOptions{})
+		release()
+	}
+}
@@ -0,0 +1,124 @@
+package memorable_ids
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditedGenerator(t *testing.T) {
+	t.Run("should record every issued ID", func(t *testing.T) {
+		var records []AuditRecord
+		sink := AuditSinkFunc(func(record AuditRecord) error {
+			records = append(records, record)
+			return nil
+		})
+
+		gen, err := NewAuditedGenerator(GenerateOptions{Components: 3, Suffix: SuffixGenerators.Hex}, 42, sink)
+		require.NoError(t, err)
+
+		var ids []string
+		for range 5 {
+			id, err := gen.Generate()
+			require.NoError(t, err)
+			ids = append(ids, id)
+		}
+
+		require.Len(t, records, 5)
+		for i, record := range records {
+			assert.Equal(t, int64(42), record.Seed)
+			assert.Equal(t, i, record.Position)
+			assert.Equal(t, 3, record.Components)
+			assert.Equal(t, "-", record.Separator)
+			assert.Equal(t, "hex", record.Suffix)
+			assert.Equal(t, ids[i], record.ID)
+			assert.False(t, record.Time.IsZero())
+		}
+	})
+
+	t.Run("should replay the exact sequence from any record", func(t *testing.T) {
+		var records []AuditRecord
+		sink := AuditSinkFunc(func(record AuditRecord) error {
+			records = append(records, record)
+			return nil
+		})
+		gen, err := NewAuditedGenerator(GenerateOptions{Suffix: SuffixGenerators.Number, Separator: "_"}, 7, sink)
+		require.NoError(t, err)
+		for range 20 {
+			_, err := gen.Generate()
+			require.NoError(t, err)
+		}
+
+		replayed, err := Replay(records[0], 20)
+		require.NoError(t, err)
+		for i, record := range records {
+			assert.Equal(t, record.ID, replayed[i])
+		}
+
+		tail, err := Replay(records[15], 5)
+		require.NoError(t, err)
+		assert.Equal(t, replayed[15:], tail)
+	})
+
+	t.Run("should generate and replay pattern IDs", func(t *testing.T) {
+		var records []AuditRecord
+		sink := AuditSinkFunc(func(record AuditRecord) error {
+			records = append(records, record)
+			return nil
+		})
+		gen, err := NewAuditedGenerator(GenerateOptions{Pattern: "noun-verb", Suffix: SuffixGenerators.Letter}, 9, sink)
+		require.NoError(t, err)
+		for range 10 {
+			id, err := gen.Generate()
+			require.NoError(t, err)
+			parsed, err := ParseStrict(id, ParseOptions{Pattern: "noun-verb", Suffix: SuffixGenerators.Letter})
+			require.NoError(t, err, id)
+			assert.Len(t, parsed.Components, 2)
+		}
+
+		assert.Equal(t, "noun-verb", records[0].Pattern)
+		replayed, err := Replay(records[3], 7)
+		require.NoError(t, err)
+		for i, record := range records[3:] {
+			assert.Equal(t, record.ID, replayed[i])
+		}
+
+		_, err = NewAuditedGenerator(GenerateOptions{Pattern: "noun-thing"}, 1, nil)
+		assert.Error(t, err)
+	})
+
+	t.Run("should use the current time for a zero seed", func(t *testing.T) {
+		gen, err := NewAuditedGenerator(GenerateOptions{}, 0, nil)
+		require.NoError(t, err)
+		assert.NotZero(t, gen.Seed())
+	})
+
+	t.Run("should reject suffixes that can't be replayed", func(t *testing.T) {
+		_, err := NewAuditedGenerator(GenerateOptions{Suffix: SuffixGenerators.Timestamp}, 1, nil)
+		assert.ErrorIs(t, err, ErrNotReplayable)
+
+		_, err = NewAuditedGenerator(GenerateOptions{Suffix: func() *string { return nil }}, 1, nil)
+		assert.ErrorIs(t, err, ErrNotReplayable)
+
+		_, err = Replay(AuditRecord{Components: 2, Separator: "-", Suffix: "timestamp"}, 1)
+		assert.ErrorIs(t, err, ErrNotReplayable)
+	})
+
+	t.Run("should reject dictionary overrides", func(t *testing.T) {
+		space := NewDictionary([]string{"stellar"}, []string{"comet"}, nil, nil, nil)
+		_, err := NewAuditedGenerator(GenerateOptions{Dictionary: &space}, 1, nil)
+		assert.ErrorIs(t, err, ErrNotReplayable)
+	})
+
+	t.Run("should report sink failures", func(t *testing.T) {
+		gen, err := NewAuditedGenerator(GenerateOptions{}, 1, AuditSinkFunc(func(AuditRecord) error {
+			return errors.New("disk full")
+		}))
+		require.NoError(t, err)
+
+		_, err = gen.Generate()
+		assert.ErrorContains(t, err, "disk full")
+	})
+}
@@ -0,0 +1,263 @@
+package memorable_ids
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+)
+
+// ErrBatchExhausted is returned when a batch can't be completed within the retry budget
+var ErrBatchExhausted = errors.New("could not generate enough IDs satisfying the batch constraints")
+
+// BatchOptions contains constraints applied across all IDs of a batch
+type BatchOptions struct {
+	// Unique guarantees that no ID appears twice in the batch (default: false)
+	Unique bool
+	// MinEditDistance is the minimum Levenshtein distance between any two IDs (default: 0, disabled)
+	MinEditDistance int
+	// MinWordDistance is the minimum number of words not shared between any two IDs (default: 0, disabled)
+	MinWordDistance int
+	// MaxAttempts is the number of re-rolls per ID before giving up (default: 100)
+	MaxAttempts int
+}
+
+// GenerateN creates n memorable IDs, re-rolling any candidate that is
+// too close to an ID already in the batch
+//
+// Example:
+//
+//	// 16 tournament codes that differ in at least 2 words from each other
+//	GenerateN(16, GenerateOptions{Components: 3}, BatchOptions{MinWordDistance: 2})
+//
+//	// IDs at least 3 edits apart
+//	GenerateN(10, GenerateOptions{}, BatchOptions{MinEditDistance: 3})
+//
+//	// 500 distinct IDs, failing upfront if the space is too small
+//	GenerateN(500, GenerateOptions{Components: 2}, BatchOptions{Unique: true})
+func GenerateN(n int, options GenerateOptions, batch BatchOptions) ([]string, error) {
+	next := func(dst []byte) ([]byte, error) { return AppendTo(dst, options) }
+	return generateBatch(n, options, batch, next, func(id []byte) string { return string(id) })
+}
+
+// GenerateBatch creates n memorable IDs in one call. Words and built-in
+// suffixes are drawn from a batch-local source seeded once, so the batch
+// takes the package-level RNG lock a single time instead of per ID, and
+// the output slice is allocated upfront. Use GenerateBatchUnique to
+// deduplicate the batch, or GenerateN for distance constraints.
+//
+// Example:
+//
+//	GenerateBatch(3, GenerateOptions{Suffix: SuffixGenerators.Number})
+//	// ["cute-rabbit-042", "large-fox-317", "warm-duck-908"], nil
+func GenerateBatch(n int, options GenerateOptions) ([]string, error) {
+	return generateBatchLocal(n, options, BatchOptions{})
+}
+
+// GenerateBatchUnique creates n distinct memorable IDs like GenerateBatch,
+// failing upfront with ErrBatchExhausted if the options can't produce n
+// distinct IDs. Batches needing more than half of the ID space are drawn
+// without replacement, so they complete even up to the full capacity.
+//
+// Example:
+//
+//	GenerateBatchUnique(500, GenerateOptions{}) // 500 distinct IDs, nil
+func GenerateBatchUnique(n int, options GenerateOptions) ([]string, error) {
+	return generateBatchLocal(n, options, BatchOptions{Unique: true})
+}
+
+// generateBatchLocal implements GenerateBatch with a batch-local source
+func generateBatchLocal(n int, options GenerateOptions, batch BatchOptions) ([]string, error) {
+	if batch.Unique {
+		if ids, ok := sampleUnique(n, options); ok {
+			return ids, nil
+		}
+	}
+	return generateBatch(n, options, batch, localAppender(options), func(id []byte) string { return string(id) })
+}
+
+// sampleUnique draws n distinct IDs without replacement by shuffling the
+// indexes of the whole ID space, the word combination varying fastest and
+// the suffix slowest. Re-rolling duplicates stalls once most of the space
+// is taken, so this is used when the batch needs more than half of it;
+// it reports false otherwise, or when the suffix can't be indexed.
+func sampleUnique(n int, options GenerateOptions) ([]string, bool) {
+	capacity, ok := batchCapacity(options)
+	if !ok || n > capacity || n*2 <= capacity || capacity == math.MaxInt {
+		return nil, false
+	}
+
+	var draw func(intn func(int) int) string
+	suffixRange := 1
+	if options.hasSuffix() {
+		spec, ok := DescribeSuffix(options.Suffix)
+		if !ok || options.SuffixProvider != nil || replayableSuffixes[spec.Name] == nil {
+			return nil, false
+		}
+		draw, suffixRange = replayableSuffixes[spec.Name], spec.Range
+	}
+
+	classes, err := options.classes()
+	if err != nil {
+		return nil, false
+	}
+	separator := options.Separator
+	if separator == "" {
+		separator = "-"
+	}
+
+	dict := options.dictionary()
+	words := capacity / suffixRange
+	rng := rand.New(rand.NewSource(rand.Int63()))
+	ids := make([]string, n)
+	id := make([]byte, 0, 64)
+	for i, index := range rng.Perm(capacity)[:n] {
+		id = appendWords(id[:0], dict, classes, separator, index%words)
+		if draw != nil {
+			// A source always returning the suffix index draws that suffix
+			id = append(append(id, separator...), draw(func(int) int { return index / words })...)
+		}
+		ids[i] = string(id)
+	}
+	return ids, true
+}
+
+// localAppender returns a function appending IDs drawn from a new local
+// source seeded once from the package-level one. It is not safe for
+// concurrent use.
+func localAppender(options GenerateOptions) func(dst []byte) ([]byte, error) {
+	intn := rand.New(rand.NewSource(rand.Int63())).Intn
+	dict := GetDictionary()
+	next := func(dst []byte) ([]byte, error) {
+		return appendGenerate(dst, dict, options, intn)
+	}
+
+	// Draw built-in suffixes from the batch source too
+	if spec, ok := DescribeSuffix(options.Suffix); ok && options.SuffixProvider == nil && replayableSuffixes[spec.Name] != nil {
+		draw := replayableSuffixes[spec.Name]
+		words := options
+		words.Suffix = nil
+		separator := options.Separator
+		if separator == "" {
+			separator = "-"
+		}
+		next = func(dst []byte) ([]byte, error) {
+			dst, err := appendGenerate(dst, dict, words, intn)
+			if err != nil {
+				return dst, err
+			}
+			return append(append(dst, separator...), draw(intn)...), nil
+		}
+	}
+	return next
+}
+
+// generateBatch implements GenerateN, appending candidates with next and
+// turning accepted ones into strings with intern
+func generateBatch(n int, options GenerateOptions, batch BatchOptions, next func(dst []byte) ([]byte, error), intern func(id []byte) string) ([]string, error) {
+	if n < 0 {
+		return nil, errors.New("n must not be negative")
+	}
+	if batch.MaxAttempts < 1 {
+		batch.MaxAttempts = 100
+	}
+
+	var seen map[string]struct{}
+	if batch.Unique {
+		if capacity, ok := batchCapacity(options); ok && capacity < n {
+			return nil, fmt.Errorf("%w: only %d combinations for %d unique IDs", ErrBatchExhausted, capacity, n)
+		}
+		seen = make(map[string]struct{}, n)
+	}
+
+	ids := make([]string, 0, n)
+	candidate := make([]byte, 0, 64)
+	for len(ids) < n {
+		accepted := false
+		for attempt := 0; attempt < batch.MaxAttempts; attempt++ {
+			var err error
+			candidate, err = next(candidate[:0])
+			if err != nil {
+				return nil, err
+			}
+			if _, duplicate := seen[string(candidate)]; duplicate {
+				continue
+			}
+			if !batch.checksDistance() || batch.farEnough(string(candidate), ids, options.Separator) {
+				id := intern(candidate)
+				if seen != nil {
+					seen[id] = struct{}{}
+				}
+				ids = append(ids, id)
+				accepted = true
+				break
+			}
+		}
+		if !accepted {
+			return ids, fmt.Errorf("%w: generated %d of %d", ErrBatchExhausted, len(ids), n)
+		}
+	}
+
+	return ids, nil
+}
+
+// batchCapacity returns the number of distinct IDs the options can produce,
+// if known. Custom suffix generators make the capacity unknown.
+func batchCapacity(options GenerateOptions) (int, bool) {
+	suffixRange := 1
+	if options.hasSuffix() {
+		info, ok := options.suffixInfo()
+		if !ok {
+			return 0, false
+		}
+		suffixRange = info.rangeSize
+	}
+
+	total, err := options.combinations(suffixRange)
+	if err != nil {
+		return 0, false
+	}
+	return total, true
+}
+
+// checksDistance reports whether any distance constraint is enabled
+func (b BatchOptions) checksDistance() bool {
+	return b.MinEditDistance > 0 || b.MinWordDistance > 0
+}
+
+// farEnough reports whether id keeps the required distance to every ID in ids
+func (b BatchOptions) farEnough(id string, ids []string, separator string) bool {
+	if !b.checksDistance() {
+		return true
+	}
+
+	words := Parse(id, separator).Components
+	for _, other := range ids {
+		if b.MinEditDistance > 0 && levenshtein(id, other) < b.MinEditDistance {
+			return false
+		}
+		if b.MinWordDistance > 0 && wordDistance(words, Parse(other, separator).Components) < b.MinWordDistance {
+			return false
+		}
+	}
+	return true
+}
+
+// wordDistance returns the number of words appearing in only one of a and b
+func wordDistance(a, b []string) int {
+	set := make(map[string]int, len(a)+len(b))
+	for _, word := range a {
+		set[word] |= 1
+	}
+	for _, word := range b {
+		set[word] |= 2
+	}
+
+	distance := 0
+	for _, membership := range set {
+		if membership != 3 {
+			distance++
+		}
+	}
+	return distance
+}
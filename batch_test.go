@@ -0,0 +1,190 @@
+package memorable_ids
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateN(t *testing.T) {
+	t.Run("should generate the requested number of IDs", func(t *testing.T) {
+		ids, err := GenerateN(25, GenerateOptions{Components: 3}, BatchOptions{})
+		require.NoError(t, err, "GenerateN should not fail")
+
+		assert.Len(t, ids, 25)
+	})
+
+	t.Run("should enforce minimum edit distance", func(t *testing.T) {
+		ids, err := GenerateN(20, GenerateOptions{Components: 2}, BatchOptions{MinEditDistance: 4})
+		require.NoError(t, err, "GenerateN should not fail")
+
+		for i := range ids {
+			for j := i + 1; j < len(ids); j++ {
+				assert.GreaterOrEqual(t, levenshtein(ids[i], ids[j]), 4, "'%s' and '%s' are too close", ids[i], ids[j])
+			}
+		}
+	})
+
+	t.Run("should enforce minimum word distance", func(t *testing.T) {
+		ids, err := GenerateN(16, GenerateOptions{Components: 3}, BatchOptions{MinWordDistance: 4})
+		require.NoError(t, err, "GenerateN should not fail")
+
+		for i := range ids {
+			for j := i + 1; j < len(ids); j++ {
+				a, b := Parse(ids[i], "-").Components, Parse(ids[j], "-").Components
+				assert.GreaterOrEqual(t, wordDistance(a, b), 4, "'%s' and '%s' share too many words", ids[i], ids[j])
+			}
+		}
+	})
+
+	t.Run("should fail when the constraints can't be met", func(t *testing.T) {
+		ids, err := GenerateN(100, GenerateOptions{Components: 1}, BatchOptions{MinEditDistance: 20, MaxAttempts: 5})
+
+		assert.ErrorIs(t, err, ErrBatchExhausted)
+		assert.Len(t, ids, 1, "Expected the partial batch to be returned")
+	})
+
+	t.Run("should reject negative counts", func(t *testing.T) {
+		_, err := GenerateN(-1, GenerateOptions{}, BatchOptions{})
+		assert.Error(t, err)
+	})
+}
+
+func TestWordDistance(t *testing.T) {
+	assert.Equal(t, 0, wordDistance([]string{"cute", "fox"}, []string{"fox", "cute"}))
+	assert.Equal(t, 2, wordDistance([]string{"cute", "fox"}, []string{"cute", "rabbit"}))
+	assert.Equal(t, 4, wordDistance([]string{"cute", "fox"}, []string{"large", "rabbit"}))
+}
+
+func TestGenerateNUnique(t *testing.T) {
+	t.Run("should never return duplicates", func(t *testing.T) {
+		ids, err := GenerateN(len(Adjectives), GenerateOptions{Components: 1}, BatchOptions{Unique: true, MaxAttempts: 10000})
+		require.NoError(t, err, "GenerateN should not fail")
+
+		seen := make(map[string]bool)
+		for _, id := range ids {
+			assert.False(t, seen[id], "Duplicate ID '%s'", id)
+			seen[id] = true
+		}
+		assert.Len(t, seen, len(Adjectives))
+	})
+
+	t.Run("should fail upfront when the space is too small", func(t *testing.T) {
+		ids, err := GenerateN(len(Adjectives)*26+1, GenerateOptions{Components: 1, Suffix: SuffixGenerators.Letter}, BatchOptions{Unique: true})
+
+		assert.ErrorIs(t, err, ErrBatchExhausted)
+		assert.ErrorContains(t, err, "combinations")
+		assert.Nil(t, ids)
+	})
+
+	t.Run("should report capacity only for known suffixes", func(t *testing.T) {
+		capacity, ok := batchCapacity(GenerateOptions{Suffix: SuffixGenerators.Hex})
+		assert.True(t, ok)
+		assert.Equal(t, CalculateCombinations(2, 256), capacity)
+
+		_, ok = batchCapacity(GenerateOptions{Suffix: func() *string { return nil }})
+		assert.False(t, ok)
+	})
+}
+
+func TestGenerateBatch(t *testing.T) {
+	t.Run("should generate n IDs", func(t *testing.T) {
+		ids, err := GenerateBatch(100, GenerateOptions{Components: 3, Suffix: SuffixGenerators.Number, Separator: "_"})
+		require.NoError(t, err)
+		require.Len(t, ids, 100)
+		assert.Equal(t, 100, cap(ids))
+
+		for _, id := range ids {
+			parts := strings.Split(id, "_")
+			require.Len(t, parts, 4)
+			assert.Contains(t, Adjectives, parts[0])
+			assert.Contains(t, Nouns, parts[1])
+			assert.Contains(t, Verbs, parts[2])
+			assert.Regexp(t, `^\d{3}$`, parts[3])
+		}
+	})
+
+	t.Run("should keep custom suffixes", func(t *testing.T) {
+		custom := func() *string { s := "x"; return &s }
+		ids, err := GenerateBatch(10, GenerateOptions{Suffix: custom})
+		require.NoError(t, err)
+		for _, id := range ids {
+			assert.True(t, strings.HasSuffix(id, "-x"), id)
+		}
+	})
+
+	t.Run("should deduplicate on request", func(t *testing.T) {
+		ids, err := GenerateBatchUnique(len(Adjectives), GenerateOptions{Components: 1})
+		require.NoError(t, err)
+		assert.ElementsMatch(t, Adjectives, ids)
+
+		_, err = GenerateBatchUnique(len(Adjectives)+1, GenerateOptions{Components: 1})
+		assert.ErrorIs(t, err, ErrBatchExhausted)
+	})
+
+	t.Run("should fill most of the space without exhausting it", func(t *testing.T) {
+		ids, err := GenerateBatchUnique(6000, GenerateOptions{})
+		require.NoError(t, err)
+		require.Len(t, ids, 6000)
+
+		seen := make(map[string]bool, len(ids))
+		for _, id := range ids {
+			assert.False(t, seen[id], "Duplicate ID '%s'", id)
+			seen[id] = true
+			parsed, err := ParseStrict(id, ParseOptions{})
+			require.NoError(t, err, id)
+			assert.Contains(t, Adjectives, parsed.Components[0])
+			assert.Contains(t, Nouns, parsed.Components[1])
+		}
+	})
+
+	t.Run("should sample suffixes and patterns without replacement", func(t *testing.T) {
+		ids, err := GenerateBatchUnique(len(Adjectives)*26, GenerateOptions{Components: 1, Suffix: SuffixGenerators.Letter})
+		require.NoError(t, err)
+		seen := make(map[string]bool, len(ids))
+		for _, id := range ids {
+			assert.Regexp(t, `^[a-z-]+-[a-z]$`, id)
+			seen[id] = true
+		}
+		assert.Len(t, seen, len(Adjectives)*26)
+
+		ids, err = GenerateBatchUnique(len(Nouns)*len(Verbs), GenerateOptions{Pattern: "noun-verb", Separator: "_"})
+		require.NoError(t, err)
+		seen = make(map[string]bool, len(ids))
+		for _, id := range ids {
+			parts := strings.Split(id, "_")
+			require.Len(t, parts, 2)
+			assert.Contains(t, Nouns, parts[0])
+			assert.Contains(t, Verbs, parts[1])
+			seen[id] = true
+		}
+		assert.Len(t, seen, len(Nouns)*len(Verbs))
+	})
+
+	t.Run("should reject invalid input", func(t *testing.T) {
+		_, err := GenerateBatch(-1, GenerateOptions{})
+		assert.Error(t, err)
+		_, err = GenerateBatch(1, GenerateOptions{Components: 6})
+		assert.Error(t, err)
+
+		ids, err := GenerateBatch(0, GenerateOptions{})
+		require.NoError(t, err)
+		assert.Empty(t, ids)
+	})
+}
+
+func BenchmarkGenerateBatch(b *testing.B) {
+	options := GenerateOptions{Components: 3, Suffix: SuffixGenerators.Number}
+	b.Run("GenerateBatch", func(b *testing.B) {
+		for b.Loop() {
+			GenerateBatch(1000, options)
+		}
+	})
+	b.Run("GenerateN", func(b *testing.B) {
+		for b.Loop() {
+			GenerateN(1000, options, BatchOptions{})
+		}
+	})
+}
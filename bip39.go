@@ -0,0 +1,185 @@
+package memorable_ids
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"strings"
+)
+
+// BIP39WordlistSize is the number of words in every BIP39 wordlist
+const BIP39WordlistSize = 2048
+
+// ErrBIP39Checksum is returned when a mnemonic's checksum bits do not match its entropy
+var ErrBIP39Checksum = errors.New("invalid BIP39 checksum")
+
+// BIP39Wordlist is a BIP39 wordlist where every word encodes the 11-bit
+// value of its position (0-2047). The list itself is not bundled; load the
+// standard English list (bip-0039/english.txt) or any other language.
+type BIP39Wordlist struct {
+	words []string
+	index map[string]int
+}
+
+// NewBIP39Wordlist builds a wordlist from exactly 2048 distinct words in
+// their canonical order
+func NewBIP39Wordlist(words []string) (*BIP39Wordlist, error) {
+	if len(words) != BIP39WordlistSize {
+		return nil, fmt.Errorf("BIP39 wordlist must have %d words, got %d", BIP39WordlistSize, len(words))
+	}
+
+	list := &BIP39Wordlist{words: make([]string, len(words)), index: make(map[string]int, len(words))}
+	for i, word := range words {
+		if word == "" {
+			return nil, fmt.Errorf("BIP39 wordlist has an empty word at index %d", i)
+		}
+		if _, ok := list.index[word]; ok {
+			return nil, fmt.Errorf("BIP39 wordlist has duplicate word %q", word)
+		}
+		list.words[i] = word
+		list.index[word] = i
+	}
+	return list, nil
+}
+
+// LoadBIP39Wordlist reads a wordlist in the format of the reference lists,
+// one word per line
+//
+// Example:
+//
+//	f, _ := os.Open("bip-0039/english.txt")
+//	list, _ := LoadBIP39Wordlist(f)
+//	list.Index("zoo") // 2047, true
+func LoadBIP39Wordlist(r io.Reader) (*BIP39Wordlist, error) {
+	var words []string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		if word := strings.TrimSpace(scanner.Text()); word != "" {
+			words = append(words, word)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return NewBIP39Wordlist(words)
+}
+
+// Words returns a copy of the words in index order, e.g. for PassphraseOptions.Words
+func (l *BIP39Wordlist) Words() []string {
+	return append([]string(nil), l.words...)
+}
+
+// Word returns the word at index
+func (l *BIP39Wordlist) Word(index int) (string, bool) {
+	if index < 0 || index >= len(l.words) {
+		return "", false
+	}
+	return l.words[index], true
+}
+
+// Index returns the position of word in the list
+func (l *BIP39Wordlist) Index(word string) (int, bool) {
+	index, ok := l.index[word]
+	return index, ok
+}
+
+// Dictionary returns a dictionary using the wordlist for every word class,
+// so IDs of any component count are made of BIP39 words only
+func (l *BIP39Wordlist) Dictionary() Dictionary {
+	return NewDictionary(l.words, l.words, l.words, l.words, l.words)
+}
+
+// Generate creates an ID of the given number of random BIP39 words
+// (11 bits each). The ID has no checksum; use EntropyToMnemonic for
+// mnemonics that wallets accept.
+//
+// Example:
+//
+//	list.Generate(3, "-") // "lunar-pepper-vivid"
+func (l *BIP39Wordlist) Generate(words int, separator string) (string, error) {
+	if words < 1 {
+		return "", errors.New("words must be at least 1")
+	}
+
+	parts := make([]string, words)
+	for i := range parts {
+		parts[i] = l.words[rand.Intn(len(l.words))]
+	}
+	return strings.Join(parts, separator), nil
+}
+
+// Indices returns the word indices of an ID made of BIP39 words
+func (l *BIP39Wordlist) Indices(id, separator string) ([]int, error) {
+	parts := strings.Split(id, separator)
+	indices := make([]int, len(parts))
+	for i, part := range parts {
+		index, ok := l.index[part]
+		if !ok {
+			return nil, fmt.Errorf("%q is not in the BIP39 wordlist", part)
+		}
+		indices[i] = index
+	}
+	return indices, nil
+}
+
+// EntropyToMnemonic encodes 16-32 bytes of entropy (a multiple of 4) as a
+// space-separated BIP39 mnemonic with its SHA-256 checksum bits appended
+//
+// Example:
+//
+//	list.EntropyToMnemonic(make([]byte, 16))
+//	// "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+func (l *BIP39Wordlist) EntropyToMnemonic(entropy []byte) (string, error) {
+	if len(entropy) < 16 || len(entropy) > 32 || len(entropy)%4 != 0 {
+		return "", fmt.Errorf("BIP39 entropy must be 16-32 bytes in steps of 4, got %d", len(entropy))
+	}
+
+	checksumBits := len(entropy) / 4
+	sum := sha256.Sum256(entropy)
+	data := append(append([]byte(nil), entropy...), sum[0])
+
+	words := make([]string, (len(entropy)*8+checksumBits)/11)
+	for i := range words {
+		index := 0
+		for bit := i * 11; bit < (i+1)*11; bit++ {
+			index = index<<1 | int(data[bit/8]>>(7-bit%8)&1)
+		}
+		words[i] = l.words[index]
+	}
+	return strings.Join(words, " "), nil
+}
+
+// MnemonicToEntropy decodes a space-separated BIP39 mnemonic back to its
+// entropy, verifying the checksum
+func (l *BIP39Wordlist) MnemonicToEntropy(mnemonic string) ([]byte, error) {
+	indices, err := l.Indices(strings.Join(strings.Fields(mnemonic), " "), " ")
+	if err != nil {
+		return nil, err
+	}
+	if len(indices) < 12 || len(indices) > 24 || len(indices)%3 != 0 {
+		return nil, fmt.Errorf("BIP39 mnemonic must have 12-24 words in steps of 3, got %d", len(indices))
+	}
+
+	totalBits := len(indices) * 11
+	checksumBits := totalBits / 33
+	data := make([]byte, (totalBits+7)/8)
+	for i, index := range indices {
+		for b := 0; b < 11; b++ {
+			if index>>(10-b)&1 == 1 {
+				bit := i*11 + b
+				data[bit/8] |= 1 << (7 - bit%8)
+			}
+		}
+	}
+
+	entropy := data[:checksumBits*4]
+	sum := sha256.Sum256(entropy)
+	shift := 8 - checksumBits
+	if data[len(entropy)]>>shift != sum[0]>>shift {
+		return nil, ErrBIP39Checksum
+	}
+	return entropy, nil
+}
@@ -0,0 +1,93 @@
+package memorable_ids
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testBIP39Wordlist returns a synthetic list whose words spell their own index
+func testBIP39Wordlist(t *testing.T) *BIP39Wordlist {
+	var buf bytes.Buffer
+	for i := 0; i < BIP39WordlistSize; i++ {
+		fmt.Fprintf(&buf, "w%04d\n", i)
+	}
+	list, err := LoadBIP39Wordlist(&buf)
+	require.NoError(t, err)
+	return list
+}
+
+func TestBIP39Wordlist(t *testing.T) {
+	t.Run("should reject malformed wordlists", func(t *testing.T) {
+		_, err := NewBIP39Wordlist([]string{"abandon", "ability"})
+		assert.ErrorContains(t, err, "must have 2048 words")
+
+		words := testBIP39Wordlist(t).Words()
+		words[1] = words[0]
+		_, err = NewBIP39Wordlist(words)
+		assert.ErrorContains(t, err, "duplicate")
+	})
+
+	t.Run("should map words to their 11-bit index", func(t *testing.T) {
+		list := testBIP39Wordlist(t)
+
+		index, ok := list.Index("w2047")
+		assert.True(t, ok)
+		assert.Equal(t, 2047, index)
+
+		word, ok := list.Word(42)
+		assert.True(t, ok)
+		assert.Equal(t, "w0042", word)
+
+		_, ok = list.Word(BIP39WordlistSize)
+		assert.False(t, ok)
+	})
+
+	t.Run("should generate IDs of BIP39 words", func(t *testing.T) {
+		list := testBIP39Wordlist(t)
+
+		id, err := list.Generate(3, "-")
+		require.NoError(t, err)
+		indices, err := list.Indices(id, "-")
+		require.NoError(t, err)
+		assert.Len(t, indices, 3)
+
+		dict := list.Dictionary()
+		assert.NoError(t, dict.Validate())
+		assert.Equal(t, BIP39WordlistSize, dict.Stats.Nouns)
+	})
+
+	t.Run("should encode the reference zero-entropy vector", func(t *testing.T) {
+		list := testBIP39Wordlist(t)
+
+		mnemonic, err := list.EntropyToMnemonic(make([]byte, 16))
+		require.NoError(t, err)
+		// "abandon" x11 + "about" in the English list
+		assert.Equal(t, strings.Repeat("w0000 ", 11)+"w0003", mnemonic)
+	})
+
+	t.Run("should round-trip entropy and verify checksums", func(t *testing.T) {
+		list := testBIP39Wordlist(t)
+
+		for _, size := range []int{16, 20, 24, 28, 32} {
+			entropy := bytes.Repeat([]byte{0x7f}, size)
+			mnemonic, err := list.EntropyToMnemonic(entropy)
+			require.NoError(t, err)
+			assert.Len(t, strings.Fields(mnemonic), size*3/4)
+
+			decoded, err := list.MnemonicToEntropy(mnemonic)
+			require.NoError(t, err)
+			assert.Equal(t, entropy, decoded)
+		}
+
+		_, err := list.MnemonicToEntropy(strings.Repeat("w0000 ", 12))
+		assert.ErrorIs(t, err, ErrBIP39Checksum)
+
+		_, err = list.EntropyToMnemonic(make([]byte, 15))
+		assert.Error(t, err)
+	})
+}
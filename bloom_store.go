@@ -0,0 +1,73 @@
+package memorable_ids
+
+import (
+	"errors"
+	"hash/fnv"
+	"math"
+	"sync"
+)
+
+// BloomStore is an in-process Store backed by a Bloom filter, remembering
+// millions of issued IDs in a fixed amount of memory. False positives make
+// an unused ID look taken, which only costs a re-roll: a BloomStore never
+// lets an ID through twice. It is safe for concurrent use.
+type BloomStore struct {
+	mu     sync.Mutex
+	bits   []uint64
+	hashes int
+	count  int
+}
+
+// NewBloomStore creates a BloomStore sized for capacity IDs at the given
+// false positive rate (0-1, exclusive)
+//
+// Example:
+//
+//	store, _ := NewBloomStore(10_000_000, 0.001) // ~17 MiB
+//	gen := NewUniqueGenerator(UniqueConfig{Store: store})
+func NewBloomStore(capacity int, falsePositiveRate float64) (*BloomStore, error) {
+	if capacity < 1 {
+		return nil, errors.New("bloom store capacity must be positive")
+	}
+	if falsePositiveRate <= 0 || falsePositiveRate >= 1 {
+		return nil, errors.New("bloom store false positive rate must be between 0 and 1")
+	}
+
+	size := math.Ceil(-float64(capacity) * math.Log(falsePositiveRate) / (math.Ln2 * math.Ln2))
+	words := max(int(math.Ceil(size/64)), 1)
+	hashes := max(int(math.Round(float64(words*64)/float64(capacity)*math.Ln2)), 1)
+	return &BloomStore{bits: make([]uint64, words), hashes: hashes}, nil
+}
+
+// Reserve implements Store
+func (s *BloomStore) Reserve(id string) (bool, error) {
+	hasher := fnv.New64a()
+	hasher.Write([]byte(id))
+	h1 := mix64(hasher.Sum64())
+	h2 := mix64(h1+0x9e3779b97f4a7c15) | 1
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	size := uint64(len(s.bits)) * 64
+	taken := true
+	for i := range uint64(s.hashes) {
+		bit := (h1 + i*h2) % size
+		if s.bits[bit/64]&(1<<(bit%64)) == 0 {
+			taken = false
+			s.bits[bit/64] |= 1 << (bit % 64)
+		}
+	}
+	if taken {
+		return false, nil
+	}
+	s.count++
+	return true, nil
+}
+
+// Len returns the number of reserved IDs
+func (s *BloomStore) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.count
+}
@@ -0,0 +1,79 @@
+package memorable_ids
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBloomStore(t *testing.T) {
+	t.Run("should never reserve an ID twice", func(t *testing.T) {
+		store, err := NewBloomStore(1000, 0.01)
+		require.NoError(t, err)
+
+		reserved, err := store.Reserve("cute-rabbit")
+		require.NoError(t, err)
+		assert.True(t, reserved)
+
+		reserved, err = store.Reserve("cute-rabbit")
+		require.NoError(t, err)
+		assert.False(t, reserved)
+		assert.Equal(t, 1, store.Len())
+	})
+
+	t.Run("should stay close to the false positive rate", func(t *testing.T) {
+		store, err := NewBloomStore(10000, 0.01)
+		require.NoError(t, err)
+
+		rejected := 0
+		for i := range 10000 {
+			reserved, err := store.Reserve(fmt.Sprintf("id-%d", i))
+			require.NoError(t, err)
+			if !reserved {
+				rejected++
+			}
+		}
+		assert.Less(t, rejected, 200, "Expected about 1%% false positives while filling up")
+	})
+
+	t.Run("should back a unique generator", func(t *testing.T) {
+		store, err := NewBloomStore(1000, 0.001)
+		require.NoError(t, err)
+		gen := NewUniqueGenerator(UniqueConfig{Options: GenerateOptions{Components: 3}, Store: store})
+
+		seen := make(map[string]bool)
+		for range 500 {
+			id, err := gen.Generate()
+			require.NoError(t, err)
+			assert.False(t, seen[id], id)
+			seen[id] = true
+		}
+	})
+
+	t.Run("should reject invalid sizes", func(t *testing.T) {
+		_, err := NewBloomStore(0, 0.01)
+		assert.Error(t, err)
+		_, err = NewBloomStore(100, 1)
+		assert.Error(t, err)
+	})
+}
+
+func TestStoreFunc(t *testing.T) {
+	t.Run("should adapt functions as uniqueness stores", func(t *testing.T) {
+		taken := map[string]bool{}
+		var store UniquenessStore = StoreFunc(func(id string) (bool, error) {
+			if taken[id] {
+				return false, nil
+			}
+			taken[id] = true
+			return true, nil
+		})
+
+		gen := NewUniqueGenerator(UniqueConfig{Store: store})
+		id, err := gen.Generate()
+		require.NoError(t, err)
+		assert.True(t, taken[id])
+	})
+}
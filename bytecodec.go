@@ -0,0 +1,63 @@
+package memorable_ids
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+/**
+ * Byte codec
+ *
+ * EncodeBytes renders arbitrary data, such as a UUID or a hash, as
+ * dictionary words using the mixed-radix codec. A 0x01 marker byte is
+ * prepended before encoding, so leading zero bytes and the data length
+ * survive the round trip. The phrase has the fewest words able to hold
+ * the marked value, which makes every encoding canonical: DecodeBytes
+ * rejects phrases padded with extra words.
+ */
+
+// byteCodecMarker is prepended to the data before encoding
+const byteCodecMarker = 0x01
+
+// EncodeBytes renders data as a hyphenated phrase of dictionary words;
+// DecodeBytes recovers it. A 16-byte UUID takes 24 words.
+//
+// Example:
+//
+//	EncodeBytes([]byte{0xde, 0xad, 0xbe, 0xef}) // "afraid-butterfly-write-cordially-after-hungry"
+//	EncodeBytes(nil)                            // "dapper"
+func EncodeBytes(data []byte) string {
+	dict := GetDictionary()
+	marked := make([]byte, 0, len(data)+1)
+	marked = append(append(marked, byteCodecMarker), data...)
+
+	value := new(big.Int).SetBytes(marked)
+	return strings.Join(encodeRadix(dict, value, radixClasses(dict, value.BitLen())), "-")
+}
+
+// DecodeBytes recovers the data encoded by EncodeBytes
+//
+// Example:
+//
+//	DecodeBytes("dapper") // []byte{}, nil
+func DecodeBytes(id string) ([]byte, error) {
+	dict := GetDictionary()
+	words, err := splitCycleWords(dict, id, "-")
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := decodeRadix(dict, words, cycleClasses(0, len(words)))
+	if err != nil {
+		return nil, err
+	}
+	if value.BitLen() == 0 || (value.BitLen()-1)%8 != 0 {
+		return nil, errors.New("phrase does not encode bytes")
+	}
+	if minimal := len(radixClasses(dict, value.BitLen())); len(words) != minimal {
+		return nil, fmt.Errorf("phrase has %d words, expected %d", len(words), minimal)
+	}
+	return value.Bytes()[1:], nil
+}
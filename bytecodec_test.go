@@ -0,0 +1,49 @@
+package memorable_ids
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeBytes(t *testing.T) {
+	t.Run("should round-trip random data of any length", func(t *testing.T) {
+		for length := range 40 {
+			data := make([]byte, length)
+			rand.Read(data)
+
+			id := EncodeBytes(data)
+			decoded, err := DecodeBytes(id)
+			require.NoError(t, err, id)
+			assert.Equal(t, data, decoded, id)
+		}
+	})
+
+	t.Run("should keep leading zeros and length", func(t *testing.T) {
+		for _, data := range [][]byte{{}, {0}, {0, 0}, {0, 0, 1}, {1}} {
+			decoded, err := DecodeBytes(EncodeBytes(data))
+			require.NoError(t, err)
+			assert.Equal(t, data, decoded)
+		}
+		assert.NotEqual(t, EncodeBytes([]byte{0}), EncodeBytes([]byte{0, 0}))
+	})
+
+	t.Run("should encode UUIDs as words", func(t *testing.T) {
+		uuid := []byte{0x12, 0x3e, 0x45, 0x67, 0xe8, 0x9b, 0x12, 0xd3, 0xa4, 0x56, 0x42, 0x66, 0x14, 0x17, 0x40, 0x00}
+		id := EncodeBytes(uuid)
+		assert.Equal(t, EncodeBytes(uuid), id)
+		assert.Len(t, Parse(id, "-").Components, 24)
+	})
+
+	t.Run("should reject phrases that are not encodings", func(t *testing.T) {
+		id := EncodeBytes([]byte{0xde, 0xad})
+		_, err := DecodeBytes(id + "-cute")
+		assert.Error(t, err)
+		_, err = DecodeBytes("cute")
+		assert.Error(t, err, "value 0 has no marker")
+		_, err = DecodeBytes("cute-42")
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,110 @@
+package memorable_ids
+
+import (
+	"math/bits"
+	"slices"
+	"sync"
+)
+
+/**
+ * Combination math caching
+ *
+ * Dashboards call CalculateCombinations and GetCollisionAnalysis on every
+ * render, so word combinations for the built-in dictionaries are precomputed
+ * and analysis results are memoized per combination count. Cache keys
+ * include the count computed from the current word lists, so modifying the
+ * exported word slices never returns stale results.
+ */
+
+// maxCachedAnalyses bounds the analysis cache; it is cleared when full
+const maxCachedAnalyses = 256
+
+// builtinStats are the dictionary stats at package initialization
+var builtinStats = GetDictionaryStats()
+
+// builtinCombinations holds word combinations for 0-5 components of the built-in dictionaries
+var builtinCombinations = func() [6]uint64 {
+	var table [6]uint64
+	table[0] = 1
+	for i := 1; i < len(table); i++ {
+		table[i] = table[i-1] * uint64(statsSizes(builtinStats)[i-1])
+	}
+	return table
+}()
+
+// statsSizes returns the class sizes in component order
+func statsSizes(stats DictionaryStats) []int {
+	return []int{
+		stats.Adjectives,
+		stats.Nouns,
+		stats.Verbs,
+		stats.Adverbs,
+		stats.Prepositions,
+	}
+}
+
+// wordCombinations returns the number of word combinations for the given
+// number of components, using the precomputed table when possible, and
+// reports false if the count overflows uint64
+func wordCombinations(stats DictionaryStats, components int) (uint64, bool) {
+	if stats == builtinStats {
+		return builtinCombinations[components], true
+	}
+
+	total := uint64(1)
+	for _, size := range statsSizes(stats)[:components] {
+		var ok bool
+		if total, ok = mulUint64(total, uint64(size)); !ok {
+			return 0, false
+		}
+	}
+	return total, true
+}
+
+// mulUint64 multiplies a and b, reporting false on overflow
+func mulUint64(a, b uint64) (uint64, bool) {
+	hi, lo := bits.Mul64(a, b)
+	return lo, hi == 0
+}
+
+// analysisKey identifies a memoized collision analysis
+type analysisKey struct {
+	total     int
+	threshold float64
+	scenarios string
+	format    NumberFormat
+}
+
+// collisionAnalysisCache memoizes collision analyses per combination count
+type collisionAnalysisCache struct {
+	mu      sync.RWMutex
+	entries map[analysisKey]CollisionAnalysis
+}
+
+var analysisCache = &collisionAnalysisCache{entries: make(map[analysisKey]CollisionAnalysis)}
+
+// get returns a copy of the cached analysis so callers can't mutate the cache
+func (c *collisionAnalysisCache) get(key analysisKey) (CollisionAnalysis, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	analysis, ok := c.entries[key]
+	if !ok {
+		return CollisionAnalysis{}, false
+	}
+	analysis.Scenarios = slices.Clone(analysis.Scenarios)
+	analysis.Warnings = slices.Clone(analysis.Warnings)
+	return analysis, true
+}
+
+func (c *collisionAnalysisCache) put(key analysisKey, analysis CollisionAnalysis) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if len(c.entries) >= maxCachedAnalyses {
+		clear(c.entries)
+	}
+	analysis.Scenarios = slices.Clone(analysis.Scenarios)
+	analysis.Warnings = slices.Clone(analysis.Warnings)
+	c.entries[key] = analysis
+}
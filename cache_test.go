@@ -0,0 +1,145 @@
+package memorable_ids
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCombinationCache(t *testing.T) {
+	t.Run("should match uncached math for built-in dictionaries", func(t *testing.T) {
+		for components := 1; components <= 5; components++ {
+			expected := 1
+			for _, size := range statsSizes(GetDictionaryStats())[:components] {
+				expected *= size
+			}
+			assert.Equal(t, expected*7, CalculateCombinations(components, 7), "Mismatch for %d components", components)
+		}
+	})
+
+	t.Run("should return identical analysis on repeated calls", func(t *testing.T) {
+		first := GetCollisionAnalysis(2, 1)
+		second := GetCollisionAnalysis(2, 1)
+
+		assert.Equal(t, first, second)
+	})
+
+	t.Run("should not leak mutations into the cache", func(t *testing.T) {
+		first := GetCollisionAnalysis(2, 10)
+		first.Scenarios[0].IDs = -1
+
+		second := GetCollisionAnalysis(2, 10)
+		assert.Equal(t, 50, second.Scenarios[0].IDs, "Expected cached scenario to be unchanged")
+	})
+
+	t.Run("should not return stale results when dictionaries change", func(t *testing.T) {
+		before := GetCollisionAnalysis(1, 1)
+
+		original := Adjectives
+		Adjectives = Adjectives[:10]
+		defer func() { Adjectives = original }()
+
+		after := GetCollisionAnalysis(1, 1)
+		assert.Equal(t, 10, after.TotalCombinations)
+		assert.NotEqual(t, before.TotalCombinations, after.TotalCombinations)
+	})
+}
+
+func TestCalculateExpectedCollisions(t *testing.T) {
+	t.Run("should return 0 for 1 or fewer IDs", func(t *testing.T) {
+		assert.Equal(t, 0.0, CalculateExpectedCollisions(1000, 1))
+		assert.Equal(t, 0.0, CalculateExpectedCollisions(1000, 0))
+		assert.Equal(t, 0.0, CalculateExpectedCollisions(0, 10))
+	})
+
+	t.Run("should approximate n squared over 2N for sparse spaces", func(t *testing.T) {
+		expected := CalculateExpectedCollisions(250560000, 100000)
+		assert.InDelta(t, 100000.0*100000/(2*250560000), expected, 0.1)
+	})
+
+	t.Run("should count every extra ID once the space is exhausted", func(t *testing.T) {
+		assert.InDelta(t, 10.0, CalculateExpectedCollisions(1, 11), 1e-9)
+	})
+
+	t.Run("should be included in analysis scenarios", func(t *testing.T) {
+		analysis := GetCollisionAnalysis(2, 1)
+		for _, scenario := range analysis.Scenarios {
+			assert.InDelta(t, CalculateExpectedCollisions(analysis.TotalCombinations, scenario.IDs), scenario.ExpectedCollisions, 1e-9)
+		}
+		assert.Greater(t, analysis.Scenarios[len(analysis.Scenarios)-1].ExpectedCollisions, 1.0)
+	})
+}
+
+func TestGetCollisionAnalysisWithOptions(t *testing.T) {
+	t.Run("should report the default threshold and cutoff", func(t *testing.T) {
+		analysis := GetCollisionAnalysis(1, 1)
+
+		assert.Equal(t, DefaultAnalysisThreshold, analysis.Threshold)
+		assert.Equal(t, int(float64(len(Adjectives))*0.8), analysis.Cutoff)
+		for _, scenario := range analysis.Scenarios {
+			assert.Less(t, scenario.IDs, analysis.Cutoff)
+		}
+	})
+
+	t.Run("should use custom scenarios and threshold", func(t *testing.T) {
+		total := CalculateCombinations(2, 1)
+		analysis := GetCollisionAnalysisWithOptions(2, 1, AnalysisOptions{
+			Threshold: 1,
+			Scenarios: []int{10, total - 1, total},
+		})
+
+		assert.Equal(t, total, analysis.Cutoff)
+		assert.Len(t, analysis.Scenarios, 2)
+		assert.Equal(t, total-1, analysis.Scenarios[1].IDs)
+	})
+
+	t.Run("should not share cache entries across options", func(t *testing.T) {
+		a := GetCollisionAnalysisWithOptions(2, 1, AnalysisOptions{Scenarios: []int{10}})
+		b := GetCollisionAnalysisWithOptions(2, 1, AnalysisOptions{Scenarios: []int{20}})
+
+		assert.Equal(t, 10, a.Scenarios[0].IDs)
+		assert.Equal(t, 20, b.Scenarios[0].IDs)
+	})
+}
+
+func TestCalculateCombinationsOverflow(t *testing.T) {
+	t.Run("should saturate at math.MaxInt instead of wrapping", func(t *testing.T) {
+		assert.Equal(t, math.MaxInt, CalculateCombinations(5, 60_000_000_000))
+		assert.Equal(t, math.MaxInt, CalculateCombinations(5, 200_000_000_000))
+
+		total, err := CalculateCombinationsChecked(5, 60_000_000_000)
+		require.NoError(t, err)
+		assert.Greater(t, total, uint64(math.MaxInt))
+	})
+
+	t.Run("should detect uint64 overflow", func(t *testing.T) {
+		_, err := CalculateCombinationsChecked(5, 200_000_000_000)
+		assert.ErrorIs(t, err, ErrCombinationOverflow)
+
+		_, err = CalculateCombinationsChecked(0, 1)
+		assert.Error(t, err)
+	})
+
+	t.Run("should detect overflow from large dictionaries", func(t *testing.T) {
+		huge, ok := wordCombinations(DictionaryStats{Adjectives: 1 << 32, Nouns: 1 << 32}, 2)
+		assert.False(t, ok)
+		assert.Zero(t, huge)
+	})
+
+	t.Run("should flag saturated analyses", func(t *testing.T) {
+		analysis := GetCollisionAnalysis(5, 60_000_000_000)
+		assert.True(t, analysis.Saturated)
+		assert.NotEmpty(t, analysis.Warnings)
+
+		assert.False(t, GetCollisionAnalysis(2, 1000).Saturated)
+	})
+}
+
+func TestCalculateCollisionProbabilityLargeInputs(t *testing.T) {
+	t.Run("should not overflow when squaring large ID counts", func(t *testing.T) {
+		probability := CalculateCollisionProbability(math.MaxInt, 4_000_000_000)
+		assert.InDelta(t, 0.58, probability, 0.01)
+	})
+}
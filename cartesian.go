@@ -0,0 +1,81 @@
+package memorable_ids
+
+import (
+	"fmt"
+	"math"
+)
+
+/**
+ * Cartesian word index
+ *
+ * Every word combination of a configuration has an index in
+ * [0, product of the class sizes). The index decomposes into one word
+ * index per component, the first word being the least significant digit
+ * like in the mixed-radix codec. Generation draws a single random index
+ * instead of one per component, which is uniform over the whole space and
+ * needs fewer random draws.
+ */
+
+// wordSpace returns the number of word combinations of classes, or 0 if
+// it doesn't fit an int
+func wordSpace(dict Dictionary, classes []WordClass) (int, error) {
+	space := uint64(1)
+	fits := true
+	for _, class := range classes {
+		size := len(dict.Words(class))
+		if size == 0 {
+			return 0, fmt.Errorf("%w: no %s words", ErrEmptyWordClass, class)
+		}
+		if fits {
+			space, fits = mulUint64(space, uint64(size))
+			fits = fits && space <= math.MaxInt
+		}
+	}
+	if !fits {
+		return 0, nil
+	}
+	return int(space), nil
+}
+
+// appendWords appends the word combination of classes with the given index to dst
+func appendWords(dst []byte, dict Dictionary, classes []WordClass, separator string, index int) []byte {
+	for i, class := range classes {
+		words := dict.Words(class)
+		if i > 0 {
+			dst = append(dst, separator...)
+		}
+		dst = append(dst, words[index%len(words)]...)
+		index /= len(words)
+	}
+	return dst
+}
+
+// NthID returns the word combination with index n of the options, in
+// index order with the first word varying fastest; the suffix is ignored.
+// Indexes run from 0 to the number of word combinations of the options'
+// components or pattern, minus 1.
+//
+// Example:
+//
+//	NthID(0, GenerateOptions{})                     // "cute-rabbit", nil
+//	NthID(1, GenerateOptions{})                     // "dapper-rabbit", nil
+//	NthID(1, GenerateOptions{Pattern: "noun-verb"}) // "badger-sing", nil
+func NthID(n int, options GenerateOptions) (string, error) {
+	if options.Separator == "" {
+		options.Separator = "-"
+	}
+	classes, err := options.classes()
+	if err != nil {
+		return "", err
+	}
+
+	dict := options.dictionary()
+	space, err := wordSpace(dict, classes)
+	if err != nil {
+		return "", err
+	}
+	if n < 0 || (space > 0 && n >= space) {
+		return "", fmt.Errorf("index %d is outside [0, %d)", n, space)
+	}
+	return string(appendWords(nil, dict, classes, options.Separator, n)), nil
+}
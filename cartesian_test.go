@@ -0,0 +1,151 @@
+package memorable_ids
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCartesianIndex(t *testing.T) {
+	t.Run("should draw a single random number per ID", func(t *testing.T) {
+		calls := 0
+		var bound int
+		intn := func(n int) int {
+			calls++
+			bound = n
+			return n - 1
+		}
+
+		id, err := generate(GetDictionary(), GenerateOptions{Components: 5}, intn)
+		require.NoError(t, err)
+		assert.Equal(t, 1, calls)
+		assert.Equal(t, CalculateCombinations(5, 1), bound)
+
+		last := func(words []string) string { return words[len(words)-1] }
+		assert.Equal(t, last(Adjectives)+"-"+last(Nouns)+"-"+last(Verbs)+"-"+last(Adverbs)+"-"+last(Prepositions), id)
+	})
+
+	t.Run("should fall back to one draw per component for huge spaces", func(t *testing.T) {
+		huge := make([]string, 1<<16)
+		for i := range huge {
+			huge[i] = "w"
+		}
+		dict := NewDictionary(huge, huge, huge, huge, []string{"p"})
+		space, err := wordSpace(dict, wordClasses[:4])
+		require.NoError(t, err)
+		assert.Zero(t, space)
+
+		calls := 0
+		_, err = generate(dict, GenerateOptions{Components: 4}, func(n int) int { calls++; return 0 })
+		require.NoError(t, err)
+		assert.Equal(t, 4, calls)
+	})
+
+	t.Run("should report empty classes", func(t *testing.T) {
+		dict := NewDictionary([]string{"cute"}, nil, []string{"run"}, []string{"fast"}, []string{"in"})
+		_, err := generate(dict, GenerateOptions{Components: 2}, func(int) int { return 0 })
+		assert.ErrorIs(t, err, ErrEmptyWordClass)
+	})
+}
+
+func TestNthID(t *testing.T) {
+	t.Run("should enumerate the word space in index order", func(t *testing.T) {
+		first, err := NthID(0, GenerateOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, Adjectives[0]+"-"+Nouns[0], first)
+
+		second, err := NthID(1, GenerateOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, Adjectives[1]+"-"+Nouns[0], second)
+
+		wrapped, err := NthID(len(Adjectives), GenerateOptions{Separator: "_"})
+		require.NoError(t, err)
+		assert.Equal(t, Adjectives[0]+"_"+Nouns[1], wrapped)
+	})
+
+	t.Run("should produce every combination exactly once", func(t *testing.T) {
+		total := CalculateCombinations(2, 1)
+		seen := make(map[string]struct{}, total)
+		for n := 0; n < total; n++ {
+			id, err := NthID(n, GenerateOptions{})
+			require.NoError(t, err)
+			seen[id] = struct{}{}
+		}
+		assert.Len(t, seen, total)
+	})
+
+	t.Run("should reject indexes outside the space", func(t *testing.T) {
+		_, err := NthID(-1, GenerateOptions{})
+		assert.Error(t, err)
+		_, err = NthID(CalculateCombinations(1, 1), GenerateOptions{Components: 1})
+		assert.Error(t, err)
+		_, err = NthID(0, GenerateOptions{Components: 6})
+		assert.Error(t, err)
+	})
+}
+
+func TestGeneratorNextSequential(t *testing.T) {
+	t.Run("should issue gap-free IDs in index order", func(t *testing.T) {
+		gen := NewGenerator(Config{Options: GenerateOptions{Components: 3, Separator: "_", Suffix: SuffixGenerators.Number}})
+
+		for i := range 200 {
+			id, err := gen.NextSequential()
+			require.NoError(t, err)
+			expected, err := NthID(i, GenerateOptions{Components: 3, Separator: "_"})
+			require.NoError(t, err)
+			assert.Equal(t, expected, id)
+		}
+		assert.Equal(t, 200, gen.Sequence())
+	})
+
+	t.Run("should resume from a persisted position", func(t *testing.T) {
+		gen := NewGenerator(Config{})
+		gen.SetSequence(len(Adjectives))
+
+		id, err := gen.NextSequential()
+		require.NoError(t, err)
+		assert.Equal(t, "cute-"+Nouns[1], id)
+	})
+
+	t.Run("should stop when the space is exhausted", func(t *testing.T) {
+		gen := NewGenerator(Config{Options: GenerateOptions{Components: 1}})
+		gen.SetSequence(len(Adjectives) - 1)
+
+		id, err := gen.NextSequential()
+		require.NoError(t, err)
+		assert.Equal(t, Adjectives[len(Adjectives)-1], id)
+
+		_, err = gen.NextSequential()
+		assert.ErrorIs(t, err, ErrSpaceExhausted)
+	})
+
+	t.Run("should be gap-free under concurrent use", func(t *testing.T) {
+		gen := NewGenerator(Config{})
+		ids := make(chan string, 400)
+		var wg sync.WaitGroup
+		for range 4 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for range 100 {
+					id, err := gen.NextSequential()
+					assert.NoError(t, err)
+					ids <- id
+				}
+			}()
+		}
+		wg.Wait()
+		close(ids)
+
+		seen := make(map[string]bool)
+		for id := range ids {
+			seen[id] = true
+		}
+		for i := range 400 {
+			expected, _ := NthID(i, GenerateOptions{})
+			assert.True(t, seen[expected], expected)
+		}
+	})
+}
@@ -0,0 +1,79 @@
+package memorable_ids
+
+import (
+	"math/rand"
+	"strconv"
+	"time"
+)
+
+// Clock is a source of the current time for time-based features, so tests
+// can freeze time and distributed systems can use a synchronized source
+type Clock interface {
+	Now() time.Time
+}
+
+// ClockFunc adapts a function to a Clock
+//
+// Example:
+//
+//	frozen := ClockFunc(func() time.Time { return time.UnixMilli(1700000001234) })
+type ClockFunc func() time.Time
+
+// Now implements Clock
+func (f ClockFunc) Now() time.Time {
+	return f()
+}
+
+// systemClock reads the local system time
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+// SystemClock is the Clock reading the local system time
+var SystemClock Clock = systemClock{}
+
+// clockOr returns clock, or SystemClock if it is nil
+func clockOr(clock Clock) Clock {
+	if clock == nil {
+		return SystemClock
+	}
+	return clock
+}
+
+// clockSuffix returns the last 4 digits of the clock's Unix milliseconds
+func clockSuffix(clock Clock) string {
+	timestamp := strconv.FormatInt(clock.Now().UnixMilli(), 10)
+	if len(timestamp) >= 4 {
+		return timestamp[len(timestamp)-4:]
+	}
+	return drawNumber4(rand.Intn)
+}
+
+// TimestampSuffix returns a SuffixProvider like SuffixGenerators.Timestamp
+// that reads the time from clock (default: SystemClock)
+//
+// Example:
+//
+//	suffix := TimestampSuffix(ClockFunc(func() time.Time { return time.UnixMilli(1700000001234) }))
+//	suffix.Next() // "1234", nil
+func TimestampSuffix(clock Clock) SuffixProvider {
+	return clockSuffixProvider{clock: clockOr(clock)}
+}
+
+// clockSuffixProvider is the provider returned by TimestampSuffix
+type clockSuffixProvider struct {
+	clock Clock
+}
+
+// Next implements SuffixProvider
+func (p clockSuffixProvider) Next() (string, error) {
+	return clockSuffix(p.clock), nil
+}
+
+// Spec implements SuffixProvider
+func (p clockSuffixProvider) Spec() SuffixSpec {
+	spec, _ := DescribeSuffix(SuffixGenerators.Timestamp)
+	return spec
+}
@@ -0,0 +1,55 @@
+package memorable_ids
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// frozenClock returns a clock stuck at the given Unix milliseconds
+func frozenClock(millis int64) Clock {
+	return ClockFunc(func() time.Time { return time.UnixMilli(millis) })
+}
+
+func TestTimestampSuffix(t *testing.T) {
+	t.Run("should read the time from the clock", func(t *testing.T) {
+		suffix := TimestampSuffix(frozenClock(1700000001234))
+		for range 2 {
+			value, err := suffix.Next()
+			require.NoError(t, err)
+			assert.Equal(t, "1234", value)
+		}
+
+		id, err := Generate(GenerateOptions{SuffixProvider: suffix})
+		require.NoError(t, err)
+		assert.Regexp(t, `-1234$`, id)
+	})
+
+	t.Run("should be described as a time-derived suffix", func(t *testing.T) {
+		spec := TimestampSuffix(frozenClock(0)).Spec()
+		assert.Equal(t, "timestamp", spec.Name)
+		assert.True(t, spec.TimeDerived)
+	})
+
+	t.Run("should default to the system clock", func(t *testing.T) {
+		suffix, err := TimestampSuffix(nil).Next()
+		require.NoError(t, err)
+		assert.Regexp(t, `^\d{4}$`, suffix)
+	})
+
+	t.Run("should timestamp audit records with the clock", func(t *testing.T) {
+		var record AuditRecord
+		gen, err := NewAuditedGenerator(GenerateOptions{}, 1, AuditSinkFunc(func(r AuditRecord) error {
+			record = r
+			return nil
+		}))
+		require.NoError(t, err)
+		gen.SetClock(frozenClock(1700000000000))
+
+		_, err = gen.Generate()
+		require.NoError(t, err)
+		assert.Equal(t, int64(1700000000000), record.Time.UnixMilli())
+	})
+}
@@ -0,0 +1,112 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	memorable "github.com/riipandi/memorable-ids"
+)
+
+// exportFormats lists the accepted --format values
+var exportFormats = []string{"text", "csv", "tsv", "jsonl"}
+
+// exportColumns are the columns of the tabular formats
+var exportColumns = []string{"index", "id", "components", "suffix"}
+
+// exportRecord is one generated ID in an export
+type exportRecord struct {
+	Index      int      `json:"index"`
+	ID         string   `json:"id"`
+	Components []string `json:"components"`
+	Suffix     *string  `json:"suffix"`
+}
+
+// exportWriter streams generated IDs in one output format
+type exportWriter interface {
+	Write(record exportRecord) error
+	// Flush writes buffered output and reports any write error
+	Flush() error
+}
+
+// newExportWriter creates the writer of a --format value
+func newExportWriter(format string, w io.Writer) (exportWriter, error) {
+	switch format {
+	case "text":
+		return &textExportWriter{w: w}, nil
+	case "csv", "tsv":
+		writer := csv.NewWriter(w)
+		if format == "tsv" {
+			writer.Comma = '\t'
+		}
+		if err := writer.Write(exportColumns); err != nil {
+			return nil, err
+		}
+		return &csvExportWriter{w: writer}, nil
+	case "jsonl":
+		return &jsonExportWriter{encoder: json.NewEncoder(w)}, nil
+	}
+	return nil, fmt.Errorf("unknown format %q (expected %s)", format, strings.Join(exportFormats, ", "))
+}
+
+// textExportWriter writes one bare ID per line
+type textExportWriter struct {
+	w   io.Writer
+	err error
+}
+
+func (t *textExportWriter) Write(record exportRecord) error {
+	if _, err := fmt.Fprintln(t.w, record.ID); err != nil && t.err == nil {
+		t.err = err
+	}
+	return t.err
+}
+
+func (t *textExportWriter) Flush() error { return t.err }
+
+// csvExportWriter writes CSV or TSV rows; components are space separated
+type csvExportWriter struct {
+	w *csv.Writer
+}
+
+func (c *csvExportWriter) Write(record exportRecord) error {
+	suffix := ""
+	if record.Suffix != nil {
+		suffix = *record.Suffix
+	}
+	return c.w.Write([]string{
+		strconv.Itoa(record.Index),
+		record.ID,
+		strings.Join(record.Components, " "),
+		suffix,
+	})
+}
+
+func (c *csvExportWriter) Flush() error {
+	c.w.Flush()
+	return c.w.Error()
+}
+
+// jsonExportWriter writes one JSON object per line
+type jsonExportWriter struct {
+	encoder *json.Encoder
+}
+
+func (j *jsonExportWriter) Write(record exportRecord) error {
+	return j.encoder.Encode(record)
+}
+
+func (j *jsonExportWriter) Flush() error { return nil }
+
+// newExportRecord splits a generated ID into its export columns
+func newExportRecord(index int, id string, options memorable.ParseOptions) exportRecord {
+	// ParseStrict rejoins words containing the separator, such as "guinea-pig"
+	parsed, err := memorable.ParseStrict(id, options)
+	if err != nil {
+		parsed = memorable.ParseWithOptions(id, options)
+	}
+	return exportRecord{Index: index, ID: id, Components: parsed.Components, Suffix: parsed.Suffix}
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	memorable "github.com/riipandi/memorable-ids"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateFormats(t *testing.T) {
+	t.Run("should write CSV with a header row", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		code := run([]string{"generate", "--components", "3", "--suffix", "number", "--count", "3", "--format", "csv"}, &stdout, &stderr)
+		require.Equal(t, 0, code, stderr.String())
+
+		rows, err := csv.NewReader(&stdout).ReadAll()
+		require.NoError(t, err)
+		require.Len(t, rows, 4)
+		assert.Equal(t, []string{"index", "id", "components", "suffix"}, rows[0])
+		for i, row := range rows[1:] {
+			assert.Equal(t, []string{"0", "1", "2"}[i], row[0])
+			assert.Len(t, strings.Fields(row[2]), 3)
+			assert.Len(t, row[3], 3)
+			assert.Equal(t, strings.ReplaceAll(row[2], " ", "-")+"-"+row[3], row[1])
+		}
+	})
+
+	t.Run("should write TSV", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		code := run([]string{"generate", "--count", "2", "--format", "tsv"}, &stdout, &stderr)
+		require.Equal(t, 0, code, stderr.String())
+
+		lines := strings.Split(strings.TrimSpace(stdout.String()), "\n")
+		require.Len(t, lines, 3)
+		assert.Equal(t, "index\tid\tcomponents\tsuffix", lines[0])
+	})
+
+	t.Run("should write one JSON object per line", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		code := run([]string{"generate", "--suffix", "hex", "--count", "2", "--format", "jsonl"}, &stdout, &stderr)
+		require.Equal(t, 0, code, stderr.String())
+
+		decoder := json.NewDecoder(&stdout)
+		for i := 0; i < 2; i++ {
+			var record exportRecord
+			require.NoError(t, decoder.Decode(&record))
+			assert.Equal(t, i, record.Index)
+			assert.Len(t, record.Components, 2)
+			require.NotNil(t, record.Suffix)
+			assert.Len(t, *record.Suffix, 2)
+		}
+	})
+
+	t.Run("should keep words containing the separator together", func(t *testing.T) {
+		record := newExportRecord(0, "cute-guinea-pig-042", memorable.ParseOptions{})
+		assert.Equal(t, []string{"cute", "guinea-pig"}, record.Components)
+		require.NotNil(t, record.Suffix)
+		assert.Equal(t, "042", *record.Suffix)
+	})
+
+	t.Run("should reject unknown formats", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		code := run([]string{"generate", "--format", "xml"}, &stdout, &stderr)
+
+		assert.Equal(t, 2, code)
+		assert.Contains(t, stderr.String(), `unknown format "xml"`)
+	})
+}
@@ -0,0 +1,179 @@
+// Command memorable-ids generates memorable IDs and analyses their
+// collision characteristics from the command line.
+//
+// Usage:
+//
+//	memorable-ids generate [--components 2] [--suffix number] [--separator -] [--count 1] [--preset color-animal] [--format text|csv|tsv|jsonl]
+//	memorable-ids validate [--components 2] [--suffix none] [--separator -] <file|->...
+//	memorable-ids simulate [--components 2] [--suffix number] [--n 1000] [--trials 50]
+//	memorable-ids wordlist fetch [--base URL] [--dir wordlists] <pack>...
+//	memorable-ids wordlist update [--base URL] [--dir wordlists]
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	memorable "github.com/riipandi/memorable-ids"
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+// command is a CLI subcommand
+type command struct {
+	name        string
+	description string
+	run         func(args []string, stdout, stderr io.Writer) int
+}
+
+var commands = []command{
+	{name: "generate", description: "generate memorable IDs", run: runGenerate},
+	{name: "validate", description: "check that every line of a file is a valid ID", run: runValidate},
+	{name: "simulate", description: "compare observed collisions with the analytical prediction", run: runSimulate},
+	{name: "wordlist", description: "download or update curated word packs", run: runWordlist},
+}
+
+// run dispatches args to the matching subcommand and returns the exit code
+func run(args []string, stdout, stderr io.Writer) int {
+	if len(args) == 0 || args[0] == "-h" || args[0] == "--help" || args[0] == "help" {
+		usage(stderr)
+		return 2
+	}
+
+	for _, cmd := range commands {
+		if cmd.name == args[0] {
+			return cmd.run(args[1:], stdout, stderr)
+		}
+	}
+
+	fmt.Fprintf(stderr, "memorable-ids: unknown command %q\n\n", args[0])
+	usage(stderr)
+	return 2
+}
+
+func usage(w io.Writer) {
+	fmt.Fprintln(w, "Usage: memorable-ids <command> [flags]")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Commands:")
+	for _, cmd := range commands {
+		fmt.Fprintf(w, "  %-10s %s\n", cmd.name, cmd.description)
+	}
+}
+
+// lookupSuffix resolves a --suffix flag value by the suffix spec name
+func lookupSuffix(name string) (memorable.SuffixGenerator, error) {
+	if name == "none" {
+		return nil, nil
+	}
+	generator, ok := memorable.SuffixByName(name)
+	if !ok {
+		return nil, fmt.Errorf("unknown suffix %q (expected none, number, number4, hex, timestamp, or letter)", name)
+	}
+	return generator, nil
+}
+
+// newFlagSet creates a flag set for a subcommand writing errors to stderr
+func newFlagSet(name string, stderr io.Writer) *flag.FlagSet {
+	fs := flag.NewFlagSet("memorable-ids "+name, flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	return fs
+}
+
+func runGenerate(args []string, stdout, stderr io.Writer) int {
+	fs := newFlagSet("generate", stderr)
+	components := fs.Int("components", 2, "number of word components (1-5)")
+	suffix := fs.String("suffix", "none", "suffix generator: none, number, number4, hex, timestamp, letter")
+	separator := fs.String("separator", "-", "separator between parts")
+	count := fs.Int("count", 1, "number of IDs to generate")
+	preset := fs.String("preset", "", "named format overriding components and suffix: "+strings.Join(memorable.Presets(), ", "))
+	format := fs.String("format", "text", "output format: "+strings.Join(exportFormats, ", "))
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+
+	generator, err := lookupSuffix(*suffix)
+	if err != nil {
+		fmt.Fprintln(stderr, "memorable-ids:", err)
+		return 2
+	}
+	out, err := newExportWriter(*format, stdout)
+	if err != nil {
+		fmt.Fprintln(stderr, "memorable-ids:", err)
+		return 2
+	}
+	parseOptions := memorable.ParseOptions{Separator: *separator}
+	if *preset == "" {
+		parseOptions.Suffix = generator
+	}
+
+	for i := 0; i < *count; i++ {
+		var id string
+		var err error
+		if *preset != "" {
+			id, err = memorable.GeneratePreset(*preset, *separator)
+		} else {
+			id, err = memorable.Generate(memorable.GenerateOptions{
+				Components: *components,
+				Suffix:     generator,
+				Separator:  *separator,
+			})
+		}
+		if err != nil {
+			fmt.Fprintln(stderr, "memorable-ids:", err)
+			return 1
+		}
+		if err := out.Write(newExportRecord(i, id, parseOptions)); err != nil {
+			fmt.Fprintln(stderr, "memorable-ids:", err)
+			return 1
+		}
+	}
+	if err := out.Flush(); err != nil {
+		fmt.Fprintln(stderr, "memorable-ids:", err)
+		return 1
+	}
+	return 0
+}
+
+func runSimulate(args []string, stdout, stderr io.Writer) int {
+	fs := newFlagSet("simulate", stderr)
+	components := fs.Int("components", 2, "number of word components (1-5)")
+	suffix := fs.String("suffix", "none", "suffix generator: none, number, number4, hex, timestamp, letter")
+	n := fs.Int("n", 1000, "number of IDs generated per trial")
+	trials := fs.Int("trials", 50, "number of independent trials")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+
+	generator, err := lookupSuffix(*suffix)
+	if err != nil {
+		fmt.Fprintln(stderr, "memorable-ids:", err)
+		return 2
+	}
+
+	result, err := memorable.Simulate(memorable.SimulationOptions{
+		Generate: memorable.GenerateOptions{
+			Components: *components,
+			Suffix:     generator,
+		},
+		IDs:    *n,
+		Trials: *trials,
+	})
+	if err != nil {
+		fmt.Fprintln(stderr, "memorable-ids:", err)
+		return 1
+	}
+
+	fmt.Fprintf(stdout, "combinations:          %d\n", result.TotalCombinations)
+	fmt.Fprintf(stdout, "ids per trial:         %d\n", result.IDs)
+	fmt.Fprintf(stdout, "trials:                %d\n", result.Trials)
+	fmt.Fprintf(stdout, "trials with collision: %d\n", result.TrialsWithCollision)
+	fmt.Fprintf(stdout, "observed probability:  %.2f%%\n", result.ObservedProbability*100)
+	fmt.Fprintf(stdout, "predicted probability: %.2f%%\n", result.PredictedProbability*100)
+	fmt.Fprintf(stdout, "mean collisions/trial: %.2f\n", result.MeanCollisions)
+	return 0
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRun(t *testing.T) {
+	t.Run("should print usage without a command", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		code := run(nil, &stdout, &stderr)
+
+		assert.Equal(t, 2, code)
+		assert.Contains(t, stderr.String(), "Usage: memorable-ids")
+	})
+
+	t.Run("should reject unknown commands", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		code := run([]string{"bogus"}, &stdout, &stderr)
+
+		assert.Equal(t, 2, code)
+		assert.Contains(t, stderr.String(), `unknown command "bogus"`)
+	})
+}
+
+func TestGenerateCommand(t *testing.T) {
+	t.Run("should print the requested number of IDs", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		code := run([]string{"generate", "--components", "3", "--count", "4", "--suffix", "hex"}, &stdout, &stderr)
+
+		assert.Equal(t, 0, code, stderr.String())
+		lines := strings.Split(strings.TrimSpace(stdout.String()), "\n")
+		assert.Len(t, lines, 4)
+	})
+
+	t.Run("should generate presets", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		code := run([]string{"generate", "--preset", "color-animal", "--separator", "_"}, &stdout, &stderr)
+
+		assert.Equal(t, 0, code, stderr.String())
+		assert.Len(t, strings.Split(strings.TrimSpace(stdout.String()), "_"), 2)
+	})
+
+	t.Run("should reject unknown suffixes", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		code := run([]string{"generate", "--suffix", "emoji"}, &stdout, &stderr)
+
+		assert.Equal(t, 2, code)
+		assert.Contains(t, stderr.String(), "unknown suffix")
+	})
+}
+
+func TestSimulateCommand(t *testing.T) {
+	t.Run("should print observed and predicted statistics", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		code := run([]string{"simulate", "--components", "2", "--n", "100", "--trials", "5"}, &stdout, &stderr)
+
+		assert.Equal(t, 0, code, stderr.String())
+		assert.Contains(t, stdout.String(), "observed probability:")
+		assert.Contains(t, stdout.String(), "predicted probability:")
+	})
+
+	t.Run("should fail on invalid component count", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		code := run([]string{"simulate", "--components", "7", "--n", "10"}, &stdout, &stderr)
+
+		assert.Equal(t, 1, code)
+		assert.Contains(t, stderr.String(), "components must be between 1 and 5")
+	})
+}
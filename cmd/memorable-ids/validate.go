@@ -0,0 +1,118 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	memorable "github.com/riipandi/memorable-ids"
+)
+
+// lineFailure is a line that doesn't match the expected ID format
+type lineFailure struct {
+	file   string
+	line   int
+	reason string
+}
+
+func runValidate(args []string, stdout, stderr io.Writer) int {
+	fs := newFlagSet("validate", stderr)
+	components := fs.Int("components", 2, "expected number of word components (1-5)")
+	suffix := fs.String("suffix", "none", "expected suffix: none, number, number4, hex, timestamp, letter")
+	separator := fs.String("separator", "-", "separator between parts")
+
+	// Accept flags before and after the file names
+	var files []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			return 2
+		}
+		if fs.NArg() == 0 {
+			break
+		}
+		files = append(files, fs.Arg(0))
+		args = fs.Args()[1:]
+	}
+	if len(files) == 0 {
+		fmt.Fprintln(stderr, "Usage: memorable-ids validate [--components 2] [--suffix none] [--separator -] <file|->...")
+		return 2
+	}
+	if *components < 1 || *components > 5 {
+		fmt.Fprintln(stderr, "memorable-ids: components must be between 1 and 5")
+		return 2
+	}
+
+	generator, err := lookupSuffix(*suffix)
+	if err != nil {
+		fmt.Fprintln(stderr, "memorable-ids:", err)
+		return 2
+	}
+	options := memorable.ValidateOptions{Components: *components, Separator: *separator, Suffix: generator}
+	check := func(id string) string {
+		return validateLine(id, options)
+	}
+
+	checked := 0
+	var failures []lineFailure
+	for _, name := range files {
+		lines, fileFailures, err := validateFile(name, check)
+		if err != nil {
+			fmt.Fprintln(stderr, "memorable-ids:", err)
+			return 1
+		}
+		checked += lines
+		failures = append(failures, fileFailures...)
+	}
+
+	for _, failure := range failures {
+		fmt.Fprintf(stdout, "%s:%d: %s\n", failure.file, failure.line, failure.reason)
+	}
+	fmt.Fprintf(stdout, "%d lines checked, %d invalid\n", checked, len(failures))
+	if len(failures) > 0 {
+		return 1
+	}
+	return 0
+}
+
+// validateFile checks every non-empty line of a file, or of stdin for "-"
+func validateFile(name string, check func(id string) string) (int, []lineFailure, error) {
+	var r io.Reader = os.Stdin
+	if name != "-" {
+		file, err := os.Open(name)
+		if err != nil {
+			return 0, nil, err
+		}
+		defer file.Close()
+		r = file
+	}
+
+	var failures []lineFailure
+	checked := 0
+	scanner := bufio.NewScanner(r)
+	for number := 1; scanner.Scan(); number++ {
+		id := scanner.Text()
+		if id == "" {
+			continue
+		}
+		checked++
+		if reason := check(id); reason != "" {
+			failures = append(failures, lineFailure{file: name, line: number, reason: reason})
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return checked, failures, fmt.Errorf("%s: %w", name, err)
+	}
+	return checked, failures, nil
+}
+
+// validateLine returns why id doesn't match the expected format, or "" if
+// it does. Words containing the separator, such as "guinea-pig", are
+// rejoined before their classes are checked.
+func validateLine(id string, options memorable.ValidateOptions) string {
+	if err := memorable.Validate(id, options); err != nil {
+		return fmt.Sprintf("%q: %s", id, strings.TrimPrefix(err.Error(), memorable.ErrInvalidID.Error()+": "))
+	}
+	return ""
+}
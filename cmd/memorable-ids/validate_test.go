@@ -0,0 +1,85 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeFixture writes lines to a temporary file and returns its path
+func writeFixture(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "ids.txt")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	return path
+}
+
+func TestValidateCommand(t *testing.T) {
+	t.Run("should accept valid files", func(t *testing.T) {
+		path := writeFixture(t, "cute-rabbit-swim-042\nlarge-fox-run-999\n\n")
+
+		var stdout, stderr bytes.Buffer
+		code := run([]string{"validate", path, "--components", "3", "--suffix", "number"}, &stdout, &stderr)
+
+		assert.Equal(t, 0, code, stderr.String())
+		assert.Contains(t, stdout.String(), "2 lines checked, 0 invalid")
+	})
+
+	t.Run("should report invalid lines with their numbers", func(t *testing.T) {
+		path := writeFixture(t, "cute-rabbit-swim-042\ncute-rabbit-042\nfox-cute-swim-042\ncute-rabbit-swim\n")
+
+		var stdout, stderr bytes.Buffer
+		code := run([]string{"validate", "--components", "3", "--suffix", "number", path}, &stdout, &stderr)
+
+		assert.Equal(t, 1, code)
+		out := stdout.String()
+		assert.Contains(t, out, path+`:2: "cute-rabbit-042": 2 words, expected 3`)
+		assert.Contains(t, out, path+`:3: "fox-cute-swim-042": word 1 "fox" is not a known adjective`)
+		assert.Contains(t, out, path+`:4: "cute-rabbit-swim": "swim" is not a number suffix`)
+		assert.Contains(t, out, "4 lines checked, 3 invalid")
+	})
+
+	t.Run("should reject numeric parts when no suffix is expected", func(t *testing.T) {
+		path := writeFixture(t, "cute-rabbit\ncute-rabbit-042\n")
+
+		var stdout, stderr bytes.Buffer
+		code := run([]string{"validate", path}, &stdout, &stderr)
+
+		assert.Equal(t, 1, code)
+		assert.Contains(t, stdout.String(), `:2: "cute-rabbit-042": word 3 "042" is not a known verb`)
+	})
+
+	t.Run("should accept words containing the separator", func(t *testing.T) {
+		path := writeFixture(t, "cute-guinea-pig\nfair-guinea-pig\n")
+
+		var stdout, stderr bytes.Buffer
+		code := run([]string{"validate", path}, &stdout, &stderr)
+		assert.Equal(t, 0, code, stdout.String())
+		assert.Contains(t, stdout.String(), "2 lines checked, 0 invalid")
+
+		path = writeFixture(t, "cute-guinea-pig-042\n")
+		stdout.Reset()
+		code = run([]string{"validate", "--suffix", "number", path}, &stdout, &stderr)
+		assert.Equal(t, 0, code, stdout.String())
+	})
+
+	t.Run("should require a file", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		code := run([]string{"validate", "--components", "3"}, &stdout, &stderr)
+
+		assert.Equal(t, 2, code)
+		assert.Contains(t, stderr.String(), "Usage: memorable-ids validate")
+	})
+
+	t.Run("should fail on missing files", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		code := run([]string{"validate", filepath.Join(t.TempDir(), "missing.txt")}, &stdout, &stderr)
+
+		assert.Equal(t, 1, code)
+		assert.Contains(t, stderr.String(), "missing.txt")
+	})
+}
@@ -0,0 +1,172 @@
+package main
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	memorable "github.com/riipandi/memorable-ids"
+)
+
+// defaultPackBaseURL is the release location of the curated word packs
+const defaultPackBaseURL = "https://github.com/riipandi/memorable-ids/releases/latest/download"
+
+// packExtension is the file extension of word packs, both in releases and on disk
+const packExtension = ".dict"
+
+// maxPackSize bounds the size of a downloaded word pack
+const maxPackSize = 8 << 20
+
+var httpClient = &http.Client{Timeout: 30 * time.Second}
+
+func runWordlist(args []string, stdout, stderr io.Writer) int {
+	if len(args) == 0 || (args[0] != "fetch" && args[0] != "update") {
+		fmt.Fprintln(stderr, "Usage: memorable-ids wordlist fetch [--base URL] [--dir wordlists] <pack>...")
+		fmt.Fprintln(stderr, "       memorable-ids wordlist update [--base URL] [--dir wordlists]")
+		return 2
+	}
+	action := args[0]
+
+	fs := newFlagSet("wordlist "+action, stderr)
+	base := fs.String("base", defaultPackBaseURL, "base URL of the release artifacts")
+	dir := fs.String("dir", "wordlists", "directory the packs are written to")
+	if err := fs.Parse(args[1:]); err != nil {
+		return 2
+	}
+
+	packs := fs.Args()
+	if action == "update" {
+		installed, err := installedPacks(*dir)
+		if err != nil {
+			fmt.Fprintln(stderr, "memorable-ids:", err)
+			return 1
+		}
+		packs = installed
+	}
+	if len(packs) == 0 {
+		fmt.Fprintln(stderr, "memorable-ids: no word packs to", action)
+		return 2
+	}
+
+	if err := os.MkdirAll(*dir, 0o755); err != nil {
+		fmt.Fprintln(stderr, "memorable-ids:", err)
+		return 1
+	}
+
+	for _, pack := range packs {
+		dict, err := fetchPack(*base, pack)
+		if err != nil {
+			fmt.Fprintf(stderr, "memorable-ids: pack %s: %v\n", pack, err)
+			return 1
+		}
+
+		path := filepath.Join(*dir, pack+packExtension)
+		if err := writePack(path, dict); err != nil {
+			fmt.Fprintf(stderr, "memorable-ids: pack %s: %v\n", pack, err)
+			return 1
+		}
+
+		stats := dict.Stats
+		total := stats.Adjectives + stats.Nouns + stats.Verbs + stats.Adverbs + stats.Prepositions
+		fmt.Fprintf(stdout, "%s: %d words -> %s\n", pack, total, path)
+	}
+	return 0
+}
+
+// installedPacks returns the names of the packs present in dir
+func installedPacks(dir string) ([]string, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*"+packExtension))
+	if err != nil {
+		return nil, err
+	}
+
+	packs := make([]string, 0, len(paths))
+	for _, path := range paths {
+		packs = append(packs, strings.TrimSuffix(filepath.Base(path), packExtension))
+	}
+	return packs, nil
+}
+
+// fetchPack downloads a pack and its published SHA-256 checksum, verifies
+// the checksum, and parses and validates the dictionary
+func fetchPack(base, pack string) (memorable.Dictionary, error) {
+	if pack == "" || strings.ContainsAny(pack, `/\`) || strings.HasPrefix(pack, ".") {
+		return memorable.Dictionary{}, fmt.Errorf("invalid pack name %q", pack)
+	}
+
+	url := strings.TrimSuffix(base, "/") + "/" + pack + packExtension
+	body, err := download(url)
+	if err != nil {
+		return memorable.Dictionary{}, err
+	}
+	checksum, err := download(url + ".sha256")
+	if err != nil {
+		return memorable.Dictionary{}, err
+	}
+
+	// Checksum files follow the sha256sum layout: "<hex>  <filename>"
+	fields := strings.Fields(string(checksum))
+	if len(fields) == 0 {
+		return memorable.Dictionary{}, fmt.Errorf("empty checksum file")
+	}
+	sum := sha256.Sum256(body)
+	if !strings.EqualFold(fields[0], hex.EncodeToString(sum[:])) {
+		return memorable.Dictionary{}, fmt.Errorf("checksum mismatch")
+	}
+
+	dict, err := memorable.ReadDictionary(bytes.NewReader(body))
+	if err != nil {
+		return memorable.Dictionary{}, err
+	}
+	if err := dict.Validate(); err != nil {
+		return memorable.Dictionary{}, err
+	}
+	return dict, nil
+}
+
+// download fetches url, failing on non-200 responses and oversized bodies
+func download(url string) ([]byte, error) {
+	resp, err := httpClient.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("GET %s: %s", url, resp.Status)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxPackSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(body) > maxPackSize {
+		return nil, fmt.Errorf("GET %s: response exceeds %d bytes", url, maxPackSize)
+	}
+	return body, nil
+}
+
+// writePack writes dict to path atomically so a failed update keeps the old pack
+func writePack(path string, dict memorable.Dictionary) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".pack-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if err := memorable.WriteDictionary(tmp, dict); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
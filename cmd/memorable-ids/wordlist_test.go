@@ -0,0 +1,99 @@
+package main
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const fantasyPack = "# fantasy\n[adjective]\nancient\n[noun]\ndragon\n[verb]\nsoar\n[adverb]\nboldly\n[preposition]\nbeyond\n"
+
+// packServer serves word packs with their checksums, corrupting the checksum of bad packs
+func packServer(t *testing.T, packs map[string]string, bad map[string]bool) *httptest.Server {
+	mux := http.NewServeMux()
+	for name, body := range packs {
+		sum := sha256.Sum256([]byte(body))
+		checksum := hex.EncodeToString(sum[:])
+		if bad[name] {
+			checksum = "00" + checksum[2:]
+		}
+		mux.HandleFunc("/"+name+".dict", func(w http.ResponseWriter, _ *http.Request) {
+			w.Write([]byte(body))
+		})
+		mux.HandleFunc("/"+name+".dict.sha256", func(w http.ResponseWriter, _ *http.Request) {
+			w.Write([]byte(checksum + "  " + name + ".dict\n"))
+		})
+	}
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestWordlistCommand(t *testing.T) {
+	t.Run("should fetch and verify packs", func(t *testing.T) {
+		server := packServer(t, map[string]string{"fantasy": fantasyPack}, nil)
+		dir := t.TempDir()
+
+		var stdout, stderr bytes.Buffer
+		code := run([]string{"wordlist", "fetch", "--base", server.URL, "--dir", dir, "fantasy"}, &stdout, &stderr)
+
+		assert.Equal(t, 0, code, stderr.String())
+		assert.Contains(t, stdout.String(), "fantasy: 5 words")
+
+		written, err := os.ReadFile(filepath.Join(dir, "fantasy.dict"))
+		require.NoError(t, err)
+		assert.Contains(t, string(written), "[noun]\ndragon\n")
+	})
+
+	t.Run("should reject packs with a bad checksum", func(t *testing.T) {
+		server := packServer(t, map[string]string{"fantasy": fantasyPack}, map[string]bool{"fantasy": true})
+		dir := t.TempDir()
+
+		var stdout, stderr bytes.Buffer
+		code := run([]string{"wordlist", "fetch", "--base", server.URL, "--dir", dir, "fantasy"}, &stdout, &stderr)
+
+		assert.Equal(t, 1, code)
+		assert.Contains(t, stderr.String(), "checksum mismatch")
+		assert.NoFileExists(t, filepath.Join(dir, "fantasy.dict"))
+	})
+
+	t.Run("should reject incomplete packs", func(t *testing.T) {
+		server := packServer(t, map[string]string{"nouns": "[noun]\ndragon\n"}, nil)
+
+		var stdout, stderr bytes.Buffer
+		code := run([]string{"wordlist", "fetch", "--base", server.URL, "--dir", t.TempDir(), "nouns"}, &stdout, &stderr)
+
+		assert.Equal(t, 1, code)
+		assert.Contains(t, stderr.String(), "dictionary word class is empty")
+	})
+
+	t.Run("should update installed packs", func(t *testing.T) {
+		server := packServer(t, map[string]string{"fantasy": fantasyPack}, nil)
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "fantasy.dict"), []byte("stale"), 0o644))
+
+		var stdout, stderr bytes.Buffer
+		code := run([]string{"wordlist", "update", "--base", server.URL, "--dir", dir}, &stdout, &stderr)
+
+		assert.Equal(t, 0, code, stderr.String())
+		written, err := os.ReadFile(filepath.Join(dir, "fantasy.dict"))
+		require.NoError(t, err)
+		assert.Contains(t, string(written), "ancient")
+	})
+
+	t.Run("should reject path-like pack names", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		code := run([]string{"wordlist", "fetch", "--dir", t.TempDir(), "../etc"}, &stdout, &stderr)
+
+		assert.Equal(t, 1, code)
+		assert.Contains(t, stderr.String(), "invalid pack name")
+	})
+}
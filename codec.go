@@ -0,0 +1,158 @@
+package memorable_ids
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+/**
+ * Mixed-radix word codec
+ *
+ * Represents integers as word tuples by treating each word class as a
+ * digit whose base is the class size. Classes cycle in component order
+ * (adjective, noun, verb, adverb, preposition, adjective, ...), and the
+ * first word holds the least significant digit. Shared by the reversible
+ * encoders built on top of the dictionaries.
+ */
+
+// radixClasses returns the shortest class sequence able to represent every value below 2^bits
+func radixClasses(dict Dictionary, bits int) []WordClass {
+	limit := new(big.Int).Lsh(big.NewInt(1), uint(bits))
+	capacity := big.NewInt(1)
+
+	var classes []WordClass
+	for capacity.Cmp(limit) < 0 {
+		class := wordClasses[len(classes)%len(wordClasses)]
+		capacity.Mul(capacity, big.NewInt(int64(len(dict.Words(class)))))
+		classes = append(classes, class)
+	}
+	return classes
+}
+
+// encodeRadix converts value into one word per class
+func encodeRadix(dict Dictionary, value *big.Int, classes []WordClass) []string {
+	rest := new(big.Int).Set(value)
+	digit := new(big.Int)
+
+	words := make([]string, len(classes))
+	for i, class := range classes {
+		list := dict.Words(class)
+		rest.DivMod(rest, big.NewInt(int64(len(list))), digit)
+		words[i] = list[digit.Int64()]
+	}
+	return words
+}
+
+// decodeRadix converts one word per class back into its value
+func decodeRadix(dict Dictionary, words []string, classes []WordClass) (*big.Int, error) {
+	if len(words) != len(classes) {
+		return nil, fmt.Errorf("expected %d words, got %d", len(classes), len(words))
+	}
+
+	value := new(big.Int)
+	for i := len(classes) - 1; i >= 0; i-- {
+		list := dict.Words(classes[i])
+		index := dict.IndexOf(classes[i], words[i])
+		if index < 0 {
+			return nil, fmt.Errorf("%q is not a known %s", words[i], classes[i])
+		}
+		value.Mul(value, big.NewInt(int64(len(list))))
+		value.Add(value, big.NewInt(int64(index)))
+	}
+	return value, nil
+}
+
+// splitClassWords splits id on separator into one word per expected class,
+// rejoining parts for dictionary words that contain the separator themselves
+// (e.g. "guinea-pig")
+func splitClassWords(dict Dictionary, id, separator string, classes []WordClass) ([]string, error) {
+	words, rest, err := takeClassWords(dict, strings.Split(id, separator), separator, classes)
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) > 0 {
+		return nil, fmt.Errorf("expected %d words, got %d extra parts", len(classes), len(rest))
+	}
+	return words, nil
+}
+
+// takeClassWords takes one word per expected class from the leading parts
+// like splitClassWords, and returns the parts left over, such as a suffix
+func takeClassWords(dict Dictionary, parts []string, separator string, classes []WordClass) ([]string, []string, error) {
+	words := make([]string, 0, len(classes))
+
+	for _, class := range classes {
+		if len(parts) == 0 {
+			return nil, nil, fmt.Errorf("expected %d words, got %d", len(classes), len(words))
+		}
+
+		// Prefer the longest dictionary word spanning several parts
+		taken := 1
+		for span := len(parts); span > 1; span-- {
+			if dict.Contains(class, strings.Join(parts[:span], separator)) {
+				taken = span
+				break
+			}
+		}
+
+		words = append(words, strings.Join(parts[:taken], separator))
+		parts = parts[taken:]
+	}
+	return words, parts, nil
+}
+
+// cycleClasses returns n classes cycling in component order, starting at offset
+func cycleClasses(offset, n int) []WordClass {
+	classes := make([]WordClass, n)
+	for i := range classes {
+		classes[i] = wordClasses[(offset+i)%len(wordClasses)]
+	}
+	return classes
+}
+
+// splitCycleWords splits id on separator into words whose classes cycle in
+// component order, for encodings whose word count is not known upfront
+func splitCycleWords(dict Dictionary, id, separator string) ([]string, error) {
+	parts := strings.Split(id, separator)
+	var words []string
+
+	for len(parts) > 0 {
+		class := wordClasses[len(words)%len(wordClasses)]
+
+		taken := 0
+		for span := len(parts); span > 0; span-- {
+			if dict.Contains(class, strings.Join(parts[:span], separator)) {
+				taken = span
+				break
+			}
+		}
+		if taken == 0 {
+			return nil, fmt.Errorf("word %d %q is not a known %s", len(words)+1, parts[0], class)
+		}
+
+		words = append(words, strings.Join(parts[:taken], separator))
+		parts = parts[taken:]
+	}
+	return words, nil
+}
+
+// nameFromHash deterministically derives components words followed by an
+// optional decimal suffix of the given number of digits from a hash
+func nameFromHash(dict Dictionary, hash []byte, components, digits int, separator string) string {
+	value := new(big.Int).SetBytes(hash)
+	words := encodeRadix(dict, value, wordClasses[:components])
+
+	if digits > 0 {
+		// Use the hash bits not consumed by the words for the suffix
+		capacity := big.NewInt(1)
+		for _, class := range wordClasses[:components] {
+			capacity.Mul(capacity, big.NewInt(int64(len(dict.Words(class)))))
+		}
+		modulus := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(digits)), nil)
+		suffix := new(big.Int).Mod(value.Div(value, capacity), modulus)
+		words = append(words, fmt.Sprintf("%0*d", digits, suffix))
+	}
+
+	return strings.Join(words, separator)
+}
@@ -0,0 +1,125 @@
+package memorable_ids
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Codename is a release codename issued by Codenames
+type Codename struct {
+	// Release is the 1-based release number
+	Release int
+	// Letter is the initial shared by both words
+	Letter byte
+	// Name is the alliterative adjective-animal pair, e.g. "dapper-dove"
+	Name string
+}
+
+// Codenames issues Ubuntu-style release codenames: an alliterative
+// adjective and animal, advancing one letter per release and wrapping
+// around after the last usable letter. Letters without an alliterative
+// pair in the dictionary are skipped.
+//
+// The progression lives in the Store under keys prefixed with "codename/",
+// so concurrent or restarted generators sharing a store never reuse a name
+// or issue releases out of order; a new generator resumes after the last
+// claimed release when the store implements Lister. Once every pair of a letter has been
+// used, its release fails with ErrSpaceExhausted and the release number
+// stays claimed. History requires a store implementing Lister.
+//
+// Example:
+//
+//	codenames := NewCodenames(NewMemoryStore())
+//	codenames.Next() // Codename{Release: 1, Letter: 'a', Name: "able-ant"}
+//	codenames.Next() // Codename{Release: 2, Letter: 'b', Name: "brave-badger"}
+type Codenames struct {
+	store    Store
+	releases *sequence
+	letters  []byte
+	pairs    map[byte][][2]string
+}
+
+// codenameKeyPrefix namespaces the codename keys in the store
+const codenameKeyPrefix = "codename/"
+
+// NewCodenames creates a codename generator persisting its progression in store
+func NewCodenames(store Store) *Codenames {
+	c := &Codenames{
+		store:    store,
+		releases: &sequence{store: store, prefix: codenameKeyPrefix + "release/"},
+		pairs:    make(map[byte][][2]string),
+	}
+	for letter := byte('a'); letter <= 'z'; letter++ {
+		for _, adjective := range Adjectives {
+			if adjective[0] != letter {
+				continue
+			}
+			for _, animal := range Animals {
+				if animal[0] == letter {
+					c.pairs[letter] = append(c.pairs[letter], [2]string{adjective, animal})
+				}
+			}
+		}
+		if len(c.pairs[letter]) > 0 {
+			c.letters = append(c.letters, letter)
+		}
+	}
+	return c
+}
+
+// Next claims the next release number and issues its codename
+func (c *Codenames) Next() (Codename, error) {
+	release, err := c.releases.claim()
+	if err != nil {
+		return Codename{}, err
+	}
+
+	letter := c.letters[(release-1)%len(c.letters)]
+	pairs := c.pairs[letter]
+	for _, i := range rand.Perm(len(pairs)) {
+		name := pairs[i][0] + "-" + pairs[i][1]
+		reserved, err := c.store.Reserve(codenameKeyPrefix + "name/" + name)
+		if err != nil {
+			return Codename{}, err
+		}
+		if !reserved {
+			continue
+		}
+
+		if _, err := c.store.Reserve(fmt.Sprintf("%shistory/%d/%s", codenameKeyPrefix, release, name)); err != nil {
+			return Codename{}, err
+		}
+		return Codename{Release: release, Letter: letter, Name: name}, nil
+	}
+	return Codename{}, fmt.Errorf("release %d: every %q codename is taken: %w", release, letter, ErrSpaceExhausted)
+}
+
+// History returns the issued codenames in release order
+func (c *Codenames) History() ([]Codename, error) {
+	keys, ok, err := listPrefix(c.store, codenameKeyPrefix+"history/")
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, errors.New("codename history requires a store implementing Lister")
+	}
+
+	var history []Codename
+	for _, key := range keys {
+		entry := strings.TrimPrefix(key, codenameKeyPrefix+"history/")
+		number, name, ok := strings.Cut(entry, "/")
+		release, err := strconv.Atoi(number)
+		if !ok || err != nil || name == "" {
+			continue
+		}
+		history = append(history, Codename{Release: release, Letter: name[0], Name: name})
+	}
+
+	// Keys sort lexically ("10" < "2"), so order by release number
+	slices.SortFunc(history, func(a, b Codename) int { return a.Release - b.Release })
+	return history, nil
+}
@@ -0,0 +1,130 @@
+package memorable_ids
+
+import (
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// reserveOnlyStore hides the Lister implementation of its store
+type reserveOnlyStore struct{ Store }
+
+// countingStore counts the reservations of IDs starting with prefix
+type countingStore struct {
+	*MemoryStore
+	prefix   string
+	reserves int
+}
+
+func (s *countingStore) Reserve(id string) (bool, error) {
+	if strings.HasPrefix(id, s.prefix) {
+		s.reserves++
+	}
+	return s.MemoryStore.Reserve(id)
+}
+
+func TestCodenames(t *testing.T) {
+	t.Run("should advance one letter per release with alliterative names", func(t *testing.T) {
+		codenames := NewCodenames(NewMemoryStore())
+
+		previous := byte(0)
+		for release := 1; release <= 10; release++ {
+			codename, err := codenames.Next()
+			require.NoError(t, err)
+
+			assert.Equal(t, release, codename.Release)
+			assert.Greater(t, codename.Letter, previous)
+			previous = codename.Letter
+
+			adjective, animal, ok := strings.Cut(codename.Name, "-")
+			require.True(t, ok)
+			assert.Equal(t, codename.Letter, adjective[0])
+			assert.Equal(t, codename.Letter, animal[0])
+			assert.Contains(t, Adjectives, adjective)
+			assert.Contains(t, Animals, animal)
+		}
+	})
+
+	t.Run("should resume the progression from the store", func(t *testing.T) {
+		store := NewMemoryStore()
+
+		first, err := NewCodenames(store).Next()
+		require.NoError(t, err)
+		second, err := NewCodenames(store).Next()
+		require.NoError(t, err)
+
+		assert.Equal(t, 1, first.Release)
+		assert.Equal(t, 2, second.Release)
+		assert.Equal(t, byte('a'), first.Letter)
+		assert.Equal(t, byte('b'), second.Letter)
+	})
+
+	t.Run("should resume after the last release instead of probing from 1", func(t *testing.T) {
+		store := &countingStore{MemoryStore: NewMemoryStore(), prefix: codenameKeyPrefix + "release/"}
+		for range 20 {
+			_, err := NewCodenames(store).Next()
+			require.NoError(t, err)
+		}
+
+		store.reserves = 0
+		codename, err := NewCodenames(store).Next()
+		require.NoError(t, err)
+		assert.Equal(t, 21, codename.Release)
+		assert.Equal(t, 1, store.reserves, "Expected the release claim to take one reservation")
+	})
+
+	t.Run("should keep Animals independent of Nouns", func(t *testing.T) {
+		original := slices.Clone(Nouns)
+		_ = append(Animals, "zebra")
+		Animals[0] = "unicorn"
+		defer func() { Animals[0] = original[0] }()
+
+		assert.Equal(t, original, Nouns)
+	})
+
+	t.Run("should never reuse names across concurrent generators", func(t *testing.T) {
+		store := NewMemoryStore()
+
+		var wg sync.WaitGroup
+		results := make([]Codename, 16)
+		for i := range results {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				results[i], _ = NewCodenames(store).Next()
+			}()
+		}
+		wg.Wait()
+
+		history, err := NewCodenames(store).History()
+		require.NoError(t, err)
+
+		names := make(map[string]bool)
+		for i, codename := range history {
+			assert.Equal(t, i+1, codename.Release, "Expected releases without gaps")
+			assert.False(t, names[codename.Name], "Expected %s to be issued once", codename.Name)
+			names[codename.Name] = true
+		}
+		assert.Len(t, history, len(results))
+	})
+
+	t.Run("should fail once a letter runs out of names", func(t *testing.T) {
+		codenames := NewCodenames(NewMemoryStore())
+		for _, pair := range codenames.pairs['a'] {
+			_, err := codenames.store.Reserve(codenameKeyPrefix + "name/" + pair[0] + "-" + pair[1])
+			require.NoError(t, err)
+		}
+
+		_, err := codenames.Next()
+		assert.ErrorIs(t, err, ErrSpaceExhausted)
+	})
+
+	t.Run("should require a Lister for history", func(t *testing.T) {
+		_, err := NewCodenames(reserveOnlyStore{NewMemoryStore()}).History()
+		assert.ErrorContains(t, err, "Lister")
+	})
+}
@@ -0,0 +1,77 @@
+package memorable_ids
+
+import (
+	"strings"
+	"unsafe"
+)
+
+/**
+ * Compact dictionaries
+ *
+ * Dictionaries read from text hold every word in its own small heap
+ * allocation. A compact dictionary stores each class as one concatenated
+ * string, with the word slice acting as the offsets array into it: two
+ * heap objects per class instead of one per word, and neighbouring words
+ * sharing cache lines. Words stay ordinary strings, so every existing API
+ * works unchanged.
+ */
+
+// compactWords returns words backed by a single concatenated string
+func compactWords(words []string) []string {
+	if words == nil {
+		return nil
+	}
+
+	size := 0
+	for _, word := range words {
+		size += len(word)
+	}
+	var text strings.Builder
+	text.Grow(size)
+	for _, word := range words {
+		text.WriteString(word)
+	}
+	concatenated := text.String()
+
+	compacted := make([]string, len(words))
+	offset := 0
+	for i, word := range words {
+		compacted[i] = concatenated[offset : offset+len(word)]
+		offset += len(word)
+	}
+	return compacted
+}
+
+// isCompact reports whether words are laid out back to back in one
+// string. Addresses are compared as integers: a pointer one past the end
+// of a word isn't a valid pointer, which checkptr rejects.
+func isCompact(words []string) bool {
+	for i := 1; i < len(words); i++ {
+		previous := words[i-1]
+		if len(previous) == 0 || len(words[i]) == 0 {
+			continue
+		}
+		end := uintptr(unsafe.Pointer(unsafe.StringData(previous))) + uintptr(len(previous))
+		if end != uintptr(unsafe.Pointer(unsafe.StringData(words[i]))) {
+			return false
+		}
+	}
+	return true
+}
+
+// Compact returns a copy of the dictionary that stores each class as one
+// concatenated string, reducing heap objects for large custom dictionaries.
+// ReadDictionary returns compact dictionaries already.
+//
+// Example:
+//
+//	dict := NewDictionary(adjectives, nouns, nil, nil, nil).Compact()
+//	dict.Nouns[0] // "dragon", backed by the shared noun string
+func (d Dictionary) Compact() Dictionary {
+	return d.mapClasses(func(_ WordClass, words []string) []string {
+		if isCompact(words) {
+			return words
+		}
+		return compactWords(words)
+	})
+}
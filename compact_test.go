@@ -0,0 +1,40 @@
+package memorable_ids
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompact(t *testing.T) {
+	t.Run("should keep words and lookups unchanged", func(t *testing.T) {
+		dict := GetDictionary().Compact()
+		for _, class := range allWordClasses {
+			assert.Equal(t, GetDictionary().Words(class), dict.Words(class), class.String())
+			assert.True(t, isCompact(dict.Words(class)), class.String())
+		}
+		assert.Equal(t, GetDictionaryStats(), dict.Stats)
+		assert.Equal(t, GetDictionary().IndexOf(ClassNoun, "rabbit"), dict.IndexOf(ClassNoun, "rabbit"))
+	})
+
+	t.Run("should not copy compact dictionaries again", func(t *testing.T) {
+		dict := GetDictionary().Compact()
+		again := dict.Compact()
+		assert.Equal(t, headerOf(dict.Nouns), headerOf(again.Nouns))
+		assert.Same(t, &dict.Nouns[0], &again.Nouns[0])
+	})
+
+	t.Run("should read dictionaries compactly", func(t *testing.T) {
+		dict, err := ReadDictionary(strings.NewReader("[adjective]\nancient\nbrave\n[noun]\ndragon\nknight\n"))
+		require.NoError(t, err)
+		assert.Equal(t, []string{"dragon", "knight"}, dict.Nouns)
+		assert.True(t, isCompact(dict.Adjectives))
+		assert.True(t, isCompact(dict.Nouns))
+	})
+
+	t.Run("should detect scattered words", func(t *testing.T) {
+		assert.False(t, isCompact([]string{strings.Clone("ancient"), strings.Clone("dragon")}))
+	})
+}
@@ -0,0 +1,142 @@
+package memorable_ids
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"text/tabwriter"
+)
+
+// ConfigReport summarizes one configuration of a ConfigComparison
+type ConfigReport struct {
+	// Options are the compared generation options
+	Options GenerateOptions
+	// Label describes the configuration, e.g. "2 words + hex"
+	Label string
+	// Combinations is the number of distinct IDs, saturating at math.MaxInt
+	Combinations int
+	// EntropyBits is log2 of the number of distinct IDs
+	EntropyBits float64
+	// MaxLength is the length in characters of the longest ID
+	MaxLength int
+	// Probabilities are the collision probabilities at each compared volume
+	Probabilities []float64
+}
+
+// ConfigComparison is a side-by-side report of several configurations
+type ConfigComparison struct {
+	// Volumes are the ID counts the collision probabilities are computed for
+	Volumes []int
+	// Configs holds one report per configuration, in the order given
+	Configs []ConfigReport
+}
+
+// CompareConfigs compares configurations side by side at the volumes of
+// DefaultAnalysisScenarios, to help choose between alternatives such as
+// 3 words and 2 words with a hex suffix. Time-derived suffixes count as a
+// 1x multiplier; custom suffixes must be a SuffixProvider.
+//
+// Example:
+//
+//	comparison, _ := CompareConfigs(
+//	  GenerateOptions{Components: 3},
+//	  GenerateOptions{Components: 2, Suffix: SuffixGenerators.Hex},
+//	)
+//	fmt.Print(comparison)
+//	// config         combinations  entropy  max length  p(50)  p(100)  ...
+//	// 3 words        250,560       17.93    31          0.50%  1.98%   ...
+//	// 2 words + hex  1,603,584     20.61    25          0.08%  0.31%   ...
+func CompareConfigs(options ...GenerateOptions) (ConfigComparison, error) {
+	return CompareConfigsAt(DefaultAnalysisScenarios, options...)
+}
+
+// CompareConfigsAt compares configurations like CompareConfigs at the given volumes
+func CompareConfigsAt(volumes []int, options ...GenerateOptions) (ConfigComparison, error) {
+	comparison := ConfigComparison{Volumes: volumes, Configs: make([]ConfigReport, 0, len(options))}
+
+	for i, option := range options {
+		report, err := newConfigReport(option, volumes)
+		if err != nil {
+			return ConfigComparison{}, fmt.Errorf("config %d: %w", i+1, err)
+		}
+		comparison.Configs = append(comparison.Configs, report)
+	}
+	return comparison, nil
+}
+
+// newConfigReport computes the report of a single configuration
+func newConfigReport(options GenerateOptions, volumes []int) (ConfigReport, error) {
+	classes, err := options.classes()
+	if err != nil {
+		return ConfigReport{}, err
+	}
+
+	suffixRange, err := options.suffixRange()
+	if err != nil {
+		return ConfigReport{}, err
+	}
+	maxLength, err := MaxIDLength(options)
+	if err != nil {
+		return ConfigReport{}, err
+	}
+
+	dict := options.dictionary()
+	entropy := math.Log2(float64(suffixRange))
+	for _, class := range classes {
+		entropy += math.Log2(float64(len(dict.Words(class))))
+	}
+
+	label := fmt.Sprintf("%d words", len(classes))
+	switch {
+	case options.Pattern != "":
+		label = options.Pattern
+	case len(classes) == 1:
+		label = "1 word"
+	}
+	if info, ok := options.suffixInfo(); ok {
+		label += " + " + info.name
+	} else if options.hasSuffix() {
+		label += " + suffix"
+	}
+
+	combinations, err := options.combinations(suffixRange)
+	if err != nil {
+		return ConfigReport{}, err
+	}
+	probabilities := make([]float64, len(volumes))
+	for i, volume := range volumes {
+		probabilities[i] = CalculateCollisionProbability(combinations, volume)
+	}
+
+	return ConfigReport{
+		Options:       options,
+		Label:         label,
+		Combinations:  combinations,
+		EntropyBits:   entropy,
+		MaxLength:     maxLength,
+		Probabilities: probabilities,
+	}, nil
+}
+
+// String renders the comparison as an aligned table, one row per configuration
+func (c ConfigComparison) String() string {
+	var b strings.Builder
+	w := tabwriter.NewWriter(&b, 0, 0, 2, ' ', 0)
+
+	fmt.Fprint(w, "config\tcombinations\tentropy\tmax length")
+	for _, volume := range c.Volumes {
+		fmt.Fprintf(w, "\tp(%d)", volume)
+	}
+	fmt.Fprintln(w)
+
+	for _, report := range c.Configs {
+		fmt.Fprintf(w, "%s\t%s\t%.2f\t%d", report.Label, FormatEnglish.Integer(report.Combinations), report.EntropyBits, report.MaxLength)
+		for _, probability := range report.Probabilities {
+			fmt.Fprintf(w, "\t%s", FormatDefault.Percentage(probability))
+		}
+		fmt.Fprintln(w)
+	}
+
+	w.Flush()
+	return b.String()
+}
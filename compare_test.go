@@ -0,0 +1,65 @@
+package memorable_ids
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompareConfigs(t *testing.T) {
+	t.Run("should report every configuration in order", func(t *testing.T) {
+		comparison, err := CompareConfigs(
+			GenerateOptions{Components: 3},
+			GenerateOptions{Components: 2, Suffix: SuffixGenerators.Hex},
+		)
+		require.NoError(t, err)
+		require.Len(t, comparison.Configs, 2)
+		assert.Equal(t, DefaultAnalysisScenarios, comparison.Volumes)
+
+		words := comparison.Configs[0]
+		assert.Equal(t, "3 words", words.Label)
+		assert.Equal(t, CalculateCombinations(3, 1), words.Combinations)
+		maxLength, _ := MaxIDLength(GenerateOptions{Components: 3})
+		assert.Equal(t, maxLength, words.MaxLength)
+
+		hex := comparison.Configs[1]
+		assert.Equal(t, "2 words + hex", hex.Label)
+		assert.Equal(t, CalculateCombinations(2, 256), hex.Combinations)
+		assert.InDelta(t, 20.61, hex.EntropyBits, 0.01)
+		assert.Len(t, hex.Probabilities, len(DefaultAnalysisScenarios))
+		assert.Less(t, hex.Probabilities[0], words.Probabilities[0])
+	})
+
+	t.Run("should compute probabilities at the given volumes", func(t *testing.T) {
+		comparison, err := CompareConfigsAt([]int{100, 1000}, GenerateOptions{})
+		require.NoError(t, err)
+
+		total := CalculateCombinations(2, 1)
+		assert.Equal(t, []float64{
+			CalculateCollisionProbability(total, 100),
+			CalculateCollisionProbability(total, 1000),
+		}, comparison.Configs[0].Probabilities)
+	})
+
+	t.Run("should render a side-by-side table", func(t *testing.T) {
+		comparison, err := CompareConfigsAt([]int{50}, GenerateOptions{Components: 1}, GenerateOptions{Suffix: SuffixGenerators.Number})
+		require.NoError(t, err)
+
+		lines := strings.Split(strings.TrimSpace(comparison.String()), "\n")
+		require.Len(t, lines, 3)
+		assert.True(t, strings.HasPrefix(lines[0], "config"))
+		assert.Contains(t, lines[0], "p(50)")
+		assert.True(t, strings.HasPrefix(lines[1], "1 word "))
+		assert.True(t, strings.HasPrefix(lines[2], "2 words + number "))
+	})
+
+	t.Run("should reject invalid configurations", func(t *testing.T) {
+		_, err := CompareConfigs(GenerateOptions{}, GenerateOptions{Components: 7})
+		assert.ErrorContains(t, err, "config 2")
+
+		_, err = CompareConfigs(GenerateOptions{Suffix: func() *string { return nil }})
+		assert.ErrorIs(t, err, ErrUnknownSuffixRange)
+	})
+}
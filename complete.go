@@ -0,0 +1,129 @@
+package memorable_ids
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// completionSuffixDigits is the width of the numeric suffixes Complete enumerates
+const completionSuffixDigits = 3
+
+// Complete returns up to limit valid IDs starting with a partially typed
+// ID, for autocomplete against the combination space of the built-in
+// dictionary. Words before the last separator must be complete; the last
+// part is completed as a word of its positional class, or as a 3-digit
+// suffix when it is numeric. IDs have at least two components, so a
+// typed adjective is completed with nouns. Completions are in dictionary
+// order.
+//
+// Example:
+//
+//	Complete("cute-ra", 3)        // ["cute-rabbit", "cute-rat"]
+//	Complete("cute-rabbit-s", 2)  // ["cute-rabbit-sing", "cute-rabbit-sit"]
+//	Complete("cute-rabbit-04", 2) // ["cute-rabbit-040", "cute-rabbit-041"]
+func Complete(prefix string, limit int) []string {
+	if limit < 1 {
+		return nil
+	}
+	parts := strings.Split(Canonicalize(prefix), "-")
+	typed, last := parts[:len(parts)-1], parts[len(parts)-1]
+	if len(typed) > len(wordClasses) {
+		return nil
+	}
+
+	dict := GetDictionary()
+	for i, word := range typed {
+		if i == len(wordClasses) || !dict.Contains(wordClasses[i], word) {
+			return nil
+		}
+	}
+	head := strings.Join(typed, "-")
+	if head != "" {
+		head += "-"
+	}
+
+	if len(typed) >= 2 && isDigits(last) {
+		return completeSuffix(head, last, limit)
+	}
+	if len(typed) == len(wordClasses) {
+		return nil
+	}
+
+	var completions []string
+	components := max(2, len(typed)+1)
+	class := wordClasses[len(typed)]
+	for _, match := range dict.trieFor(class).withPrefix(dict.Words(class), last) {
+		completions = appendCompletions(completions, dict, head+match.word, len(typed)+1, components, limit)
+		if len(completions) == limit {
+			break
+		}
+	}
+	return completions
+}
+
+// appendCompletions appends the IDs completing id with the words of the
+// positions from position up to components, in index order, until dst
+// holds limit IDs
+func appendCompletions(dst []string, dict Dictionary, id string, position, components, limit int) []string {
+	if position == components {
+		return append(dst, id)
+	}
+	for _, word := range dict.Words(wordClasses[position]) {
+		dst = appendCompletions(dst, dict, id+"-"+word, position+1, components, limit)
+		if len(dst) == limit {
+			break
+		}
+	}
+	return dst
+}
+
+// completeSuffix returns up to limit IDs completing a partially typed numeric suffix
+func completeSuffix(head, digits string, limit int) []string {
+	if len(digits) > completionSuffixDigits {
+		return nil
+	}
+	if len(digits) == completionSuffixDigits {
+		return []string{head + digits}
+	}
+
+	var completions []string
+	remaining := completionSuffixDigits - len(digits)
+	count := 1
+	for range remaining {
+		count *= 10
+	}
+	for i := range min(count, limit) {
+		completions = append(completions, fmt.Sprintf("%s%s%0*d", head, digits, remaining, i))
+	}
+	return completions
+}
+
+// CompleteIssued returns up to limit IDs of store starting with prefix, in
+// sorted order, so autocomplete only offers IDs that were actually issued
+//
+// Example:
+//
+//	store := NewMemoryStore()
+//	store.Reserve("cute-rabbit-042")
+//	CompleteIssued(store, "cute-ra", 10) // ["cute-rabbit-042"], nil
+func CompleteIssued(store Lister, prefix string, limit int) ([]string, error) {
+	if limit < 1 {
+		return nil, nil
+	}
+	ids, err := store.List()
+	if err != nil {
+		return nil, err
+	}
+
+	prefix = Canonicalize(prefix)
+	start, _ := slices.BinarySearch(ids, prefix)
+	var completions []string
+	for _, id := range ids[start:] {
+		if !strings.HasPrefix(id, prefix) || len(completions) == limit {
+			break
+		}
+		completions = append(completions, id)
+	}
+	return completions, nil
+}
@@ -0,0 +1,59 @@
+package memorable_ids
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestComplete(t *testing.T) {
+	t.Run("should complete the word being typed", func(t *testing.T) {
+		assert.Equal(t, []string{"cute-rabbit", "cute-rat"}, Complete("cute-ra", 10))
+		assert.Equal(t, []string{"cute-rabbit-sing", "cute-rabbit-sit"}, Complete("Cute-Rabbit-s", 2))
+	})
+
+	t.Run("should complete a typed adjective with nouns", func(t *testing.T) {
+		completions := Complete("cute", 5)
+		require.Len(t, completions, 5)
+		for _, id := range completions {
+			assert.True(t, strings.HasPrefix(id, "cute-"), id)
+			assert.Len(t, strings.Split(id, "-"), 2)
+		}
+	})
+
+	t.Run("should complete numeric suffixes", func(t *testing.T) {
+		assert.Equal(t, []string{"cute-rabbit-040", "cute-rabbit-041"}, Complete("cute-rabbit-04", 2))
+		assert.Equal(t, []string{"cute-rabbit-042"}, Complete("cute-rabbit-042", 5))
+		assert.Empty(t, Complete("cute-rabbit-0421", 5))
+	})
+
+	t.Run("should only complete valid IDs", func(t *testing.T) {
+		assert.Empty(t, Complete("cuet-ra", 5))
+		assert.Empty(t, Complete("cute-xyz", 5))
+		assert.Empty(t, Complete("cute-ra", 0))
+		for _, id := range Complete("l", 50) {
+			assert.True(t, strings.HasPrefix(id, "l"), id)
+			assert.Equal(t, []string{id}, Suggest(id, 1))
+		}
+	})
+}
+
+func TestCompleteIssued(t *testing.T) {
+	t.Run("should complete from issued IDs", func(t *testing.T) {
+		store := NewMemoryStore()
+		for _, id := range []string{"cute-rabbit-042", "cute-rat-007", "cute-fox-001", "large-rabbit-042"} {
+			_, err := store.Reserve(id)
+			require.NoError(t, err)
+		}
+
+		completions, err := CompleteIssued(store, "cute-ra", 10)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"cute-rabbit-042", "cute-rat-007"}, completions)
+
+		completions, err = CompleteIssued(store, "cute", 1)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"cute-fox-001"}, completions)
+	})
+}
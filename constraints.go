@@ -0,0 +1,126 @@
+package memorable_ids
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"strings"
+)
+
+// Constraints contains hard requirements for GenerateWithConstraints
+type Constraints struct {
+	// MaxLength is the maximum total ID length in characters (0: unlimited)
+	MaxLength int
+	// MinEntropyBits is the minimum entropy of the configuration in bits (default: 0)
+	MinEntropyBits float64
+	// Charset lists the characters the ID may contain (default: any)
+	Charset string
+	// Separator between parts (default: "-")
+	Separator string
+}
+
+// UnsatisfiableError is returned when no configuration meets the constraints
+type UnsatisfiableError struct {
+	// Constraints are the requested constraints
+	Constraints Constraints
+	// Reason explains why the constraints cannot be met
+	Reason string
+}
+
+func (e *UnsatisfiableError) Error() string {
+	return "unsatisfiable constraints: " + e.Reason
+}
+
+// constraintSuffixes lists suffix candidates from the smallest to the largest,
+// skipping the time-derived Timestamp suffix
+var constraintSuffixes = append([]suffixInfo{{rangeSize: 1}}, builtinSuffixes[:4]...)
+
+// GenerateWithConstraints creates a memorable ID satisfying hard constraints,
+// picking the number of components, the suffix, and the eligible words
+// automatically. Configurations with fewer parts are preferred; an
+// *UnsatisfiableError is returned when nothing fits.
+//
+// Example:
+//
+//	// At most 16 characters with at least 20 bits of entropy
+//	GenerateWithConstraints(Constraints{MaxLength: 16, MinEntropyBits: 20})
+//	// "loud-duck-5f"
+//
+//	// Lowercase letters and underscores only
+//	GenerateWithConstraints(Constraints{Charset: "abcdefghijklmnopqrstuvwxyz_", Separator: "_"})
+func GenerateWithConstraints(constraints Constraints) (string, error) {
+	separator := constraints.Separator
+	if separator == "" {
+		separator = "-"
+	}
+
+	allowed := func(s string) bool {
+		if constraints.Charset == "" {
+			return true
+		}
+		for _, r := range s {
+			if !strings.ContainsRune(constraints.Charset, r) {
+				return false
+			}
+		}
+		return true
+	}
+
+	if !allowed(separator) {
+		return "", &UnsatisfiableError{Constraints: constraints, Reason: fmt.Sprintf("separator %q is outside the allowed charset", separator)}
+	}
+
+	// Keep only words made of allowed characters
+	base := GetDictionary().mapClasses(func(_ WordClass, words []string) []string {
+		filtered := make([]string, 0, len(words))
+		for _, word := range words {
+			if allowed(word) {
+				filtered = append(filtered, word)
+			}
+		}
+		return filtered
+	})
+
+	for components := 1; components <= 5; components++ {
+		for _, suffix := range constraintSuffixes {
+			if suffix.generator != nil && !allowed(suffix.charset) {
+				continue
+			}
+
+			dict := base
+			if constraints.MaxLength > 0 {
+				separators := components - 1
+				if suffix.generator != nil {
+					separators++
+				}
+				budget := constraints.MaxLength - separators*len(separator) - suffix.length
+				if budget < components {
+					continue
+				}
+				dict = base.FilterLength(1, budget/components)
+			}
+
+			entropy := math.Log2(float64(suffix.rangeSize))
+			satisfiable := true
+			for i := 0; i < components; i++ {
+				size := len(dict.Words(wordClasses[i]))
+				if size == 0 {
+					satisfiable = false
+					break
+				}
+				entropy += math.Log2(float64(size))
+			}
+			if !satisfiable || entropy < constraints.MinEntropyBits {
+				continue
+			}
+
+			return generate(dict, GenerateOptions{
+				Components: components,
+				Suffix:     suffix.generator,
+				Separator:  separator,
+			}, rand.Intn)
+		}
+	}
+
+	return "", &UnsatisfiableError{Constraints: constraints, Reason: "no combination of components and suffix fits the length, entropy, and charset limits"}
+}
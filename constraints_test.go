@@ -0,0 +1,53 @@
+package memorable_ids
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateWithConstraints(t *testing.T) {
+	t.Run("should respect max length", func(t *testing.T) {
+		for i := 0; i < 50; i++ {
+			id, err := GenerateWithConstraints(Constraints{MaxLength: 12, MinEntropyBits: 16})
+			require.NoError(t, err, "GenerateWithConstraints should not fail")
+			assert.LessOrEqual(t, len(id), 12, "ID '%s' exceeds max length", id)
+		}
+	})
+
+	t.Run("should respect charset", func(t *testing.T) {
+		charset := "abcdefghijklmnopqrstuvwxyz_"
+		for i := 0; i < 50; i++ {
+			id, err := GenerateWithConstraints(Constraints{Charset: charset, Separator: "_", MinEntropyBits: 30})
+			require.NoError(t, err, "GenerateWithConstraints should not fail")
+			for _, r := range id {
+				assert.Contains(t, charset, string(r), "ID '%s' contains disallowed character", id)
+			}
+		}
+	})
+
+	t.Run("should add components to reach minimum entropy", func(t *testing.T) {
+		id, err := GenerateWithConstraints(Constraints{MinEntropyBits: 20})
+		require.NoError(t, err, "GenerateWithConstraints should not fail")
+
+		parsed := Parse(id, "-")
+		assert.GreaterOrEqual(t, len(parsed.Components), 2, "Expected at least 2 components for 20 bits")
+	})
+
+	t.Run("should return typed error when unsatisfiable", func(t *testing.T) {
+		_, err := GenerateWithConstraints(Constraints{MaxLength: 5, MinEntropyBits: 40})
+
+		var unsatisfiable *UnsatisfiableError
+		assert.True(t, errors.As(err, &unsatisfiable), "Expected UnsatisfiableError, got %v", err)
+	})
+
+	t.Run("should reject separator outside charset", func(t *testing.T) {
+		_, err := GenerateWithConstraints(Constraints{Charset: "abc"})
+
+		var unsatisfiable *UnsatisfiableError
+		require.True(t, errors.As(err, &unsatisfiable), "Expected UnsatisfiableError")
+		assert.Contains(t, unsatisfiable.Reason, "separator")
+	})
+}
@@ -0,0 +1,337 @@
+// Package consulstore implements a memorable ID uniqueness store on top of
+// the Consul KV store, for clusters that already run Consul and need
+// strongly consistent name allocation across machines.
+//
+// Reservations are written with a Consul transaction that checks the key
+// doesn't exist before setting it, so exactly one caller wins each name no
+// matter which agent it talks to. The package talks to the Consul HTTP API
+// directly and depends on the standard library only:
+//
+//	registry := &memorable.Namespaces{
+//	  NewStore: func(tenant string) memorable.Store {
+//	    return consulstore.New(consulstore.Options{Prefix: "memorable-ids/" + tenant})
+//	  },
+//	}
+//	registry.Generate("acme") // "cute-rabbit", reserved as memorable-ids/acme/cute-rabbit
+package consulstore
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultAddress is the Consul agent address used when Options has none
+const DefaultAddress = "http://127.0.0.1:8500"
+
+// DefaultPrefix is the key prefix used when Options has none
+const DefaultPrefix = "memorable-ids"
+
+// ErrClosed is returned by Reserve after Close
+var ErrClosed = errors.New("consul store is closed")
+
+// Options configures a Consul store
+type Options struct {
+	// Address is the base URL of the Consul HTTP API (default: DefaultAddress)
+	Address string
+	// Prefix is the KV path reservations are stored under (default: DefaultPrefix)
+	Prefix string
+	// Token is sent as the ACL token, if set (default: none)
+	Token string
+	// Datacenter routes requests to another datacenter (default: the agent's)
+	Datacenter string
+	// TTL makes reservations expire: they are bound to a Consul session that
+	// is renewed until Close, and deleted by Consul once the session lapses.
+	// Consul accepts TTLs between 10s and 24h (default: 0, never expire)
+	TTL time.Duration
+	// Client performs the HTTP requests (default: http.DefaultClient)
+	Client *http.Client
+}
+
+// Store is a memorable Store and PrefixLister backed by Consul KV. Store
+// is safe for concurrent use.
+type Store struct {
+	options Options
+
+	mu      sync.Mutex
+	session string
+	closed  bool
+	stop    chan struct{}
+	done    chan struct{}
+}
+
+// New creates a store talking to the Consul agent described by options.
+// No request is made until the first reservation.
+func New(options Options) *Store {
+	if options.Address == "" {
+		options.Address = DefaultAddress
+	}
+	options.Address = strings.TrimSuffix(options.Address, "/")
+	if options.Prefix == "" {
+		options.Prefix = DefaultPrefix
+	}
+	options.Prefix = strings.Trim(options.Prefix, "/")
+	if options.Client == nil {
+		options.Client = http.DefaultClient
+	}
+	return &Store{options: options}
+}
+
+// txnOp is one operation of a Consul KV transaction
+type txnOp struct {
+	KV txnKV `json:"KV"`
+}
+
+type txnKV struct {
+	Verb    string `json:"Verb"`
+	Key     string `json:"Key"`
+	Value   string `json:"Value,omitempty"`
+	Session string `json:"Session,omitempty"`
+}
+
+// txnResponse is the body of a rolled back transaction
+type txnResponse struct {
+	Errors []struct {
+		OpIndex int    `json:"OpIndex"`
+		What    string `json:"What"`
+	} `json:"Errors"`
+}
+
+// Reserve implements memorable.Store. It reports false if another caller,
+// on this or any other machine, already holds id.
+func (s *Store) Reserve(id string) (bool, error) {
+	if id == "" {
+		return false, errors.New("consul store: empty ID")
+	}
+
+	session, err := s.sessionID()
+	if err != nil {
+		return false, err
+	}
+
+	key := s.options.Prefix + "/" + id
+	set := txnKV{Verb: "set", Key: key, Value: base64.StdEncoding.EncodeToString([]byte(id))}
+	if session != "" {
+		set.Verb = "lock"
+		set.Session = session
+	}
+	body, err := json.Marshal([]txnOp{
+		{KV: txnKV{Verb: "check-not-exists", Key: key}},
+		{KV: set},
+	})
+	if err != nil {
+		return false, err
+	}
+
+	resp, err := s.do(http.MethodPut, "/v1/txn", nil, body)
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return true, nil
+	case http.StatusConflict:
+		// The transaction was rolled back; the key is taken only when the
+		// check-not-exists operation failed, other failures such as a lock
+		// on an invalidated session are errors
+		var rollback txnResponse
+		if err := json.NewDecoder(io.LimitReader(resp.Body, 64<<10)).Decode(&rollback); err != nil {
+			return false, fmt.Errorf("consul store: decoding rolled back transaction: %w", err)
+		}
+		var failures []string
+		for _, failure := range rollback.Errors {
+			if failure.OpIndex == 0 {
+				return false, nil
+			}
+			failures = append(failures, fmt.Sprintf("operation %d: %s", failure.OpIndex, failure.What))
+		}
+		if len(failures) == 0 {
+			return false, fmt.Errorf("consul store: %s: transaction rolled back without errors", resp.Status)
+		}
+		return false, fmt.Errorf("consul store: %s: %s", resp.Status, strings.Join(failures, "; "))
+	default:
+		return false, statusError(resp)
+	}
+}
+
+// List implements memorable.Lister with a consistent read of every reserved ID
+func (s *Store) List() ([]string, error) {
+	return s.ListPrefix("")
+}
+
+// ListPrefix implements memorable.PrefixLister with a consistent read of
+// the reserved IDs starting with prefix, which Consul filters server-side
+func (s *Store) ListPrefix(prefix string) ([]string, error) {
+	query := url.Values{"keys": {""}, "consistent": {""}}
+	resp, err := s.do(http.MethodGet, "/v1/kv/"+s.options.Prefix+"/"+prefix, query, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		// Consul answers 404 when no key has the prefix
+		return []string{}, nil
+	default:
+		return nil, statusError(resp)
+	}
+
+	var keys []string
+	if err := json.NewDecoder(resp.Body).Decode(&keys); err != nil {
+		return nil, fmt.Errorf("consul store: decoding keys: %w", err)
+	}
+	ids := make([]string, 0, len(keys))
+	for _, key := range keys {
+		if id, ok := strings.CutPrefix(key, s.options.Prefix+"/"); ok && id != "" && strings.HasPrefix(id, prefix) {
+			ids = append(ids, id)
+		}
+	}
+	slices.Sort(ids)
+	return ids, nil
+}
+
+// Close stops renewing the session of a store with a TTL, so its
+// reservations expire once the TTL passes. Reserve fails after Close.
+func (s *Store) Close() error {
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		return nil
+	}
+	s.closed = true
+	stop, done := s.stop, s.done
+	s.mu.Unlock()
+
+	if stop != nil {
+		close(stop)
+		<-done
+	}
+	return nil
+}
+
+// sessionID returns the session reservations are bound to, creating it on
+// first use, or "" for stores without a TTL
+func (s *Store) sessionID() (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return "", ErrClosed
+	}
+	if s.options.TTL <= 0 || s.session != "" {
+		return s.session, nil
+	}
+
+	body, err := json.Marshal(map[string]string{
+		"Name":     "memorable-ids " + s.options.Prefix,
+		"TTL":      s.options.TTL.String(),
+		"Behavior": "delete",
+	})
+	if err != nil {
+		return "", err
+	}
+	resp, err := s.do(http.MethodPut, "/v1/session/create", nil, body)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", statusError(resp)
+	}
+
+	var created struct{ ID string }
+	if err := json.NewDecoder(resp.Body).Decode(&created); err != nil {
+		return "", fmt.Errorf("consul store: decoding session: %w", err)
+	}
+	if created.ID == "" {
+		return "", errors.New("consul store: session create returned no ID")
+	}
+
+	s.session = created.ID
+	s.stop = make(chan struct{})
+	s.done = make(chan struct{})
+	go s.renew(created.ID, s.stop, s.done)
+	return s.session, nil
+}
+
+// renew keeps the session alive at half its TTL until stop is closed. If
+// Consul reports the session gone, the next reservation creates a new one.
+func (s *Store) renew(session string, stop, done chan struct{}) {
+	defer close(done)
+
+	ticker := time.NewTicker(s.options.TTL / 2)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			resp, err := s.do(http.MethodPut, "/v1/session/renew/"+session, nil, nil)
+			if err != nil {
+				// Transient failure; retry on the next tick
+				continue
+			}
+			io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+			if resp.StatusCode == http.StatusNotFound {
+				s.mu.Lock()
+				if s.session == session {
+					s.session = ""
+				}
+				s.mu.Unlock()
+				return
+			}
+		}
+	}
+}
+
+// do sends a request to the Consul HTTP API
+func (s *Store) do(method, path string, query url.Values, body []byte) (*http.Response, error) {
+	if query == nil {
+		query = url.Values{}
+	}
+	if s.options.Datacenter != "" {
+		query.Set("dc", s.options.Datacenter)
+	}
+
+	target := s.options.Address + path
+	if len(query) > 0 {
+		// Consul flags such as ?keys have no value, which Encode renders as "keys="
+		target += "?" + query.Encode()
+	}
+	req, err := http.NewRequest(method, target, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	if s.options.Token != "" {
+		req.Header.Set("X-Consul-Token", s.options.Token)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := s.options.Client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("consul store: %w", err)
+	}
+	return resp, nil
+}
+
+// statusError describes an unexpected Consul response
+func statusError(resp *http.Response) error {
+	message, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+	return fmt.Errorf("consul store: %s: %s", resp.Status, strings.TrimSpace(string(message)))
+}
@@ -0,0 +1,245 @@
+package consulstore
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	memorable "github.com/riipandi/memorable-ids"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeConsul implements the subset of the Consul HTTP API used by Store
+type fakeConsul struct {
+	mu       sync.Mutex
+	kv       map[string]string // key → session
+	sessions map[string]bool
+	renewals int
+	token    string
+	// lockFailure rolls back every lock as if the session was invalidated
+	lockFailure bool
+}
+
+func newFakeConsul(t *testing.T) (*fakeConsul, *httptest.Server) {
+	fake := &fakeConsul{kv: make(map[string]string), sessions: make(map[string]bool)}
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+	return fake, server
+}
+
+func (f *fakeConsul) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.token = r.Header.Get("X-Consul-Token")
+	switch {
+	case r.URL.Path == "/v1/txn":
+		var ops []txnOp
+		if err := json.NewDecoder(r.Body).Decode(&ops); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		for _, op := range ops {
+			if _, exists := f.kv[op.KV.Key]; op.KV.Verb == "check-not-exists" && exists {
+				http.Error(w, `{"Errors":[{"OpIndex":0,"What":"key exists"}]}`, http.StatusConflict)
+				return
+			}
+			if op.KV.Verb == "lock" && f.lockFailure {
+				http.Error(w, `{"Errors":[{"OpIndex":1,"What":"failed to lock key: invalid session"}]}`, http.StatusConflict)
+				return
+			}
+		}
+		for _, op := range ops {
+			if op.KV.Verb == "set" || op.KV.Verb == "lock" {
+				f.kv[op.KV.Key] = op.KV.Session
+			}
+		}
+		w.Write([]byte(`{"Results":[]}`))
+	case r.URL.Path == "/v1/session/create":
+		id := "session-" + string(rune('a'+len(f.sessions)))
+		f.sessions[id] = true
+		json.NewEncoder(w).Encode(map[string]string{"ID": id})
+	case strings.HasPrefix(r.URL.Path, "/v1/session/renew/"):
+		f.renewals++
+		if !f.sessions[strings.TrimPrefix(r.URL.Path, "/v1/session/renew/")] {
+			http.NotFound(w, r)
+		}
+	case strings.HasPrefix(r.URL.Path, "/v1/kv/"):
+		prefix := strings.TrimPrefix(r.URL.Path, "/v1/kv/")
+		keys := []string{}
+		for key := range f.kv {
+			if strings.HasPrefix(key, prefix) {
+				keys = append(keys, key)
+			}
+		}
+		if len(keys) == 0 {
+			http.NotFound(w, r)
+			return
+		}
+		json.NewEncoder(w).Encode(keys)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func TestStore(t *testing.T) {
+	t.Run("should reserve each ID once", func(t *testing.T) {
+		_, server := newFakeConsul(t)
+		store := New(Options{Address: server.URL})
+
+		reserved, err := store.Reserve("cute-rabbit")
+		require.NoError(t, err)
+		assert.True(t, reserved)
+
+		reserved, err = store.Reserve("cute-rabbit")
+		require.NoError(t, err)
+		assert.False(t, reserved)
+	})
+
+	t.Run("should share reservations between stores on the same prefix", func(t *testing.T) {
+		_, server := newFakeConsul(t)
+		first := New(Options{Address: server.URL, Prefix: "builds"})
+		second := New(Options{Address: server.URL, Prefix: "builds"})
+		other := New(Options{Address: server.URL, Prefix: "deploys"})
+
+		reserved, err := first.Reserve("large-fox")
+		require.NoError(t, err)
+		assert.True(t, reserved)
+
+		reserved, err = second.Reserve("large-fox")
+		require.NoError(t, err)
+		assert.False(t, reserved)
+
+		reserved, err = other.Reserve("large-fox")
+		require.NoError(t, err)
+		assert.True(t, reserved)
+	})
+
+	t.Run("should list reserved IDs in order", func(t *testing.T) {
+		_, server := newFakeConsul(t)
+		store := New(Options{Address: server.URL, Prefix: "/builds/"})
+
+		ids, err := store.List()
+		require.NoError(t, err)
+		assert.Empty(t, ids)
+
+		for _, id := range []string{"zany-owl", "cute-rabbit", "large-fox"} {
+			_, err := store.Reserve(id)
+			require.NoError(t, err)
+		}
+		ids, err = store.List()
+		require.NoError(t, err)
+		assert.Equal(t, []string{"cute-rabbit", "large-fox", "zany-owl"}, ids)
+	})
+
+	t.Run("should list reserved IDs under a prefix", func(t *testing.T) {
+		_, server := newFakeConsul(t)
+		store := New(Options{Address: server.URL})
+		for _, id := range []string{"history/a/name/cute-rabbit", "history/a/name/large-fox", "history/b/name/zany-owl"} {
+			_, err := store.Reserve(id)
+			require.NoError(t, err)
+		}
+
+		ids, err := store.ListPrefix("history/a/")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"history/a/name/cute-rabbit", "history/a/name/large-fox"}, ids)
+
+		ids, err = store.ListPrefix("history/c/")
+		require.NoError(t, err)
+		assert.Empty(t, ids)
+	})
+
+	t.Run("should bind reservations to a renewed session when a TTL is set", func(t *testing.T) {
+		fake, server := newFakeConsul(t)
+		store := New(Options{Address: server.URL, TTL: 20 * time.Millisecond, Token: "secret"})
+
+		_, err := store.Reserve("cute-rabbit")
+		require.NoError(t, err)
+		_, err = store.Reserve("large-fox")
+		require.NoError(t, err)
+
+		assert.Eventually(t, func() bool {
+			fake.mu.Lock()
+			defer fake.mu.Unlock()
+			return fake.renewals > 0
+		}, time.Second, 5*time.Millisecond)
+		require.NoError(t, store.Close())
+
+		fake.mu.Lock()
+		defer fake.mu.Unlock()
+		assert.Len(t, fake.sessions, 1)
+		assert.Equal(t, "session-a", fake.kv["memorable-ids/cute-rabbit"])
+		assert.Equal(t, "session-a", fake.kv["memorable-ids/large-fox"])
+		assert.Equal(t, "secret", fake.token)
+	})
+
+	t.Run("should fail after Close", func(t *testing.T) {
+		_, server := newFakeConsul(t)
+		store := New(Options{Address: server.URL})
+		require.NoError(t, store.Close())
+
+		_, err := store.Reserve("cute-rabbit")
+		assert.ErrorIs(t, err, ErrClosed)
+	})
+
+	t.Run("should report unexpected responses", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "Permission denied", http.StatusForbidden)
+		}))
+		defer server.Close()
+		store := New(Options{Address: server.URL})
+
+		_, err := store.Reserve("cute-rabbit")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Permission denied")
+	})
+
+	t.Run("should report rolled back transactions other than taken IDs", func(t *testing.T) {
+		fake, server := newFakeConsul(t)
+		fake.lockFailure = true
+		store := New(Options{Address: server.URL, TTL: time.Minute})
+		defer store.Close()
+
+		reserved, err := store.Reserve("cute-rabbit")
+		require.Error(t, err)
+		assert.False(t, reserved)
+		assert.Contains(t, err.Error(), "invalid session")
+	})
+
+	t.Run("should report undecodable conflicts", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "Transaction conflict", http.StatusConflict)
+		}))
+		defer server.Close()
+		store := New(Options{Address: server.URL})
+
+		_, err := store.Reserve("cute-rabbit")
+		assert.ErrorContains(t, err, "rolled back transaction")
+	})
+
+	t.Run("should back a namespace registry", func(t *testing.T) {
+		_, server := newFakeConsul(t)
+		registry := &memorable.Namespaces{
+			NewStore: func(tenant string) memorable.Store {
+				return New(Options{Address: server.URL, Prefix: "memorable-ids/" + tenant})
+			},
+		}
+
+		seen := make(map[string]bool)
+		for range 50 {
+			id, err := registry.Generate("acme")
+			require.NoError(t, err)
+			assert.False(t, seen[id])
+			seen[id] = true
+		}
+
+		ids, err := New(Options{Address: server.URL, Prefix: "memorable-ids/acme"}).List()
+		require.NoError(t, err)
+		assert.Len(t, ids, 50)
+	})
+}
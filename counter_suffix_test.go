@@ -0,0 +1,94 @@
+package memorable_ids
+
+import (
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCounterSuffix(t *testing.T) {
+	t.Run("should issue strictly increasing zero-padded suffixes", func(t *testing.T) {
+		counter := NewCounterSuffix(CounterSuffixOptions{Start: 9998})
+
+		var suffixes []string
+		for range 3 {
+			suffix, err := counter.Next()
+			require.NoError(t, err)
+			suffixes = append(suffixes, suffix)
+		}
+		assert.Equal(t, []string{"9998", "9999", "10000"}, suffixes)
+		assert.Equal(t, uint64(10001), counter.Value())
+	})
+
+	t.Run("should be unique under concurrent use", func(t *testing.T) {
+		counter := NewCounterSuffix(CounterSuffixOptions{Width: 6})
+
+		var mu sync.Mutex
+		var suffixes []string
+		var wg sync.WaitGroup
+		for range 8 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for range 500 {
+					suffix, err := counter.Next()
+					assert.NoError(t, err)
+					mu.Lock()
+					suffixes = append(suffixes, suffix)
+					mu.Unlock()
+				}
+			}()
+		}
+		wg.Wait()
+
+		slices.Sort(suffixes)
+		assert.Len(t, slices.Compact(suffixes), 4000)
+		assert.Equal(t, "000000", suffixes[0])
+		assert.Equal(t, "003999", suffixes[len(suffixes)-1])
+	})
+
+	t.Run("should checkpoint before issuing past the persisted mark", func(t *testing.T) {
+		var marks []uint64
+		options := CounterSuffixOptions{
+			Start:           100,
+			CheckpointEvery: 10,
+			Checkpoint:      func(mark uint64) error { marks = append(marks, mark); return nil },
+		}
+		counter := NewCounterSuffix(options)
+		for range 25 {
+			_, err := counter.Next()
+			require.NoError(t, err)
+		}
+		assert.Equal(t, []uint64{110, 120, 130}, marks)
+
+		// A restart from the last mark never reissues a value
+		options.Start = marks[len(marks)-1]
+		restarted, err := NewCounterSuffix(options).Next()
+		require.NoError(t, err)
+		assert.Equal(t, "0130", restarted)
+	})
+
+	t.Run("should not issue values when checkpoints fail", func(t *testing.T) {
+		failure := errors.New("disk full")
+		counter := NewCounterSuffix(CounterSuffixOptions{Checkpoint: func(uint64) error { return failure }})
+
+		_, err := counter.Next()
+		assert.ErrorIs(t, err, failure)
+
+		_, err = Generate(GenerateOptions{SuffixProvider: counter})
+		assert.ErrorIs(t, err, failure)
+		_, err = NewGenerator(Config{Options: GenerateOptions{SuffixProvider: counter}}).Generate()
+		assert.ErrorIs(t, err, failure)
+	})
+
+	t.Run("should describe the generator", func(t *testing.T) {
+		spec := NewCounterSuffix(CounterSuffixOptions{Width: 3}).Spec()
+		assert.Equal(t, "counter", spec.Name)
+		assert.Equal(t, 1000, spec.Range)
+		assert.Equal(t, 3, spec.MinLength)
+	})
+}
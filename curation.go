@@ -0,0 +1,85 @@
+package memorable_ids
+
+/**
+ * Dictionary curation helpers
+ *
+ * Detects words within a class that are easily confused when IDs are
+ * read aloud or retyped (e.g. "fat" and "flat"), and prunes them so
+ * transcription errors can't silently turn one valid ID into another.
+ */
+
+// WordConflict describes two words of the same class that are too close to each other
+type WordConflict struct {
+	// Class is the word class both words belong to
+	Class WordClass
+	// Kept is the word appearing first in the collection
+	Kept string
+	// Conflicting is the later word that is too close to Kept
+	Conflicting string
+	// Distance is the edit distance between both words
+	Distance int
+}
+
+// EditDistanceConflicts reports every pair of words within the same class
+// whose edit distance is at most maxDistance (default: 1)
+//
+// Example:
+//
+//	GetDictionary().EditDistanceConflicts(1)
+//	// [{Class: ClassAdjective, Kept: "flat", Conflicting: "fat", Distance: 1}, ...]
+func (d Dictionary) EditDistanceConflicts(maxDistance int) []WordConflict {
+	if maxDistance < 1 {
+		maxDistance = 1
+	}
+
+	var conflicts []WordConflict
+	for _, class := range wordClasses {
+		words := d.Words(class)
+		for i := 0; i < len(words); i++ {
+			for j := i + 1; j < len(words); j++ {
+				distance := levenshtein(words[i], words[j])
+				if distance <= maxDistance {
+					conflicts = append(conflicts, WordConflict{
+						Class:       class,
+						Kept:        words[i],
+						Conflicting: words[j],
+						Distance:    distance,
+					})
+				}
+			}
+		}
+	}
+
+	return conflicts
+}
+
+// PruneEditDistance returns a copy of the dictionary where no two words of
+// the same class are within maxDistance edits of each other (default: 1).
+// Words earlier in a collection win, later conflicting words are dropped.
+//
+// Example:
+//
+//	safe := GetDictionary().PruneEditDistance(1)
+//	len(safe.EditDistanceConflicts(1)) // 0
+func (d Dictionary) PruneEditDistance(maxDistance int) Dictionary {
+	if maxDistance < 1 {
+		maxDistance = 1
+	}
+
+	return d.mapClasses(func(_ WordClass, words []string) []string {
+		kept := make([]string, 0, len(words))
+		for _, word := range words {
+			conflict := false
+			for _, existing := range kept {
+				if levenshtein(word, existing) <= maxDistance {
+					conflict = true
+					break
+				}
+			}
+			if !conflict {
+				kept = append(kept, word)
+			}
+		}
+		return kept
+	})
+}
@@ -0,0 +1,113 @@
+package memorable_ids
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLevenshtein(t *testing.T) {
+	t.Run("should compute edit distance", func(t *testing.T) {
+		assert.Equal(t, 0, levenshtein("cute", "cute"))
+		assert.Equal(t, 1, levenshtein("fat", "flat"))
+		assert.Equal(t, 1, levenshtein("cute", "mute"))
+		assert.Equal(t, 3, levenshtein("", "fox"))
+		assert.Equal(t, 3, levenshtein("kitten", "sitting"))
+	})
+}
+
+func TestEditDistanceConflicts(t *testing.T) {
+	t.Run("should report close words within the same class", func(t *testing.T) {
+		dict := NewDictionary([]string{"flat", "fat", "cute"}, []string{"fox"}, nil, nil, nil)
+
+		conflicts := dict.EditDistanceConflicts(1)
+		assert.Equal(t, []WordConflict{{Class: ClassAdjective, Kept: "flat", Conflicting: "fat", Distance: 1}}, conflicts)
+	})
+
+	t.Run("should not report words from different classes", func(t *testing.T) {
+		dict := NewDictionary([]string{"fast"}, []string{"fat"}, nil, nil, nil)
+
+		assert.Empty(t, dict.EditDistanceConflicts(1), "Expected no cross-class conflicts")
+	})
+
+	t.Run("should prune conflicting words keeping the first occurrence", func(t *testing.T) {
+		dict := NewDictionary([]string{"flat", "fat", "cute"}, []string{"fox"}, nil, nil, nil)
+
+		pruned := dict.PruneEditDistance(1)
+		assert.Equal(t, []string{"flat", "cute"}, pruned.Adjectives)
+		assert.Equal(t, 2, pruned.Stats.Adjectives, "Expected recomputed stats")
+	})
+
+	t.Run("should leave no conflicts in the pruned built-in dictionary", func(t *testing.T) {
+		pruned := GetDictionary().PruneEditDistance(1)
+
+		assert.Empty(t, pruned.EditDistanceConflicts(1), "Expected no conflicts after pruning")
+		assert.NotContains(t, pruned.Adjectives, "fat", "Expected 'fat' to be pruned in favour of 'flat'")
+	})
+}
+
+func TestFilterLength(t *testing.T) {
+	t.Run("should keep only words within the length bounds", func(t *testing.T) {
+		short := GetDictionary().FilterLength(1, 4)
+
+		for _, class := range wordClasses {
+			for _, word := range short.Words(class) {
+				assert.LessOrEqual(t, len(word), 4, "Word '%s' exceeds max length", word)
+			}
+		}
+		assert.Equal(t, len(short.Nouns), short.Stats.Nouns, "Expected recomputed stats")
+		assert.NoError(t, short.Validate(), "Expected valid subset")
+	})
+
+	t.Run("should treat max below 1 as unbounded", func(t *testing.T) {
+		long := GetDictionary().FilterLength(8, 0)
+
+		assert.Contains(t, long.Adjectives, "interesting")
+		assert.NotContains(t, long.Adjectives, "cute")
+	})
+
+	t.Run("should fail validation when a class is emptied", func(t *testing.T) {
+		empty := GetDictionary().FilterLength(20, 30)
+
+		assert.ErrorIs(t, empty.Validate(), ErrEmptyWordClass)
+	})
+
+	t.Run("should reject duplicate words", func(t *testing.T) {
+		dict := NewDictionary([]string{"cute", "cute"}, []string{"fox"}, []string{"run"}, []string{"fast"}, []string{"in"})
+
+		assert.ErrorContains(t, dict.Validate(), "duplicate")
+	})
+}
+
+func TestWordClassOf(t *testing.T) {
+	t.Run("should find the class of a word", func(t *testing.T) {
+		class, ok := WordClassOf("rabbit")
+		assert.True(t, ok)
+		assert.Equal(t, ClassNoun, class)
+
+		class, ok = WordClassOf("within")
+		assert.True(t, ok)
+		assert.Equal(t, ClassPreposition, class)
+	})
+
+	t.Run("should prefer the earliest class for shared words", func(t *testing.T) {
+		class, ok := WordClassOf("fast")
+		assert.True(t, ok)
+		assert.Equal(t, ClassAdjective, class)
+		assert.Equal(t, []WordClass{ClassAdjective, ClassAdverb}, GetDictionary().WordClassesOf("fast"))
+	})
+
+	t.Run("should report unknown words", func(t *testing.T) {
+		_, ok := WordClassOf("zebra")
+		assert.False(t, ok)
+	})
+
+	t.Run("should check membership per class", func(t *testing.T) {
+		assert.True(t, ClassAdjective.Contains("cute"))
+		assert.False(t, ClassAdjective.Contains("rabbit"))
+
+		dict := NewDictionary([]string{"crimson"}, []string{"comet"}, nil, nil, nil)
+		assert.True(t, dict.Contains(ClassNoun, "comet"))
+		assert.False(t, dict.Contains(ClassNoun, "rabbit"))
+	})
+}
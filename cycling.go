@@ -0,0 +1,103 @@
+package memorable_ids
+
+import (
+	"errors"
+	"math/rand"
+	"strconv"
+	"sync"
+)
+
+// CyclingSuffix is a SuffixProvider that walks its entire numeric range
+// in a shuffled but deterministic order before repeating, so suffixes
+// cannot collide until the range is exhausted. The order is fixed by the
+// seed; processes using the same seed and Store share one sequence.
+// A CyclingSuffix is safe for concurrent use.
+//
+// Example:
+//
+//	cycle, _ := NewCyclingSuffix(3, 42)
+//	Generate(GenerateOptions{SuffixProvider: cycle}) // "cute-rabbit-459"
+//	// the next 999 IDs get the other 999 suffixes
+type CyclingSuffix struct {
+	digits int
+	order  []int32
+
+	mu       sync.Mutex
+	position int
+	store    Store
+	key      string
+}
+
+// NewCyclingSuffix creates a per-process cycling sequence of digits-long
+// numbers (1-6 digits)
+func NewCyclingSuffix(digits int, seed int64) (*CyclingSuffix, error) {
+	if digits < 1 || digits > 6 {
+		return nil, errors.New("cycling suffix digits must be between 1 and 6")
+	}
+
+	size := 1
+	for i := 0; i < digits; i++ {
+		size *= 10
+	}
+
+	order := make([]int32, size)
+	for i, value := range rand.New(rand.NewSource(seed)).Perm(size) {
+		order[i] = int32(value)
+	}
+	return &CyclingSuffix{digits: digits, order: order}, nil
+}
+
+// NewStoreCyclingSuffix creates a cycling sequence whose position is
+// claimed in store under keys prefixed with name, so every process sharing
+// the store and seed draws distinct positions of one sequence. The store
+// keeps one key per issued suffix.
+func NewStoreCyclingSuffix(digits int, seed int64, store Store, name string) (*CyclingSuffix, error) {
+	cycle, err := NewCyclingSuffix(digits, seed)
+	if err != nil {
+		return nil, err
+	}
+	cycle.store = store
+	cycle.key = "suffix/" + name + "/"
+	return cycle, nil
+}
+
+// Next returns the next suffix of the sequence. Store errors are
+// returned, failing the Generate call.
+func (c *CyclingSuffix) Next() (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	position := c.position
+	if c.store != nil {
+		// Positions claimed by other processes are skipped
+		for {
+			claimed, err := c.store.Reserve(c.key + strconv.Itoa(position))
+			if err != nil {
+				return "", err
+			}
+			if claimed {
+				break
+			}
+			position++
+		}
+	}
+	c.position = position + 1
+
+	return formatPadded(int(c.order[position%len(c.order)]), c.digits), nil
+}
+
+// Spec implements SuffixProvider
+func (c *CyclingSuffix) Spec() SuffixSpec {
+	return SuffixSpec{
+		Name:      "cycling",
+		Range:     len(c.order),
+		Charset:   "0123456789",
+		MinLength: c.digits,
+		MaxLength: c.digits,
+	}
+}
+
+// Range returns the number of distinct suffixes in the cycle
+func (c *CyclingSuffix) Range() int {
+	return len(c.order)
+}
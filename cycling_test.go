@@ -0,0 +1,96 @@
+package memorable_ids
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// failingStore is a Store whose reservations always fail
+type failingStore struct{ err error }
+
+func (s failingStore) Reserve(string) (bool, error) { return false, s.err }
+
+func TestCyclingSuffix(t *testing.T) {
+	t.Run("should visit the full range before repeating", func(t *testing.T) {
+		cycle, err := NewCyclingSuffix(3, 42)
+		require.NoError(t, err)
+		assert.Equal(t, 1000, cycle.Range())
+
+		first := make([]string, 1000)
+		seen := make(map[string]bool)
+		for i := range first {
+			first[i], err = cycle.Next()
+			require.NoError(t, err)
+			assert.Len(t, first[i], 3)
+			assert.False(t, seen[first[i]], "Expected %s once per cycle", first[i])
+			seen[first[i]] = true
+		}
+
+		again, err := cycle.Next()
+		require.NoError(t, err)
+		assert.Equal(t, first[0], again, "Expected the cycle to restart")
+		assert.NotEqual(t, []string{"000", "001", "002"}, first[:3], "Expected a shuffled order")
+	})
+
+	t.Run("should be deterministic per seed", func(t *testing.T) {
+		a, _ := NewCyclingSuffix(2, 7)
+		b, _ := NewCyclingSuffix(2, 7)
+		c, _ := NewCyclingSuffix(2, 8)
+
+		var sequenceA, sequenceB, sequenceC []string
+		for range 10 {
+			next, _ := a.Next()
+			sequenceA = append(sequenceA, next)
+			next, _ = b.Next()
+			sequenceB = append(sequenceB, next)
+			next, _ = c.Next()
+			sequenceC = append(sequenceC, next)
+		}
+		assert.Equal(t, sequenceA, sequenceB)
+		assert.NotEqual(t, sequenceA, sequenceC)
+	})
+
+	t.Run("should share one sequence through a store", func(t *testing.T) {
+		store := NewMemoryStore()
+		a, err := NewStoreCyclingSuffix(2, 1, store, "orders")
+		require.NoError(t, err)
+		b, err := NewStoreCyclingSuffix(2, 1, store, "orders")
+		require.NoError(t, err)
+
+		seen := make(map[string]bool)
+		for i := range 100 {
+			cycle := a
+			if i%3 == 0 {
+				cycle = b
+			}
+			suffix, err := cycle.Next()
+			require.NoError(t, err)
+			assert.False(t, seen[suffix], "Expected %s once across processes", suffix)
+			seen[suffix] = true
+		}
+	})
+
+	t.Run("should plug into Generate and report store errors", func(t *testing.T) {
+		cycle, _ := NewCyclingSuffix(3, 1)
+		id, err := Generate(GenerateOptions{SuffixProvider: cycle})
+		require.NoError(t, err)
+		assert.Regexp(t, `-\d{3}$`, id)
+
+		failure := errors.New("store down")
+		broken, _ := NewStoreCyclingSuffix(3, 1, failingStore{failure}, "orders")
+		_, err = broken.Next()
+		assert.ErrorIs(t, err, failure)
+		_, err = Generate(GenerateOptions{SuffixProvider: broken})
+		assert.ErrorIs(t, err, failure)
+	})
+
+	t.Run("should validate digits", func(t *testing.T) {
+		_, err := NewCyclingSuffix(0, 1)
+		assert.Error(t, err)
+		_, err = NewCyclingSuffix(7, 1)
+		assert.Error(t, err)
+	})
+}
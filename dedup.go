@@ -0,0 +1,146 @@
+package memorable_ids
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sync"
+)
+
+// DedupWriter forwards IDs to a sink and silently drops, or re-rolls,
+// any ID it has already forwarded, for streaming export jobs that must
+// emit unique names. It implements io.Writer over newline-separated IDs.
+// A DedupWriter is safe for concurrent use.
+//
+// Example:
+//
+//	w := NewDedupWriter(file)
+//	w.Regenerate = func() (string, error) { return Generate(GenerateOptions{Components: 3}) }
+//	for _, id := range ids {
+//	  w.WriteID(id) // duplicates are replaced by fresh IDs
+//	}
+type DedupWriter struct {
+	// Regenerate produces a replacement for duplicates; nil drops them instead
+	Regenerate func() (string, error)
+	// MaxAttempts is the number of re-rolls per duplicate before dropping it (default: 100)
+	MaxAttempts int
+
+	mu       sync.Mutex
+	emit     func(id string) error
+	seen     map[string]struct{}
+	partial  []byte
+	written  int
+	dropped  int
+	rerolled int
+}
+
+// NewDedupWriter creates a DedupWriter writing one ID per line to w
+func NewDedupWriter(w io.Writer) *DedupWriter {
+	return NewDedupWriterFunc(func(id string) error {
+		_, err := io.WriteString(w, id+"\n")
+		return err
+	})
+}
+
+// NewDedupWriterFunc creates a DedupWriter passing every unique ID to emit
+func NewDedupWriterFunc(emit func(id string) error) *DedupWriter {
+	return &DedupWriter{
+		emit: emit,
+		seen: make(map[string]struct{}),
+	}
+}
+
+// WriteID forwards id unless it is a duplicate, and reports the ID that was
+// actually forwarded (a re-rolled replacement, or "" when dropped)
+func (d *DedupWriter) WriteID(id string) (string, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.writeID(id)
+}
+
+func (d *DedupWriter) writeID(id string) (string, error) {
+	if _, duplicate := d.seen[id]; duplicate {
+		replacement, err := d.reroll()
+		if err != nil || replacement == "" {
+			d.dropped++
+			return "", err
+		}
+		d.rerolled++
+		id = replacement
+	}
+
+	if err := d.emit(id); err != nil {
+		return "", err
+	}
+	d.seen[id] = struct{}{}
+	d.written++
+	return id, nil
+}
+
+// reroll draws replacements until one is unseen, returning "" when it gives up
+func (d *DedupWriter) reroll() (string, error) {
+	if d.Regenerate == nil {
+		return "", nil
+	}
+
+	attempts := d.MaxAttempts
+	if attempts < 1 {
+		attempts = 100
+	}
+	for i := 0; i < attempts; i++ {
+		id, err := d.Regenerate()
+		if err != nil {
+			return "", fmt.Errorf("regenerate duplicate: %w", err)
+		}
+		if _, duplicate := d.seen[id]; !duplicate {
+			return id, nil
+		}
+	}
+	return "", nil
+}
+
+// Write implements io.Writer, treating p as newline-separated IDs.
+// A trailing incomplete line is buffered until the next Write or Flush.
+func (d *DedupWriter) Write(p []byte) (int, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	data := append(d.partial, p...)
+	for {
+		i := bytes.IndexByte(data, '\n')
+		if i < 0 {
+			break
+		}
+		if line := string(bytes.TrimRight(data[:i], "\r")); line != "" {
+			if _, err := d.writeID(line); err != nil {
+				d.partial = append(d.partial[:0], data[i+1:]...)
+				return len(p), err
+			}
+		}
+		data = data[i+1:]
+	}
+	d.partial = append(d.partial[:0], data...)
+
+	return len(p), nil
+}
+
+// Flush forwards a buffered incomplete line written without a trailing newline
+func (d *DedupWriter) Flush() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if len(d.partial) == 0 {
+		return nil
+	}
+	line := string(d.partial)
+	d.partial = d.partial[:0]
+	_, err := d.writeID(line)
+	return err
+}
+
+// Stats returns how many IDs were forwarded, dropped, and re-rolled so far
+func (d *DedupWriter) Stats() (written, dropped, rerolled int) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.written, d.dropped, d.rerolled
+}
@@ -0,0 +1,78 @@
+package memorable_ids
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDedupWriter(t *testing.T) {
+	t.Run("should drop duplicate IDs", func(t *testing.T) {
+		var out bytes.Buffer
+		w := NewDedupWriter(&out)
+
+		for _, id := range []string{"cute-rabbit", "large-fox", "cute-rabbit"} {
+			_, err := w.WriteID(id)
+			require.NoError(t, err)
+		}
+
+		assert.Equal(t, "cute-rabbit\nlarge-fox\n", out.String())
+		written, dropped, rerolled := w.Stats()
+		assert.Equal(t, [3]int{2, 1, 0}, [3]int{written, dropped, rerolled})
+	})
+
+	t.Run("should re-roll duplicates when Regenerate is set", func(t *testing.T) {
+		var emitted []string
+		w := NewDedupWriterFunc(func(id string) error {
+			emitted = append(emitted, id)
+			return nil
+		})
+		w.Regenerate = func() (string, error) { return "warm-duck", nil }
+
+		_, _ = w.WriteID("cute-rabbit")
+		replacement, err := w.WriteID("cute-rabbit")
+		require.NoError(t, err)
+
+		assert.Equal(t, "warm-duck", replacement)
+		assert.Equal(t, []string{"cute-rabbit", "warm-duck"}, emitted)
+	})
+
+	t.Run("should drop duplicates when re-rolls are exhausted", func(t *testing.T) {
+		w := NewDedupWriterFunc(func(string) error { return nil })
+		w.Regenerate = func() (string, error) { return "cute-rabbit", nil }
+		w.MaxAttempts = 3
+
+		_, _ = w.WriteID("cute-rabbit")
+		replacement, err := w.WriteID("cute-rabbit")
+		require.NoError(t, err)
+
+		assert.Equal(t, "", replacement)
+		_, dropped, _ := w.Stats()
+		assert.Equal(t, 1, dropped)
+	})
+
+	t.Run("should act as a line-oriented io.Writer", func(t *testing.T) {
+		var out bytes.Buffer
+		w := NewDedupWriter(&out)
+
+		_, err := w.Write([]byte("cute-rabbit\nlarge-"))
+		require.NoError(t, err)
+		_, err = w.Write([]byte("fox\r\ncute-rabbit\nwarm-duck"))
+		require.NoError(t, err)
+		require.NoError(t, w.Flush())
+
+		assert.Equal(t, []string{"cute-rabbit", "large-fox", "warm-duck"}, strings.Fields(out.String()))
+	})
+
+	t.Run("should propagate sink errors", func(t *testing.T) {
+		sinkErr := errors.New("disk full")
+		w := NewDedupWriterFunc(func(string) error { return sinkErr })
+
+		_, err := w.WriteID("cute-rabbit")
+		assert.ErrorIs(t, err, sinkErr)
+	})
+}
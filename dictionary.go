@@ -1,5 +1,12 @@
 package memorable_ids
 
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"unicode/utf8"
+)
+
 /**
  * Dictionary of words for memorable ID generation
  *
@@ -41,6 +48,10 @@ var Nouns = []string{
 	"phone", "computer", "window", "door",
 }
 
+// Animals is the subset of Nouns naming animals, in dictionary order. It
+// is a copy, so appending to or modifying it leaves Nouns untouched.
+var Animals = slices.Clone(Nouns[:slices.Index(Nouns, "parrot")+1])
+
 // Verbs contains English verbs - present tense (40 total)
 // Action words in present tense form
 var Verbs = []string{
@@ -69,6 +80,18 @@ var Prepositions = []string{
 	"without", "across",
 }
 
+// Colors contains English color names (48 total)
+// Not a component class; used by presets such as "color-animal"
+var Colors = []string{
+	"crimson", "scarlet", "red", "coral", "orange", "amber", "gold", "yellow",
+	"lime", "green", "olive", "mint", "jade", "emerald", "teal", "cyan",
+	"azure", "blue", "cobalt", "navy", "sapphire", "indigo", "violet",
+	"purple", "plum", "lilac", "lavender", "magenta", "pink", "rose", "ruby",
+	"maroon", "rust", "copper", "bronze", "ochre", "sienna", "umber", "brown",
+	"tan", "beige", "peach", "cream", "ivory", "white", "silver", "gray",
+	"black",
+}
+
 // DictionaryStats contains dictionary statistics for combination calculations
 type DictionaryStats struct {
 	Adjectives   int
@@ -89,6 +112,69 @@ func GetDictionaryStats() DictionaryStats {
 	}
 }
 
+// WordClass identifies a word collection by its part of speech
+type WordClass int
+
+const (
+	// ClassAdjective is the adjective collection (component 1)
+	ClassAdjective WordClass = iota
+	// ClassNoun is the noun collection (component 2)
+	ClassNoun
+	// ClassVerb is the verb collection (component 3)
+	ClassVerb
+	// ClassAdverb is the adverb collection (component 4)
+	ClassAdverb
+	// ClassPreposition is the preposition collection (component 5)
+	ClassPreposition
+	// ClassColor is the color collection; it is not used as a component
+	ClassColor
+)
+
+// wordClasses lists the classes in component order
+var wordClasses = []WordClass{ClassAdjective, ClassNoun, ClassVerb, ClassAdverb, ClassPreposition}
+
+// allWordClasses lists the component classes followed by the extra classes
+var allWordClasses = []WordClass{ClassAdjective, ClassNoun, ClassVerb, ClassAdverb, ClassPreposition, ClassColor}
+
+// String returns the lowercase name of the word class
+func (c WordClass) String() string {
+	switch c {
+	case ClassAdjective:
+		return "adjective"
+	case ClassNoun:
+		return "noun"
+	case ClassVerb:
+		return "verb"
+	case ClassAdverb:
+		return "adverb"
+	case ClassPreposition:
+		return "preposition"
+	case ClassColor:
+		return "color"
+	default:
+		return "unknown"
+	}
+}
+
+// Contains reports whether word belongs to the class in the built-in dictionary
+//
+// Example:
+//
+//	ClassNoun.Contains("rabbit") // true
+//	ClassVerb.Contains("rabbit") // false
+func (c WordClass) Contains(word string) bool {
+	return GetDictionary().Contains(c, word)
+}
+
+// WordClassOf returns the first class of the built-in dictionary containing word
+//
+// Example:
+//
+//	WordClassOf("swim") // ClassVerb, true
+func WordClassOf(word string) (WordClass, bool) {
+	return GetDictionary().WordClassOf(word)
+}
+
 // Dictionary contains all word collections grouped by type
 type Dictionary struct {
 	Adjectives   []string
@@ -96,17 +182,182 @@ type Dictionary struct {
 	Verbs        []string
 	Adverbs      []string
 	Prepositions []string
-	Stats        DictionaryStats
+	// Colors is optional and not used as a component
+	Colors []string
+	Stats  DictionaryStats
+
+	// index is the prebuilt membership index, see dictionary_index.go
+	index *dictionaryIndex
 }
 
 // GetDictionary returns the complete dictionary with all word collections
 func GetDictionary() Dictionary {
-	return Dictionary{
+	dict := Dictionary{
 		Adjectives:   Adjectives,
 		Nouns:        Nouns,
 		Verbs:        Verbs,
 		Adverbs:      Adverbs,
 		Prepositions: Prepositions,
+		Colors:       Colors,
 		Stats:        GetDictionaryStats(),
 	}
+	dict.index = builtinDictionaryIndex(dict)
+	return dict
+}
+
+// NewDictionary builds a dictionary from the given word collections
+// and computes its statistics
+func NewDictionary(adjectives, nouns, verbs, adverbs, prepositions []string) Dictionary {
+	dict := Dictionary{
+		Adjectives:   adjectives,
+		Nouns:        nouns,
+		Verbs:        verbs,
+		Adverbs:      adverbs,
+		Prepositions: prepositions,
+	}
+	dict.Stats = statsOf(dict)
+	dict.index = buildIndex(dict)
+	return dict
+}
+
+// statsOf returns the class sizes of the dictionary counted from its word
+// lists, so a struct-literal dictionary without Stats is sized correctly
+func statsOf(d Dictionary) DictionaryStats {
+	return DictionaryStats{
+		Adjectives:   len(d.Adjectives),
+		Nouns:        len(d.Nouns),
+		Verbs:        len(d.Verbs),
+		Adverbs:      len(d.Adverbs),
+		Prepositions: len(d.Prepositions),
+	}
+}
+
+// Words returns the word collection for the given class
+func (d Dictionary) Words(class WordClass) []string {
+	switch class {
+	case ClassAdjective:
+		return d.Adjectives
+	case ClassNoun:
+		return d.Nouns
+	case ClassVerb:
+		return d.Verbs
+	case ClassAdverb:
+		return d.Adverbs
+	case ClassPreposition:
+		return d.Prepositions
+	case ClassColor:
+		return d.Colors
+	default:
+		return nil
+	}
+}
+
+// Contains reports whether word belongs to the given class of the dictionary
+func (d Dictionary) Contains(class WordClass, word string) bool {
+	return d.IndexOf(class, word) >= 0
+}
+
+// WordClassOf returns the first class, in component order, that contains
+// word. Some words belong to several classes (e.g. "fast" is both an
+// adjective and an adverb); use WordClassesOf to get all of them.
+//
+// Example:
+//
+//	GetDictionary().WordClassOf("rabbit") // ClassNoun, true
+//	GetDictionary().WordClassOf("fast")   // ClassAdjective, true
+//	GetDictionary().WordClassOf("zebra")  // 0, false
+func (d Dictionary) WordClassOf(word string) (WordClass, bool) {
+	index := indexFor(d)
+	for _, class := range allWordClasses {
+		if _, ok := index.positionsOf(class)[word]; ok {
+			return class, true
+		}
+	}
+	return 0, false
+}
+
+// WordClassesOf returns every class that contains word, in component order
+// followed by the extra classes
+func (d Dictionary) WordClassesOf(word string) []WordClass {
+	index := indexFor(d)
+	var classes []WordClass
+	for _, class := range allWordClasses {
+		if _, ok := index.positionsOf(class)[word]; ok {
+			classes = append(classes, class)
+		}
+	}
+	return classes
+}
+
+// mapClasses returns a new dictionary with fn applied to every word collection
+func (d Dictionary) mapClasses(fn func(class WordClass, words []string) []string) Dictionary {
+	dict := NewDictionary(
+		fn(ClassAdjective, d.Adjectives),
+		fn(ClassNoun, d.Nouns),
+		fn(ClassVerb, d.Verbs),
+		fn(ClassAdverb, d.Adverbs),
+		fn(ClassPreposition, d.Prepositions),
+	)
+	if d.Colors != nil {
+		dict.Colors = fn(ClassColor, d.Colors)
+		dict.index = buildIndex(dict)
+	}
+	return dict
+}
+
+// ErrEmptyWordClass is returned by Validate when a word class has no words,
+// e.g. after filtering the dictionary too aggressively
+var ErrEmptyWordClass = errors.New("dictionary word class is empty")
+
+// Validate checks that every word collection is non-empty and contains
+// no empty or duplicate words
+func (d Dictionary) Validate() error {
+	return d.validateClasses(wordClasses)
+}
+
+// validateClasses checks the word collections of classes like Validate,
+// for options using only some of them
+func (d Dictionary) validateClasses(classes []WordClass) error {
+	for _, class := range classes {
+		words := d.Words(class)
+		if len(words) == 0 {
+			return fmt.Errorf("%w: no %s words", ErrEmptyWordClass, class)
+		}
+
+		seen := make(map[string]struct{}, len(words))
+		for _, word := range words {
+			if word == "" {
+				return fmt.Errorf("dictionary contains an empty %s", class)
+			}
+			if _, ok := seen[word]; ok {
+				return fmt.Errorf("dictionary contains duplicate %s %q", class, word)
+			}
+			seen[word] = struct{}{}
+		}
+	}
+	return nil
+}
+
+// FilterLength returns the subset of the dictionary whose words are between
+// minLength and maxLength characters long (inclusive), with recomputed stats.
+// A maxLength below 1 means no upper bound. Call Validate on the result to
+// make sure every class still has words.
+//
+// Example:
+//
+//	short := GetDictionary().FilterLength(1, 4)
+//	short.Validate() // nil
+//	short.Nouns      // ["fox", "bat", "deer", ...]
+func (d Dictionary) FilterLength(minLength, maxLength int) Dictionary {
+	return d.mapClasses(func(_ WordClass, words []string) []string {
+		filtered := make([]string, 0, len(words))
+		for _, word := range words {
+			length := utf8.RuneCountInString(word)
+			if length < minLength || (maxLength > 0 && length > maxLength) {
+				continue
+			}
+			filtered = append(filtered, word)
+		}
+		return filtered
+	})
 }
@@ -0,0 +1,90 @@
+package memorable_ids
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// ParseWordClass returns the word class with the given name, as returned by WordClass.String
+func ParseWordClass(name string) (WordClass, bool) {
+	for _, class := range allWordClasses {
+		if class.String() == name {
+			return class, true
+		}
+	}
+	return 0, false
+}
+
+// ReadDictionary parses a dictionary file. The format is plain UTF-8 text
+// with one word per line, grouped under a "[class]" header per word class;
+// blank lines and lines starting with "#" are ignored. The result is
+// compact, see Dictionary.Compact.
+//
+// Example:
+//
+//	# fantasy pack
+//	[adjective]
+//	ancient
+//	[noun]
+//	dragon
+func ReadDictionary(r io.Reader) (Dictionary, error) {
+	var classes [6][]string
+	current := -1
+
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		if strings.HasPrefix(text, "[") && strings.HasSuffix(text, "]") {
+			class, ok := ParseWordClass(strings.TrimSpace(text[1 : len(text)-1]))
+			if !ok {
+				return Dictionary{}, fmt.Errorf("dictionary line %d: unknown word class %s", line, text)
+			}
+			current = int(class)
+			continue
+		}
+
+		if current < 0 {
+			return Dictionary{}, fmt.Errorf("dictionary line %d: word %q before any [class] header", line, text)
+		}
+		classes[current] = append(classes[current], text)
+	}
+	if err := scanner.Err(); err != nil {
+		return Dictionary{}, err
+	}
+
+	for i := range classes {
+		classes[i] = compactWords(classes[i])
+	}
+	dict := NewDictionary(classes[0], classes[1], classes[2], classes[3], classes[4])
+	if classes[ClassColor] != nil {
+		dict.Colors = classes[ClassColor]
+		dict.index = buildIndex(dict)
+	}
+	return dict, nil
+}
+
+// WriteDictionary writes d in the format read by ReadDictionary. Extra
+// classes such as colors are only written when non-empty.
+func WriteDictionary(w io.Writer, d Dictionary) error {
+	bw := bufio.NewWriter(w)
+	for i, class := range allWordClasses {
+		if class > ClassPreposition && len(d.Words(class)) == 0 {
+			continue
+		}
+		if i > 0 {
+			bw.WriteByte('\n')
+		}
+		fmt.Fprintf(bw, "[%s]\n", class)
+		for _, word := range d.Words(class) {
+			bw.WriteString(word)
+			bw.WriteByte('\n')
+		}
+	}
+	return bw.Flush()
+}
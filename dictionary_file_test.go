@@ -0,0 +1,48 @@
+package memorable_ids
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDictionaryFile(t *testing.T) {
+	t.Run("should round-trip the built-in dictionary", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, WriteDictionary(&buf, GetDictionary()))
+
+		dict, err := ReadDictionary(&buf)
+		require.NoError(t, err)
+		assert.Equal(t, Nouns, dict.Nouns)
+		assert.Equal(t, GetDictionaryStats(), dict.Stats)
+		assert.True(t, dict.Contains(ClassVerb, "swim"))
+	})
+
+	t.Run("should skip comments and blank lines", func(t *testing.T) {
+		dict, err := ReadDictionary(strings.NewReader("# pack\n\n[adjective]\nancient\n  \n[noun]\ndragon\n"))
+		require.NoError(t, err)
+		assert.Equal(t, []string{"ancient"}, dict.Adjectives)
+		assert.Equal(t, []string{"dragon"}, dict.Nouns)
+		assert.Empty(t, dict.Verbs)
+	})
+
+	t.Run("should report malformed files with line numbers", func(t *testing.T) {
+		_, err := ReadDictionary(strings.NewReader("dragon\n"))
+		assert.ErrorContains(t, err, "line 1")
+
+		_, err = ReadDictionary(strings.NewReader("[noun]\ndragon\n[pronoun]\n"))
+		assert.ErrorContains(t, err, "line 3: unknown word class [pronoun]")
+	})
+
+	t.Run("should parse word class names", func(t *testing.T) {
+		class, ok := ParseWordClass("adverb")
+		assert.True(t, ok)
+		assert.Equal(t, ClassAdverb, class)
+
+		_, ok = ParseWordClass("pronoun")
+		assert.False(t, ok)
+	})
+}
@@ -0,0 +1,162 @@
+package memorable_ids
+
+import (
+	"sync"
+	"sync/atomic"
+	"unsafe"
+)
+
+/**
+ * Dictionary membership index
+ *
+ * Membership checks and word lookups use prebuilt hash maps instead of
+ * scanning the word slices, keeping validation of bulk imports linear even
+ * with large custom dictionaries. Indexes are built once per set of word
+ * slices: eagerly by NewDictionary, lazily (then cached) for the
+ * package-level collections and dictionaries built as struct literals, and
+ * on first lookup for mapped packs. Word slices must not be modified in
+ * place after a dictionary has been created.
+ */
+
+// dictionaryIndex maps every word to its position within each class
+type dictionaryIndex struct {
+	// headers identify the slices the index was built from
+	headers [6]sliceHeader
+	// words are the indexed slices, indexed by WordClass
+	words [6][]string
+	// positions maps word → index for every class, indexed by WordClass;
+	// use positionsOf, as lazy indexes fill it on first use
+	positionsOnce sync.Once
+	positions     [6]map[string]int
+
+	// tries are the prefix indexes of every class, see trie.go
+	triesOnce sync.Once
+	tries     [6]*wordTrie
+}
+
+// sliceHeader identifies a slice by its backing array and length
+type sliceHeader struct {
+	data   *string
+	length int
+}
+
+func headerOf(words []string) sliceHeader {
+	return sliceHeader{data: unsafe.SliceData(words), length: len(words)}
+}
+
+// builtinIndex caches the index of the package-level word collections
+var builtinIndex atomic.Pointer[dictionaryIndex]
+
+// maxCachedIndexes bounds the index cache of dictionaries built without
+// NewDictionary; it is cleared when full
+const maxCachedIndexes = 64
+
+// literalIndexes caches the indexes of dictionaries built without
+// NewDictionary, keyed by their slices, so lookups don't rebuild them
+var literalIndexes = struct {
+	mu      sync.Mutex
+	entries map[[6]sliceHeader]*dictionaryIndex
+}{entries: make(map[[6]sliceHeader]*dictionaryIndex)}
+
+// cachedIndex returns the cached index of a dictionary built without
+// NewDictionary, creating it on first use
+func cachedIndex(d Dictionary) *dictionaryIndex {
+	index := lazyIndex(d)
+
+	literalIndexes.mu.Lock()
+	defer literalIndexes.mu.Unlock()
+
+	if cached, ok := literalIndexes.entries[index.headers]; ok {
+		return cached
+	}
+	if len(literalIndexes.entries) >= maxCachedIndexes {
+		clear(literalIndexes.entries)
+	}
+	literalIndexes.entries[index.headers] = index
+	return index
+}
+
+// buildIndex creates the membership index for a dictionary
+func buildIndex(d Dictionary) *dictionaryIndex {
+	index := lazyIndex(d)
+	index.positionsOnce.Do(index.fill)
+	return index
+}
+
+// lazyIndex creates a membership index whose maps are only built on first
+// use, so loading huge dictionaries doesn't pay for lookups it may never do
+func lazyIndex(d Dictionary) *dictionaryIndex {
+	index := &dictionaryIndex{}
+	for i, class := range allWordClasses {
+		index.words[i] = d.Words(class)
+		index.headers[i] = headerOf(index.words[i])
+	}
+	return index
+}
+
+// fill builds the position maps
+func (x *dictionaryIndex) fill() {
+	for i, words := range x.words {
+		positions := make(map[string]int, len(words))
+		for position, word := range words {
+			if _, ok := positions[word]; !ok {
+				positions[word] = position
+			}
+		}
+		x.positions[i] = positions
+	}
+}
+
+// positionsOf returns the word positions of a class
+func (x *dictionaryIndex) positionsOf(class WordClass) map[string]int {
+	x.positionsOnce.Do(x.fill)
+	return x.positions[class]
+}
+
+// matches reports whether the index was built from the dictionary's current slices
+func (x *dictionaryIndex) matches(d Dictionary) bool {
+	for i, class := range allWordClasses {
+		if x.headers[i] != headerOf(d.Words(class)) {
+			return false
+		}
+	}
+	return true
+}
+
+// indexFor returns an up-to-date membership index for the dictionary
+func indexFor(d Dictionary) *dictionaryIndex {
+	if d.index != nil && d.index.matches(d) {
+		return d.index
+	}
+	if cached := builtinIndex.Load(); cached != nil && cached.matches(d) {
+		return cached
+	}
+	return cachedIndex(d)
+}
+
+// builtinDictionaryIndex returns the cached index of the package-level collections
+func builtinDictionaryIndex(d Dictionary) *dictionaryIndex {
+	if cached := builtinIndex.Load(); cached != nil && cached.matches(d) {
+		return cached
+	}
+	index := buildIndex(d)
+	builtinIndex.Store(index)
+	return index
+}
+
+// IndexOf returns the position of word within the given class, or -1
+//
+// Example:
+//
+//	GetDictionary().IndexOf(ClassAdjective, "cute") // 0
+//	GetDictionary().IndexOf(ClassAdjective, "fox")  // -1
+func (d Dictionary) IndexOf(class WordClass, word string) int {
+	if class < ClassAdjective || class > ClassColor {
+		return -1
+	}
+	position, ok := indexFor(d).positionsOf(class)[word]
+	if !ok {
+		return -1
+	}
+	return position
+}
@@ -0,0 +1,63 @@
+package memorable_ids
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDictionaryIndex(t *testing.T) {
+	t.Run("should return word positions", func(t *testing.T) {
+		dict := GetDictionary()
+
+		assert.Equal(t, 0, dict.IndexOf(ClassAdjective, "cute"))
+		assert.Equal(t, len(Prepositions)-1, dict.IndexOf(ClassPreposition, Prepositions[len(Prepositions)-1]))
+		assert.Equal(t, -1, dict.IndexOf(ClassAdjective, "rabbit"))
+		assert.Equal(t, -1, dict.IndexOf(WordClass(42), "cute"))
+	})
+
+	t.Run("should reuse the cached index for the built-in dictionary", func(t *testing.T) {
+		assert.Same(t, GetDictionary().index, GetDictionary().index)
+	})
+
+	t.Run("should rebuild the index when package collections are replaced", func(t *testing.T) {
+		original := Nouns
+		Nouns = []string{"comet", "nebula"}
+		defer func() { Nouns = original }()
+
+		assert.True(t, ClassNoun.Contains("comet"))
+		assert.False(t, ClassNoun.Contains("rabbit"))
+	})
+
+	t.Run("should index dictionaries built without NewDictionary", func(t *testing.T) {
+		dict := Dictionary{Nouns: []string{"comet"}}
+
+		assert.True(t, dict.Contains(ClassNoun, "comet"))
+		assert.Equal(t, -1, dict.IndexOf(ClassAdjective, "comet"))
+	})
+
+	t.Run("should build the index of struct-literal dictionaries once", func(t *testing.T) {
+		dict := Dictionary{Nouns: []string{"comet", "nebula"}}
+
+		assert.Same(t, indexFor(dict), indexFor(dict))
+		assert.Same(t, indexFor(dict).classTries(dict)[ClassNoun], indexFor(dict).classTries(dict)[ClassNoun])
+
+		dict.Nouns = []string{"quasar"}
+		assert.True(t, dict.Contains(ClassNoun, "quasar"))
+		assert.False(t, dict.Contains(ClassNoun, "comet"))
+	})
+}
+
+func BenchmarkDictionaryContains(b *testing.B) {
+	words := make([]string, 100000)
+	for i := range words {
+		words[i] = fmt.Sprintf("word%06d", i)
+	}
+	dict := NewDictionary(words, words, words, words, words)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		dict.Contains(ClassNoun, words[i%len(words)])
+	}
+}
@@ -0,0 +1,95 @@
+package memorable_ids
+
+import (
+	"math"
+	"unicode/utf8"
+)
+
+// ClassStats contains the statistics of one word class
+type ClassStats struct {
+	// Class is the word class described
+	Class WordClass
+	// Words is the number of words in the class
+	Words int
+	// MinLength and MaxLength are the shortest and longest word lengths in characters
+	MinLength int
+	MaxLength int
+	// AverageLength is the mean word length in characters
+	AverageLength float64
+	// TotalCharacters is the sum of all word lengths in characters
+	TotalCharacters int
+	// EntropyBits is log2 of the class size, the entropy a word of the class adds to an ID
+	EntropyBits float64
+}
+
+// ClassOverlap lists the words shared by two word classes
+type ClassOverlap struct {
+	// Classes are the two overlapping classes, in component order
+	Classes [2]WordClass
+	// Words are the shared words, in the order of the first class
+	Words []string
+}
+
+// DetailedDictionaryStats extends DictionaryStats with per-class word
+// length and entropy statistics and the words shared between classes, for
+// data-driven dictionary curation
+type DetailedDictionaryStats struct {
+	DictionaryStats
+	// Classes holds the statistics of each component class, in component order
+	Classes []ClassStats
+	// TotalCharacters is the sum of all word lengths in characters
+	TotalCharacters int
+	// Overlaps lists every pair of component classes sharing words
+	Overlaps []ClassOverlap
+}
+
+// DetailedStats computes the detailed statistics of the dictionary
+//
+// Example:
+//
+//	stats := GetDictionary().DetailedStats()
+//	stats.Classes[0].AverageLength // 5.05 (adjectives)
+//	stats.Classes[1].EntropyBits   // 6.17 (nouns)
+//	stats.Overlaps                 // [{[adjective adverb] [fast]} {[noun verb] [fly]}]
+func (d Dictionary) DetailedStats() DetailedDictionaryStats {
+	stats := DetailedDictionaryStats{
+		DictionaryStats: statsOf(d),
+		Classes:         make([]ClassStats, 0, len(wordClasses)),
+	}
+
+	for _, class := range wordClasses {
+		words := d.Words(class)
+		classStats := ClassStats{Class: class, Words: len(words)}
+		for i, word := range words {
+			length := utf8.RuneCountInString(word)
+			if i == 0 || length < classStats.MinLength {
+				classStats.MinLength = length
+			}
+			classStats.MaxLength = max(classStats.MaxLength, length)
+			classStats.TotalCharacters += length
+		}
+		if len(words) > 0 {
+			classStats.AverageLength = float64(classStats.TotalCharacters) / float64(len(words))
+			classStats.EntropyBits = math.Log2(float64(len(words)))
+		}
+		stats.TotalCharacters += classStats.TotalCharacters
+		stats.Classes = append(stats.Classes, classStats)
+	}
+
+	index := indexFor(d)
+	for i, first := range wordClasses {
+		for _, second := range wordClasses[i+1:] {
+			var shared []string
+			for _, word := range d.Words(first) {
+				if _, ok := index.positionsOf(second)[word]; ok {
+					shared = append(shared, word)
+				}
+			}
+			if len(shared) > 0 {
+				stats.Overlaps = append(stats.Overlaps, ClassOverlap{Classes: [2]WordClass{first, second}, Words: shared})
+			}
+		}
+	}
+
+	return stats
+}
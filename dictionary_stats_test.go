@@ -0,0 +1,54 @@
+package memorable_ids
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDetailedStats(t *testing.T) {
+	t.Run("should compute per-class length and entropy statistics", func(t *testing.T) {
+		dict := NewDictionary([]string{"red", "blue", "green"}, []string{"fox", "fly"}, []string{"fly", "run"}, []string{"fast"}, []string{"in"})
+		stats := dict.DetailedStats()
+
+		assert.Equal(t, dict.Stats, stats.DictionaryStats)
+		require.Len(t, stats.Classes, 5)
+
+		adjectives := stats.Classes[0]
+		assert.Equal(t, ClassAdjective, adjectives.Class)
+		assert.Equal(t, 3, adjectives.Words)
+		assert.Equal(t, 3, adjectives.MinLength)
+		assert.Equal(t, 5, adjectives.MaxLength)
+		assert.Equal(t, 12, adjectives.TotalCharacters)
+		assert.InDelta(t, 4.0, adjectives.AverageLength, 1e-9)
+		assert.InDelta(t, math.Log2(3), adjectives.EntropyBits, 1e-9)
+
+		assert.Equal(t, 0.0, stats.Classes[3].EntropyBits, "a single word adds no entropy")
+		assert.Equal(t, 12+6+6+4+2, stats.TotalCharacters)
+	})
+
+	t.Run("should list words shared between classes", func(t *testing.T) {
+		dict := NewDictionary([]string{"fast", "red"}, []string{"fox", "fly"}, []string{"fly", "run"}, []string{"fast"}, []string{"in"})
+		stats := dict.DetailedStats()
+
+		assert.Equal(t, []ClassOverlap{
+			{Classes: [2]WordClass{ClassAdjective, ClassAdverb}, Words: []string{"fast"}},
+			{Classes: [2]WordClass{ClassNoun, ClassVerb}, Words: []string{"fly"}},
+		}, stats.Overlaps)
+	})
+
+	t.Run("should match the built-in dictionary stats", func(t *testing.T) {
+		stats := GetDictionary().DetailedStats()
+		assert.Equal(t, GetDictionaryStats(), stats.DictionaryStats)
+
+		longest, err := MaxIDLength(GenerateOptions{Components: 5, Separator: "-"})
+		require.NoError(t, err)
+		total := 4
+		for _, class := range stats.Classes {
+			total += class.MaxLength
+		}
+		assert.Equal(t, longest, total)
+	})
+}
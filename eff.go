@@ -0,0 +1,102 @@
+package memorable_ids
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// EFFWordlist is a diceware wordlist in the format published by the EFF,
+// where every word is keyed by the dice rolls selecting it
+// ("11111<TAB>abacus"). The long list has 7776 words keyed by five dice,
+// the short lists 1296 words keyed by four dice.
+type EFFWordlist struct {
+	words []string
+	rolls map[string]string
+	dice  int
+}
+
+// LoadEFFWordlist reads an EFF wordlist, checking that the dice keys are
+// consistent and cover every roll exactly once
+//
+// Example:
+//
+//	f, _ := os.Open("eff_large_wordlist.txt")
+//	list, _ := LoadEFFWordlist(f)
+//	list.Lookup("66666") // "zoom", true
+//
+//	GeneratePassphrase(PassphraseOptions{Words: list.Words()})
+func LoadEFFWordlist(r io.Reader) (*EFFWordlist, error) {
+	list := &EFFWordlist{rolls: make(map[string]string)}
+	seen := make(map[string]struct{})
+
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("EFF wordlist line %d: expected dice rolls and a word", line)
+		}
+
+		rolls, word := fields[0], fields[1]
+		if strings.Trim(rolls, "123456") != "" {
+			return nil, fmt.Errorf("EFF wordlist line %d: invalid dice rolls %q", line, rolls)
+		}
+		if list.dice == 0 {
+			list.dice = len(rolls)
+		}
+		if len(rolls) != list.dice {
+			return nil, fmt.Errorf("EFF wordlist line %d: expected %d dice, got %q", line, list.dice, rolls)
+		}
+		if _, ok := list.rolls[rolls]; ok {
+			return nil, fmt.Errorf("EFF wordlist line %d: duplicate dice rolls %q", line, rolls)
+		}
+		if _, ok := seen[word]; ok {
+			return nil, fmt.Errorf("EFF wordlist line %d: duplicate word %q", line, word)
+		}
+
+		list.rolls[rolls] = word
+		list.words = append(list.words, word)
+		seen[word] = struct{}{}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if list.dice == 0 {
+		return nil, fmt.Errorf("EFF wordlist is empty")
+	}
+	expected := 1
+	for i := 0; i < list.dice; i++ {
+		expected *= 6
+	}
+	if len(list.words) != expected {
+		return nil, fmt.Errorf("EFF wordlist with %d dice must have %d words, got %d", list.dice, expected, len(list.words))
+	}
+	return list, nil
+}
+
+// Words returns a copy of the words in file order, e.g. for PassphraseOptions.Words
+func (l *EFFWordlist) Words() []string {
+	return append([]string(nil), l.words...)
+}
+
+// Dice returns the number of dice rolled per word
+func (l *EFFWordlist) Dice() int {
+	return l.dice
+}
+
+// Lookup returns the word selected by physical dice rolls such as "16354"
+func (l *EFFWordlist) Lookup(rolls string) (string, bool) {
+	word, ok := l.rolls[rolls]
+	return word, ok
+}
+
+// Dictionary returns a dictionary using the wordlist for every word class,
+// as a drop-in vocabulary for Generate-style IDs
+func (l *EFFWordlist) Dictionary() Dictionary {
+	return NewDictionary(l.words, l.words, l.words, l.words, l.words)
+}
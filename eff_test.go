@@ -0,0 +1,70 @@
+package memorable_ids
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// effTestList renders a synthetic four-dice EFF wordlist
+func effTestList() string {
+	var buf bytes.Buffer
+	i := 0
+	for a := '1'; a <= '6'; a++ {
+		for b := '1'; b <= '6'; b++ {
+			for c := '1'; c <= '6'; c++ {
+				for d := '1'; d <= '6'; d++ {
+					fmt.Fprintf(&buf, "%c%c%c%c\tword%d\n", a, b, c, d, i)
+					i++
+				}
+			}
+		}
+	}
+	return buf.String()
+}
+
+func TestEFFWordlist(t *testing.T) {
+	t.Run("should load a short list keyed by four dice", func(t *testing.T) {
+		list, err := LoadEFFWordlist(strings.NewReader(effTestList()))
+		require.NoError(t, err)
+
+		assert.Equal(t, 4, list.Dice())
+		assert.Len(t, list.Words(), 1296)
+
+		word, ok := list.Lookup("1112")
+		assert.True(t, ok)
+		assert.Equal(t, "word1", word)
+
+		_, ok = list.Lookup("7777")
+		assert.False(t, ok)
+	})
+
+	t.Run("should work as a passphrase vocabulary and dictionary", func(t *testing.T) {
+		list, err := LoadEFFWordlist(strings.NewReader(effTestList()))
+		require.NoError(t, err)
+
+		passphrase, err := GeneratePassphrase(PassphraseOptions{Words: list.Words(), MinEntropyBits: 40})
+		require.NoError(t, err)
+		assert.Equal(t, 4, passphrase.Words, "Expected 10.3 bits per word")
+
+		assert.NoError(t, list.Dictionary().Validate())
+	})
+
+	t.Run("should reject malformed lists", func(t *testing.T) {
+		_, err := LoadEFFWordlist(strings.NewReader("1111\tabacus\n1112\n"))
+		assert.ErrorContains(t, err, "line 2")
+
+		_, err = LoadEFFWordlist(strings.NewReader("1117\tabacus\n"))
+		assert.ErrorContains(t, err, "invalid dice rolls")
+
+		_, err = LoadEFFWordlist(strings.NewReader("1111\tabacus\n1112\tabdomen\n"))
+		assert.ErrorContains(t, err, "must have 1296 words")
+
+		_, err = LoadEFFWordlist(strings.NewReader(""))
+		assert.ErrorContains(t, err, "empty")
+	})
+}
@@ -0,0 +1,140 @@
+package memorable_ids
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+)
+
+// ErrLockUnsupported is returned by OpenFileStore on platforms without advisory file locks
+var ErrLockUnsupported = errors.New("file locking is not supported on this platform")
+
+// FileStore is a Store backed by an append-only file with one ID per line,
+// guarded by an advisory lock, so several processes on the same machine
+// (e.g. parallel CI jobs naming artifacts) never reserve the same ID
+// without a server. A FileStore is safe for concurrent use; IDs reserved
+// by other processes are picked up incrementally on every call.
+//
+// Example:
+//
+//	store, err := OpenFileStore("/var/tmp/artifact-names")
+//	if err != nil {
+//	  return err
+//	}
+//	defer store.Close()
+//	registry := &Namespaces{NewStore: func(string) Store { return store }}
+type FileStore struct {
+	mu     sync.Mutex
+	file   *os.File
+	ids    map[string]struct{}
+	offset int64
+	// torn is set when the file ends in a partial line
+	torn bool
+}
+
+// OpenFileStore opens or creates the store file at path. It returns
+// ErrLockUnsupported on platforms without advisory file locks.
+func OpenFileStore(path string) (*FileStore, error) {
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, err
+	}
+	if err := lockFile(file, false); err != nil {
+		file.Close()
+		return nil, err
+	}
+	unlockFile(file)
+	return &FileStore{file: file, ids: make(map[string]struct{})}, nil
+}
+
+// Reserve implements Store
+func (s *FileStore) Reserve(id string) (bool, error) {
+	if id == "" || strings.ContainsAny(id, "\r\n") {
+		return false, fmt.Errorf("file store: invalid ID %q", id)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := lockFile(s.file, true); err != nil {
+		return false, fmt.Errorf("file store: lock: %w", err)
+	}
+	defer unlockFile(s.file)
+
+	if err := s.catchUp(); err != nil {
+		return false, err
+	}
+	if _, taken := s.ids[id]; taken {
+		return false, nil
+	}
+
+	line := id + "\n"
+	if s.torn {
+		line = "\n" + line
+	}
+	n, err := s.file.WriteString(line)
+	if err != nil {
+		return false, fmt.Errorf("file store: %w", err)
+	}
+	if err := s.file.Sync(); err != nil {
+		return false, fmt.Errorf("file store: %w", err)
+	}
+	s.ids[id] = struct{}{}
+	s.offset += int64(n)
+	s.torn = false
+	return true, nil
+}
+
+// List implements Lister
+func (s *FileStore) List() ([]string, error) {
+	return s.ListPrefix("")
+}
+
+// ListPrefix implements PrefixLister
+func (s *FileStore) ListPrefix(prefix string) ([]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := lockFile(s.file, false); err != nil {
+		return nil, fmt.Errorf("file store: lock: %w", err)
+	}
+	defer unlockFile(s.file)
+
+	if err := s.catchUp(); err != nil {
+		return nil, err
+	}
+	return sortedWithPrefix(s.ids, prefix), nil
+}
+
+// Close closes the store file
+func (s *FileStore) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.file.Close()
+}
+
+// catchUp reads the IDs appended since the last call; the caller must hold
+// s.mu and the file lock. A trailing partial line, left by a writer that
+// crashed mid-append, is skipped and terminated by the next append.
+func (s *FileStore) catchUp() error {
+	reader := bufio.NewReader(io.NewSectionReader(s.file, s.offset, 1<<62))
+	for {
+		line, err := reader.ReadString('\n')
+		if err == io.EOF {
+			s.offset += int64(len(line))
+			s.torn = s.torn || len(line) > 0
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("file store: %w", err)
+		}
+		s.offset += int64(len(line))
+		if id := strings.TrimRight(line, "\r\n"); id != "" {
+			s.ids[id] = struct{}{}
+		}
+	}
+}
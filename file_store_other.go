@@ -0,0 +1,13 @@
+//go:build !unix
+
+package memorable_ids
+
+import "os"
+
+func lockFile(*os.File, bool) error {
+	return ErrLockUnsupported
+}
+
+func unlockFile(*os.File) error {
+	return ErrLockUnsupported
+}
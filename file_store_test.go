@@ -0,0 +1,125 @@
+//go:build unix
+
+package memorable_ids
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileStore(t *testing.T) {
+	t.Run("should reserve each ID once", func(t *testing.T) {
+		store, err := OpenFileStore(filepath.Join(t.TempDir(), "ids"))
+		require.NoError(t, err)
+		defer store.Close()
+
+		reserved, err := store.Reserve("cute-rabbit")
+		require.NoError(t, err)
+		assert.True(t, reserved)
+
+		reserved, err = store.Reserve("cute-rabbit")
+		require.NoError(t, err)
+		assert.False(t, reserved)
+	})
+
+	t.Run("should share reservations between independent handles", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "ids")
+		first, err := OpenFileStore(path)
+		require.NoError(t, err)
+		defer first.Close()
+		second, err := OpenFileStore(path)
+		require.NoError(t, err)
+		defer second.Close()
+
+		var wg sync.WaitGroup
+		results := make([][]bool, 2)
+		for i, store := range []*FileStore{first, second} {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for j := 0; j < 200; j++ {
+					reserved, err := store.Reserve(nthTestID(t, j))
+					require.NoError(t, err)
+					results[i] = append(results[i], reserved)
+				}
+			}()
+		}
+		wg.Wait()
+
+		for j := 0; j < 200; j++ {
+			assert.NotEqual(t, results[0][j], results[1][j], "ID %d reserved by exactly one handle", j)
+		}
+
+		ids, err := first.List()
+		require.NoError(t, err)
+		assert.Len(t, ids, 200)
+	})
+
+	t.Run("should list the IDs under a prefix", func(t *testing.T) {
+		store, err := OpenFileStore(filepath.Join(t.TempDir(), "ids"))
+		require.NoError(t, err)
+		defer store.Close()
+		for _, id := range []string{"builds/large-fox", "deploys/cute-rabbit", "builds/able-ant"} {
+			_, err := store.Reserve(id)
+			require.NoError(t, err)
+		}
+
+		ids, err := store.ListPrefix("builds/")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"builds/able-ant", "builds/large-fox"}, ids)
+	})
+
+	t.Run("should persist reservations across reopening", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "ids")
+		store, err := OpenFileStore(path)
+		require.NoError(t, err)
+		_, err = store.Reserve("large-fox")
+		require.NoError(t, err)
+		require.NoError(t, store.Close())
+
+		reopened, err := OpenFileStore(path)
+		require.NoError(t, err)
+		defer reopened.Close()
+		reserved, err := reopened.Reserve("large-fox")
+		require.NoError(t, err)
+		assert.False(t, reserved)
+	})
+
+	t.Run("should recover from a torn last line", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "ids")
+		require.NoError(t, os.WriteFile(path, []byte("cute-rabbit\nlarge-f"), 0o644))
+
+		store, err := OpenFileStore(path)
+		require.NoError(t, err)
+		defer store.Close()
+
+		reserved, err := store.Reserve("large-fox")
+		require.NoError(t, err)
+		assert.True(t, reserved)
+
+		content, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.Equal(t, "cute-rabbit\nlarge-f\nlarge-fox\n", string(content))
+	})
+
+	t.Run("should reject IDs containing line breaks", func(t *testing.T) {
+		store, err := OpenFileStore(filepath.Join(t.TempDir(), "ids"))
+		require.NoError(t, err)
+		defer store.Close()
+
+		_, err = store.Reserve("cute\nrabbit")
+		assert.Error(t, err)
+	})
+}
+
+// nthTestID returns a distinct two-word ID for index n
+func nthTestID(t *testing.T, n int) string {
+	id, err := NthID(n, GenerateOptions{})
+	require.NoError(t, err)
+	return id
+}
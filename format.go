@@ -0,0 +1,104 @@
+package memorable_ids
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// NumberFormat controls how analysis numbers are rendered, so the output
+// can go straight into customer-facing documents
+type NumberFormat struct {
+	// Precision is the number of decimals of percentages
+	Precision int
+	// Floor renders probabilities below it as "<" followed by the floor,
+	// e.g. 0.0001 gives "<0.01%"; 0 disables the floor
+	Floor float64
+	// ThousandsSeparator groups integer digits by three, "" disables grouping
+	ThousandsSeparator string
+	// DecimalSeparator separates the fractional part (default: ".")
+	DecimalSeparator string
+}
+
+var (
+	// FormatDefault is the format used when AnalysisOptions.Format is nil: "1.23%"
+	FormatDefault = NumberFormat{Precision: 2}
+	// FormatEnglish renders "1,234.57" and "<0.01%"
+	FormatEnglish = NumberFormat{Precision: 2, Floor: 0.0001, ThousandsSeparator: ",", DecimalSeparator: "."}
+	// FormatGerman renders "1.234,57" and "<0,01%"
+	FormatGerman = NumberFormat{Precision: 2, Floor: 0.0001, ThousandsSeparator: ".", DecimalSeparator: ","}
+	// FormatFrench renders "1 234,57" and "<0,01%" with a narrow no-break space
+	FormatFrench = NumberFormat{Precision: 2, Floor: 0.0001, ThousandsSeparator: " ", DecimalSeparator: ","}
+)
+
+// Percentage formats a probability (0-1) as a percentage
+//
+// Example:
+//
+//	FormatEnglish.Percentage(0.2183)   // "21.83%"
+//	FormatEnglish.Percentage(0.000004) // "<0.01%"
+//	FormatGerman.Percentage(0.2183)    // "21,83%"
+func (f NumberFormat) Percentage(probability float64) string {
+	if f.Floor > 0 && probability > 0 && probability < f.Floor {
+		return "<" + f.Decimal(f.Floor*100) + "%"
+	}
+	return f.Decimal(probability*100) + "%"
+}
+
+// Decimal formats a number with Precision decimals
+func (f NumberFormat) Decimal(value float64) string {
+	precision := max(f.Precision, 0)
+	formatted := strconv.FormatFloat(math.Abs(value), 'f', precision, 64)
+
+	whole, fraction, _ := strings.Cut(formatted, ".")
+	var b strings.Builder
+	if value < 0 && strings.Trim(formatted, "0.") != "" {
+		b.WriteByte('-')
+	}
+	b.WriteString(f.group(whole))
+	if fraction != "" {
+		b.WriteString(f.decimalSeparator())
+		b.WriteString(fraction)
+	}
+	return b.String()
+}
+
+// Integer formats an integer with thousands grouping
+//
+// Example:
+//
+//	FormatEnglish.Integer(5304000) // "5,304,000"
+func (f NumberFormat) Integer(value int) string {
+	digits := strconv.Itoa(value)
+	if negative, ok := strings.CutPrefix(digits, "-"); ok {
+		return "-" + f.group(negative)
+	}
+	return f.group(digits)
+}
+
+// group inserts the thousands separator into a string of digits
+func (f NumberFormat) group(digits string) string {
+	if f.ThousandsSeparator == "" || len(digits) <= 3 {
+		return digits
+	}
+
+	var b strings.Builder
+	head := len(digits) % 3
+	if head > 0 {
+		b.WriteString(digits[:head])
+	}
+	for i := head; i < len(digits); i += 3 {
+		if b.Len() > 0 {
+			b.WriteString(f.ThousandsSeparator)
+		}
+		b.WriteString(digits[i : i+3])
+	}
+	return b.String()
+}
+
+func (f NumberFormat) decimalSeparator() string {
+	if f.DecimalSeparator == "" {
+		return "."
+	}
+	return f.DecimalSeparator
+}
@@ -0,0 +1,43 @@
+package memorable_ids
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNumberFormat(t *testing.T) {
+	t.Run("should format percentages per locale", func(t *testing.T) {
+		assert.Equal(t, "21.83%", FormatEnglish.Percentage(0.2183))
+		assert.Equal(t, "21,83%", FormatGerman.Percentage(0.2183))
+		assert.Equal(t, "0.22%", FormatDefault.Percentage(0.0022))
+		assert.Equal(t, "21.8%", NumberFormat{Precision: 1}.Percentage(0.2183))
+		assert.Equal(t, "22%", NumberFormat{}.Percentage(0.2183))
+	})
+
+	t.Run("should render probabilities below the floor", func(t *testing.T) {
+		assert.Equal(t, "<0.01%", FormatEnglish.Percentage(0.000004))
+		assert.Equal(t, "<0,01%", FormatGerman.Percentage(0.000004))
+		assert.Equal(t, "0.00%", FormatEnglish.Percentage(0))
+		assert.Equal(t, "0.00%", FormatDefault.Percentage(0.000004))
+	})
+
+	t.Run("should group thousands", func(t *testing.T) {
+		assert.Equal(t, "5,304,000", FormatEnglish.Integer(5304000))
+		assert.Equal(t, "5.304.000", FormatGerman.Integer(5304000))
+		assert.Equal(t, "5 304 000", FormatFrench.Integer(5304000))
+		assert.Equal(t, "-1,000", FormatEnglish.Integer(-1000))
+		assert.Equal(t, "999", FormatEnglish.Integer(999))
+		assert.Equal(t, "-9,223,372,036,854,775,808", FormatEnglish.Integer(math.MinInt64))
+		assert.Equal(t, "1.234,57", FormatGerman.Decimal(1234.567))
+	})
+
+	t.Run("should apply to collision analyses", func(t *testing.T) {
+		analysis := GetCollisionAnalysisWithOptions(3, 1000, AnalysisOptions{Format: &FormatGerman})
+		assert.Equal(t, "<0,01%", analysis.Scenarios[0].Percentage)
+
+		defaults := GetCollisionAnalysis(3, 1000)
+		assert.Equal(t, "0.00%", defaults.Scenarios[0].Percentage)
+	})
+}
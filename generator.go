@@ -0,0 +1,265 @@
+package memorable_ids
+
+import (
+	"log/slog"
+	"math/rand"
+	"strings"
+	"sync"
+)
+
+// Config contains configuration for a Generator
+type Config struct {
+	// Options are the generation options used for every ID
+	Options GenerateOptions
+	// Dictionary is the instance's word source, so generators with
+	// different vocabularies can coexist without touching the package-level
+	// collections; overrides Options.Dictionary (default: nil)
+	Dictionary *Dictionary
+	// SuffixRange is the number of distinct suffix values, used for analysis
+	// (default: derived from the suffix generator, see SuffixRange, otherwise 1)
+	SuffixRange int
+	// Logger receives generation failures such as invalid options
+	// (default: nil, no logging)
+	Logger *slog.Logger
+	// Usage counts the words of every generated ID (default: nil, not tracked)
+	Usage *WordUsage
+	// Random picks the words and the built-in random suffixes (see
+	// SuffixGenerators); it is called under the generator's lock, so it
+	// need not be safe for concurrent use (default: nil, package-level
+	// math/rand)
+	Random RandomSource
+	// Suffix produces the suffixes, overriding Options.Suffix and
+	// Options.SuffixProvider; like Random
+	// it is called under the generator's lock. Set SuffixRange for
+	// analysis unless it is a SuffixGenerator (default: nil)
+	Suffix SuffixSource
+}
+
+// Generator issues memorable IDs from a fixed configuration and keeps
+// per-instance state, such as an estimate of how many distinct IDs
+// it has issued. A Generator is safe for concurrent use.
+type Generator struct {
+	config  Config
+	options GenerateOptions
+	logger  *slog.Logger
+	// err is the options validation error returned by every Generate call
+	err error
+	// drawSuffix draws a built-in suffix from Config.Random, if both are set
+	drawSuffix func(intn func(int) int) string
+
+	// sourceMu serializes calls to the configured sources
+	sourceMu sync.Mutex
+
+	mu     sync.Mutex
+	issued *HyperLogLog
+	// sequence is the index of the next NextSequential ID
+	sequence int
+}
+
+// NewGenerator creates a Generator for the given configuration. The
+// options and dictionary are validated upfront; if they are invalid, Err
+// reports why and every Generate call fails with that error.
+//
+// Example:
+//
+//	gen := NewGenerator(Config{
+//	  Options: GenerateOptions{Components: 3, Suffix: SuffixGenerators.Number},
+//	})
+//	gen.Generate() // "large-fox-swim-042"
+//
+//	space := NewGenerator(Config{Dictionary: &spaceWords, Random: rand.New(rand.NewSource(1))})
+//	space.Generate() // "stellar-comet"
+func NewGenerator(config Config) *Generator {
+	options := config.Options
+	if config.Suffix != nil {
+		options.Suffix = suffixGeneratorOf(config.Suffix)
+		options.SuffixProvider = nil
+	}
+	if config.SuffixRange < 1 {
+		config.SuffixRange = 1
+		if suffixRange, err := options.suffixRange(); err == nil {
+			config.SuffixRange = suffixRange
+		}
+	}
+	if config.Dictionary != nil {
+		options.Dictionary = config.Dictionary
+	}
+	g := &Generator{
+		config:  config,
+		options: options,
+		logger:  loggerOr(config.Logger),
+		err:     options.Validate(),
+		issued:  NewHyperLogLog(14),
+	}
+	if spec, ok := DescribeSuffix(options.Suffix); ok && config.Random != nil && options.SuffixProvider == nil {
+		g.drawSuffix = replayableSuffixes[spec.Name]
+	}
+	return g
+}
+
+// Generate creates a memorable ID and records it as issued
+func (g *Generator) Generate() (string, error) {
+	id, err := g.generate()
+	if err != nil {
+		g.logger.Warn("memorable ID generation failed",
+			slog.Int("components", g.config.Options.Components),
+			slog.Any("error", err))
+		return "", err
+	}
+
+	g.mu.Lock()
+	g.issued.Add(id)
+	g.mu.Unlock()
+
+	if g.config.Usage != nil {
+		g.recordUsage(id)
+	}
+
+	return id, nil
+}
+
+// recordUsage counts the words of id in the classes they were drawn from,
+// rejoining words that contain the separator and leaving out the suffix
+func (g *Generator) recordUsage(id string) {
+	classes, err := g.options.classes()
+	if err != nil {
+		return
+	}
+	separator := g.options.Separator
+	if separator == "" {
+		separator = "-"
+	}
+	words, _, err := takeClassWords(g.dictionary(), strings.Split(id, separator), separator, classes)
+	if err == nil {
+		g.config.Usage.record(classes, words)
+	}
+}
+
+// Err returns the validation error of the generator's options, or nil
+func (g *Generator) Err() error {
+	return g.err
+}
+
+// generate creates an ID from the configured sources
+func (g *Generator) generate() (string, error) {
+	if g.err != nil {
+		return "", g.err
+	}
+	if g.config.Random == nil && g.config.Suffix == nil {
+		return generate(g.dictionary(), g.options, rand.Intn)
+	}
+
+	g.sourceMu.Lock()
+	defer g.sourceMu.Unlock()
+
+	intn := rand.Intn
+	if g.config.Random != nil {
+		intn = g.config.Random.Intn
+	}
+	options := g.options
+	if g.drawSuffix != nil {
+		options.Suffix = func() *string {
+			value := g.drawSuffix(intn)
+			return &value
+		}
+	}
+	return generate(g.dictionary(), options, intn)
+}
+
+// NextSequential returns the word combination at the generator's counter
+// and advances it, producing gap-free IDs in index order (see NthID) for
+// ticket or invoice numbering. The suffix is not used. Once every
+// combination has been issued it returns ErrSpaceExhausted.
+//
+// Example:
+//
+//	gen := NewGenerator(Config{})
+//	gen.NextSequential() // "cute-rabbit", nil
+//	gen.NextSequential() // "dapper-rabbit", nil
+func (g *Generator) NextSequential() (string, error) {
+	if g.err != nil {
+		return "", g.err
+	}
+
+	classes, err := g.options.classes()
+	if err != nil {
+		return "", err
+	}
+	dict := g.dictionary()
+	space, err := wordSpace(dict, classes)
+	if err != nil {
+		return "", err
+	}
+
+	separator := g.options.Separator
+	if separator == "" {
+		separator = "-"
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if space > 0 && g.sequence >= space {
+		return "", ErrSpaceExhausted
+	}
+	id := string(appendWords(nil, dict, classes, separator, g.sequence))
+	g.sequence++
+	g.issued.Add(id)
+	return id, nil
+}
+
+// Sequence returns the counter of the next NextSequential ID
+func (g *Generator) Sequence() int {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.sequence
+}
+
+// SetSequence moves the NextSequential counter, e.g. to resume numbering
+// from a persisted position after a restart. Negative values reset it to 0.
+func (g *Generator) SetSequence(next int) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.sequence = max(next, 0)
+}
+
+// EstimatedIssued returns the estimated number of distinct IDs issued so far
+func (g *Generator) EstimatedIssued() int {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return int(g.issued.Estimate())
+}
+
+// dictionary returns the configured dictionary
+func (g *Generator) dictionary() Dictionary {
+	return g.options.dictionary()
+}
+
+// CollisionAnalysis returns the collision analysis for the generator's
+// configuration, including the live risk based on the IDs issued so far
+//
+// Example:
+//
+//	analysis := gen.CollisionAnalysis()
+//	analysis.Live.EstimatedIssued          // 1200
+//	analysis.Live.NextCollisionProbability // 0.0057
+func (g *Generator) CollisionAnalysis() CollisionAnalysis {
+	total, _ := g.options.combinations(g.config.SuffixRange)
+	analysis := collisionAnalysisFor(total, AnalysisOptions{})
+	issued := g.EstimatedIssued()
+
+	live := LiveCollisionRisk{
+		EstimatedIssued:      issued,
+		CollisionProbability: CalculateCollisionProbability(analysis.TotalCombinations, issued),
+	}
+	if analysis.TotalCombinations > 0 {
+		live.NextCollisionProbability = min(float64(issued)/float64(analysis.TotalCombinations), 1.0)
+	}
+	analysis.Live = &live
+
+	if info, ok := g.options.suffixInfo(); ok && info.timeDerived {
+		analysis.Warnings = append(analysis.Warnings, timeDerivedSuffixWarning)
+	}
+
+	return analysis
+}
@@ -0,0 +1,112 @@
+package memorable_ids
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+)
+
+const (
+	// MinLatLonPrecision is the smallest precision of EncodeLatLon, a word triple
+	MinLatLonPrecision = 3
+	// MaxLatLonPrecision is the largest precision of EncodeLatLon
+	MaxLatLonPrecision = 10
+)
+
+// latLonGrid returns the word classes of a precision and the number of
+// latitude and longitude bins of its grid. Longitude gets twice the bins of
+// latitude so cells are roughly square in degrees.
+func latLonGrid(dict Dictionary, precision int) ([]WordClass, int64, int64) {
+	classes := make([]WordClass, precision)
+	capacity := int64(1)
+	for i := range classes {
+		classes[i] = wordClasses[i%len(wordClasses)]
+		capacity *= int64(len(dict.Words(classes[i])))
+	}
+
+	latBins := int64(math.Sqrt(float64(capacity / 2)))
+	for latBins > 1 && latBins*latBins*2 > capacity {
+		latBins--
+	}
+	return classes, latBins, latBins * 2
+}
+
+// LatLonCellSize returns the size in degrees of the grid cells at a precision
+//
+// Example:
+//
+//	LatLonCellSize(3) // 0.51, 0.51, nil (about 57 km at the equator)
+//	LatLonCellSize(6) // 0.0021, 0.0021, nil (about 230 m)
+func LatLonCellSize(precision int) (latDegrees, lonDegrees float64, err error) {
+	if precision < MinLatLonPrecision || precision > MaxLatLonPrecision {
+		return 0, 0, fmt.Errorf("precision must be between %d and %d", MinLatLonPrecision, MaxLatLonPrecision)
+	}
+	_, latBins, lonBins := latLonGrid(GetDictionary(), precision)
+	return 180 / float64(latBins), 360 / float64(lonBins), nil
+}
+
+// EncodeLatLon maps the grid cell containing a coordinate to a hyphenated
+// phrase of precision words. Precision 3 gives a word triple naming a cell
+// of about 57 km; every further word makes cells several times smaller.
+//
+// Example:
+//
+//	EncodeLatLon(51.5007, -0.1246, 3) // "funny-duck-write", nil
+//	EncodeLatLon(51.5007, -0.1246, 8) // "expensive-heron-sit-correctly-across-cute-swan-write", nil
+func EncodeLatLon(lat, lon float64, precision int) (string, error) {
+	if precision < MinLatLonPrecision || precision > MaxLatLonPrecision {
+		return "", fmt.Errorf("precision must be between %d and %d", MinLatLonPrecision, MaxLatLonPrecision)
+	}
+	if math.IsNaN(lat) || math.IsNaN(lon) || lat < -90 || lat > 90 || math.IsInf(lon, 0) {
+		return "", errors.New("latitude must be between -90 and 90 and longitude finite")
+	}
+
+	// Wrap longitude into [-180, 180)
+	lon = math.Mod(lon+180, 360)
+	if lon < 0 {
+		lon += 360
+	}
+
+	dict := GetDictionary()
+	classes, latBins, lonBins := latLonGrid(dict, precision)
+
+	latBin := min(int64((lat+90)/180*float64(latBins)), latBins-1)
+	lonBin := min(int64(lon/360*float64(lonBins)), lonBins-1)
+
+	value := big.NewInt(latBin*lonBins + lonBin)
+	return strings.Join(encodeRadix(dict, value, classes), "-"), nil
+}
+
+// DecodeLatLon returns the center of the grid cell named by a phrase from
+// EncodeLatLon, together with the precision inferred from its word count
+//
+// Example:
+//
+//	DecodeLatLon("funny-duck-write") // 51.501, -0.255, 3, nil
+func DecodeLatLon(id string) (lat, lon float64, precision int, err error) {
+	dict := GetDictionary()
+
+	for precision = MinLatLonPrecision; precision <= MaxLatLonPrecision; precision++ {
+		classes, latBins, lonBins := latLonGrid(dict, precision)
+		words, err := splitClassWords(dict, id, "-", classes)
+		if err != nil {
+			continue
+		}
+
+		value, err := decodeRadix(dict, words, classes)
+		if err != nil {
+			return 0, 0, 0, err
+		}
+		if !value.IsInt64() || value.Int64() >= latBins*lonBins {
+			return 0, 0, 0, errors.New("phrase is outside of the coordinate grid")
+		}
+
+		latBin, lonBin := value.Int64()/lonBins, value.Int64()%lonBins
+		lat = (float64(latBin)+0.5)*180/float64(latBins) - 90
+		lon = (float64(lonBin)+0.5)*360/float64(lonBins) - 180
+		return lat, lon, precision, nil
+	}
+	return 0, 0, 0, fmt.Errorf("phrase does not encode a coordinate with %d-%d words", MinLatLonPrecision, MaxLatLonPrecision)
+}
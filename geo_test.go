@@ -0,0 +1,63 @@
+package memorable_ids
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLatLon(t *testing.T) {
+	t.Run("should encode coordinates as word triples", func(t *testing.T) {
+		id, err := EncodeLatLon(51.5007, -0.1246, 3)
+		require.NoError(t, err)
+		assert.Equal(t, "funny-duck-write", id)
+	})
+
+	t.Run("should decode to the cell center within the cell size", func(t *testing.T) {
+		points := [][2]float64{{51.5007, -0.1246}, {-33.8568, 151.2153}, {90, 180}, {-90, -180}, {0, 0}}
+
+		for precision := MinLatLonPrecision; precision <= MaxLatLonPrecision; precision++ {
+			latSize, lonSize, err := LatLonCellSize(precision)
+			require.NoError(t, err)
+
+			for _, point := range points {
+				id, err := EncodeLatLon(point[0], point[1], precision)
+				require.NoError(t, err)
+
+				lat, lon, decodedPrecision, err := DecodeLatLon(id)
+				require.NoError(t, err, "DecodeLatLon(%q)", id)
+				assert.Equal(t, precision, decodedPrecision)
+				assert.InDelta(t, point[0], lat, latSize)
+
+				// 180 wraps to -180
+				expectedLon := point[1]
+				if expectedLon == 180 {
+					expectedLon = -180
+				}
+				assert.InDelta(t, expectedLon, lon, lonSize)
+			}
+		}
+	})
+
+	t.Run("should shrink cells as precision grows", func(t *testing.T) {
+		previous := 180.0
+		for precision := MinLatLonPrecision; precision <= MaxLatLonPrecision; precision++ {
+			size, _, err := LatLonCellSize(precision)
+			require.NoError(t, err)
+			assert.Less(t, size, previous)
+			previous = size
+		}
+	})
+
+	t.Run("should reject invalid input", func(t *testing.T) {
+		_, err := EncodeLatLon(91, 0, 3)
+		assert.Error(t, err)
+
+		_, err = EncodeLatLon(0, 0, 2)
+		assert.Error(t, err)
+
+		_, _, _, err = DecodeLatLon("cute-rabbit")
+		assert.Error(t, err)
+	})
+}
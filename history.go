@@ -0,0 +1,196 @@
+package memorable_ids
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrHistoryNotListable is returned by History queries when the store doesn't implement Lister
+var ErrHistoryNotListable = errors.New("history queries require a store implementing Lister")
+
+// HistoryEntry is a name recorded in a History
+type HistoryEntry struct {
+	// Sequence is the 1-based position of the name in the history
+	Sequence int
+	// Name is the issued name
+	Name string
+	// IssuedAt is when the name was recorded, at millisecond precision
+	IssuedAt time.Time
+}
+
+// History records every name ever issued for a purpose, such as release
+// or experiment codenames, and guarantees a name is never issued twice,
+// even by concurrent or restarted processes sharing the store. Names live
+// in the Store under keys prefixed with "history/<purpose>/"; a name is
+// reserved before it is logged, so a crash can lose a log entry but never
+// allow reuse. Sequence numbers are claimed like Codenames releases, and
+// a new History resumes after the last claimed one. Queries require a
+// store implementing Lister, and only list the keys of the purpose when
+// it implements PrefixLister. A History is safe for concurrent use.
+//
+// Example:
+//
+//	experiments := NewHistory(store, "experiments", GenerateOptions{Components: 3})
+//	experiments.Issue()                // HistoryEntry{Sequence: 1, Name: "large-fox-swim", ...}, nil
+//	experiments.Used("large-fox-swim") // true, nil
+type History struct {
+	store    Store
+	purpose  string
+	options  GenerateOptions
+	sequence *sequence
+
+	mu    sync.Mutex
+	clock Clock
+}
+
+// NewHistory creates the history of purpose, persisted in store. IDs are
+// generated with options.
+func NewHistory(store Store, purpose string, options GenerateOptions) *History {
+	h := &History{store: store, purpose: purpose, options: options, clock: SystemClock}
+	h.sequence = &sequence{store: store, prefix: h.key("sequence/")}
+	return h
+}
+
+// SetClock sets the clock timestamping entries (default: SystemClock)
+func (h *History) SetClock(clock Clock) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.clock = clockOr(clock)
+}
+
+// Issue generates a name that was never issued for the purpose and records it
+func (h *History) Issue() (HistoryEntry, error) {
+	name, err := reserveUnique(nil, keyedStore{h.store, h.key("name/")}, 0, func() (string, error) {
+		return generate(GetDictionary(), h.options, rand.Intn)
+	})
+	if err != nil {
+		return HistoryEntry{}, fmt.Errorf("history %q: %w", h.purpose, err)
+	}
+	return h.log(name)
+}
+
+// Record adds a name chosen elsewhere, e.g. by hand, reporting false
+// without recording it if the name was already used
+func (h *History) Record(name string) (HistoryEntry, bool, error) {
+	reserved, err := h.store.Reserve(h.key("name/") + name)
+	if err != nil || !reserved {
+		return HistoryEntry{}, false, err
+	}
+	entry, err := h.log(name)
+	return entry, err == nil, err
+}
+
+// log claims the next sequence number for a reserved name and records the entry
+func (h *History) log(name string) (HistoryEntry, error) {
+	number, err := h.sequence.claim()
+	if err != nil {
+		return HistoryEntry{}, err
+	}
+
+	h.mu.Lock()
+	clock := h.clock
+	h.mu.Unlock()
+
+	entry := HistoryEntry{Sequence: number, Name: name, IssuedAt: clock.Now().Truncate(time.Millisecond)}
+	key := fmt.Sprintf("%slog/%d/%d/%s", h.key(""), entry.Sequence, entry.IssuedAt.UnixMilli(), name)
+	if _, err := h.store.Reserve(key); err != nil {
+		return HistoryEntry{}, err
+	}
+	return entry, nil
+}
+
+// Used reports whether name was ever issued or recorded for the purpose
+func (h *History) Used(name string) (bool, error) {
+	key := h.key("name/") + name
+	keys, err := h.keys(key)
+	if err != nil {
+		return false, err
+	}
+	_, found := slices.BinarySearch(keys, key)
+	return found, nil
+}
+
+// Entries returns every logged name in sequence order
+func (h *History) Entries() ([]HistoryEntry, error) {
+	keys, err := h.keys(h.key("log/"))
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []HistoryEntry
+	for _, key := range keys {
+		if entry, ok := parseHistoryKey(strings.TrimPrefix(key, h.key("log/"))); ok {
+			entries = append(entries, entry)
+		}
+	}
+
+	// Keys sort lexically ("10" < "2"), so order by sequence number
+	slices.SortFunc(entries, func(a, b HistoryEntry) int { return a.Sequence - b.Sequence })
+	return entries, nil
+}
+
+// Find returns the entry of name, reporting false if it was never logged
+func (h *History) Find(name string) (HistoryEntry, bool, error) {
+	entries, err := h.Entries()
+	if err != nil {
+		return HistoryEntry{}, false, err
+	}
+	for _, entry := range entries {
+		if entry.Name == name {
+			return entry, true, nil
+		}
+	}
+	return HistoryEntry{}, false, nil
+}
+
+// Since returns the entries issued at or after t, in sequence order
+func (h *History) Since(t time.Time) ([]HistoryEntry, error) {
+	entries, err := h.Entries()
+	if err != nil {
+		return nil, err
+	}
+	return slices.DeleteFunc(entries, func(entry HistoryEntry) bool { return entry.IssuedAt.Before(t) }), nil
+}
+
+// key returns the store key of the purpose with the given suffix
+func (h *History) key(suffix string) string {
+	return "history/" + h.purpose + "/" + suffix
+}
+
+// keys lists the store keys starting with prefix, which are sorted
+func (h *History) keys(prefix string) ([]string, error) {
+	keys, ok, err := listPrefix(h.store, prefix)
+	if !ok {
+		return nil, ErrHistoryNotListable
+	}
+	return keys, err
+}
+
+// parseHistoryKey parses "<sequence>/<unix millis>/<name>"
+func parseHistoryKey(entry string) (HistoryEntry, bool) {
+	number, rest, ok := strings.Cut(entry, "/")
+	millis, name, ok2 := strings.Cut(rest, "/")
+	sequence, err := strconv.Atoi(number)
+	issued, err2 := strconv.ParseInt(millis, 10, 64)
+	if !ok || !ok2 || err != nil || err2 != nil || name == "" {
+		return HistoryEntry{}, false
+	}
+	return HistoryEntry{Sequence: sequence, Name: name, IssuedAt: time.UnixMilli(issued)}, true
+}
+
+// keyedStore reserves IDs under a key prefix in another store
+type keyedStore struct {
+	store  Store
+	prefix string
+}
+
+// Reserve implements Store
+func (s keyedStore) Reserve(id string) (bool, error) {
+	return s.store.Reserve(s.prefix + id)
+}
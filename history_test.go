@@ -0,0 +1,142 @@
+package memorable_ids
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHistory(t *testing.T) {
+	t.Run("should never reuse a name", func(t *testing.T) {
+		history := NewHistory(NewMemoryStore(), "releases", GenerateOptions{Components: 1})
+
+		seen := make(map[string]bool)
+		for range 50 {
+			entry, err := history.Issue()
+			require.NoError(t, err)
+			assert.False(t, seen[entry.Name], entry.Name)
+			seen[entry.Name] = true
+		}
+	})
+
+	t.Run("should share the history between instances on the same store", func(t *testing.T) {
+		store := NewMemoryStore()
+		first := NewHistory(store, "experiments", GenerateOptions{})
+		second := NewHistory(store, "experiments", GenerateOptions{})
+		other := NewHistory(store, "rooms", GenerateOptions{})
+
+		var wg sync.WaitGroup
+		for _, history := range []*History{first, second} {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for range 20 {
+					_, err := history.Issue()
+					assert.NoError(t, err)
+				}
+			}()
+		}
+		wg.Wait()
+
+		entries, err := second.Entries()
+		require.NoError(t, err)
+		require.Len(t, entries, 40)
+		for i, entry := range entries {
+			assert.Equal(t, i+1, entry.Sequence)
+		}
+
+		used, err := other.Used(entries[0].Name)
+		require.NoError(t, err)
+		assert.False(t, used)
+	})
+
+	t.Run("should record hand-picked names once", func(t *testing.T) {
+		history := NewHistory(NewMemoryStore(), "releases", GenerateOptions{})
+		history.SetClock(ClockFunc(func() time.Time { return time.UnixMilli(1700000001234) }))
+
+		entry, recorded, err := history.Record("brave-badger")
+		require.NoError(t, err)
+		assert.True(t, recorded)
+		assert.Equal(t, HistoryEntry{Sequence: 1, Name: "brave-badger", IssuedAt: time.UnixMilli(1700000001234)}, entry)
+
+		_, recorded, err = history.Record("brave-badger")
+		require.NoError(t, err)
+		assert.False(t, recorded)
+
+		found, ok, err := history.Find("brave-badger")
+		require.NoError(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, entry, found)
+
+		used, err := history.Used("brave-badger")
+		require.NoError(t, err)
+		assert.True(t, used)
+	})
+
+	t.Run("should filter entries by issue time", func(t *testing.T) {
+		now := time.UnixMilli(1700000000000)
+		history := NewHistory(NewMemoryStore(), "rooms", GenerateOptions{})
+		history.SetClock(ClockFunc(func() time.Time { return now }))
+
+		for range 12 {
+			_, err := history.Issue()
+			require.NoError(t, err)
+			now = now.Add(time.Hour)
+		}
+
+		recent, err := history.Since(time.UnixMilli(1700000000000).Add(10 * time.Hour))
+		require.NoError(t, err)
+		require.Len(t, recent, 2)
+		assert.Equal(t, 11, recent[0].Sequence)
+		assert.Equal(t, 12, recent[1].Sequence)
+	})
+
+	t.Run("should require a listable store for queries", func(t *testing.T) {
+		history := NewHistory(reserveOnlyStore{NewMemoryStore()}, "releases", GenerateOptions{})
+		_, err := history.Issue()
+		require.NoError(t, err)
+
+		_, err = history.Entries()
+		assert.ErrorIs(t, err, ErrHistoryNotListable)
+	})
+
+	t.Run("should resume the sequence after the last claimed number", func(t *testing.T) {
+		store := &countingStore{MemoryStore: NewMemoryStore(), prefix: "history/releases/sequence/"}
+		for range 20 {
+			_, err := NewHistory(store, "releases", GenerateOptions{Components: 3}).Issue()
+			require.NoError(t, err)
+		}
+
+		store.reserves = 0
+		entry, err := NewHistory(store, "releases", GenerateOptions{Components: 3}).Issue()
+		require.NoError(t, err)
+		assert.Equal(t, 21, entry.Sequence)
+		assert.Equal(t, 1, store.reserves)
+	})
+
+	t.Run("should only list the keys of its purpose", func(t *testing.T) {
+		store := prefixOnlyStore{NewMemoryStore()}
+		other := NewHistory(store, "experiments", GenerateOptions{})
+		history := NewHistory(store, "releases", GenerateOptions{})
+		_, err := other.Issue()
+		require.NoError(t, err)
+		entry, err := history.Issue()
+		require.NoError(t, err)
+
+		entries, err := history.Entries()
+		require.NoError(t, err)
+		assert.Equal(t, []HistoryEntry{entry}, entries)
+		used, err := history.Used(entry.Name)
+		require.NoError(t, err)
+		assert.True(t, used)
+	})
+}
+
+// prefixOnlyStore is a PrefixLister whose full listing fails
+type prefixOnlyStore struct{ *MemoryStore }
+
+func (prefixOnlyStore) List() ([]string, error) { return nil, errors.New("listing the whole store") }
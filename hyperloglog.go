@@ -0,0 +1,98 @@
+package memorable_ids
+
+import (
+	"errors"
+	"hash/fnv"
+	"math"
+	"math/bits"
+)
+
+// HyperLogLog estimates the number of distinct strings added to it using
+// a fixed amount of memory (2^precision bytes), with a standard error of
+// about 1.04/sqrt(2^precision)
+type HyperLogLog struct {
+	precision uint8
+	registers []uint8
+}
+
+// NewHyperLogLog creates an estimator with the given precision (4-18, default: 14)
+//
+// Example:
+//
+//	hll := NewHyperLogLog(14) // 16 KiB, ~0.8% standard error
+//	hll.Add("cute-rabbit")
+//	hll.Estimate() // 1
+func NewHyperLogLog(precision uint8) *HyperLogLog {
+	if precision == 0 {
+		precision = 14
+	}
+	precision = min(max(precision, 4), 18)
+	return &HyperLogLog{
+		precision: precision,
+		registers: make([]uint8, 1<<precision),
+	}
+}
+
+// Add records a value
+func (h *HyperLogLog) Add(value string) {
+	hasher := fnv.New64a()
+	hasher.Write([]byte(value))
+	hash := mix64(hasher.Sum64())
+
+	index := hash >> (64 - h.precision)
+	rank := uint8(bits.LeadingZeros64(hash<<h.precision|1<<(h.precision-1)) + 1)
+	if rank > h.registers[index] {
+		h.registers[index] = rank
+	}
+}
+
+// Estimate returns the estimated number of distinct values added
+func (h *HyperLogLog) Estimate() uint64 {
+	m := float64(len(h.registers))
+	sum := 0.0
+	zeros := 0
+	for _, register := range h.registers {
+		sum += 1.0 / float64(uint64(1)<<register)
+		if register == 0 {
+			zeros++
+		}
+	}
+
+	alpha := 0.7213 / (1 + 1.079/m)
+	estimate := alpha * m * m / sum
+
+	// Small range correction using linear counting
+	if estimate <= 2.5*m && zeros > 0 {
+		estimate = m * math.Log(m/float64(zeros))
+	}
+
+	return uint64(math.Round(estimate))
+}
+
+// Merge folds another estimator with the same precision into this one
+func (h *HyperLogLog) Merge(other *HyperLogLog) error {
+	if other.precision != h.precision {
+		return errors.New("cannot merge HyperLogLog estimators with different precision")
+	}
+	for i, register := range other.registers {
+		if register > h.registers[i] {
+			h.registers[i] = register
+		}
+	}
+	return nil
+}
+
+// Reset clears all recorded values
+func (h *HyperLogLog) Reset() {
+	clear(h.registers)
+}
+
+// mix64 is the splitmix64 finalizer, spreading FNV output across all bits
+func mix64(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
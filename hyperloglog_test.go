@@ -0,0 +1,105 @@
+package memorable_ids
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHyperLogLog(t *testing.T) {
+	t.Run("should estimate small cardinalities exactly enough", func(t *testing.T) {
+		hll := NewHyperLogLog(14)
+		for i := 0; i < 100; i++ {
+			hll.Add(fmt.Sprintf("id-%d", i))
+		}
+
+		assert.InDelta(t, 100, hll.Estimate(), 2)
+	})
+
+	t.Run("should ignore duplicates", func(t *testing.T) {
+		hll := NewHyperLogLog(14)
+		for i := 0; i < 1000; i++ {
+			hll.Add("cute-rabbit")
+		}
+
+		assert.Equal(t, uint64(1), hll.Estimate())
+	})
+
+	t.Run("should estimate large cardinalities within error bounds", func(t *testing.T) {
+		hll := NewHyperLogLog(14)
+		for i := 0; i < 200000; i++ {
+			hll.Add(fmt.Sprintf("id-%d", i))
+		}
+
+		assert.InEpsilon(t, 200000, hll.Estimate(), 0.03)
+	})
+
+	t.Run("should merge estimators", func(t *testing.T) {
+		a, b := NewHyperLogLog(12), NewHyperLogLog(12)
+		for i := 0; i < 500; i++ {
+			a.Add(fmt.Sprintf("a-%d", i))
+			b.Add(fmt.Sprintf("b-%d", i))
+		}
+
+		require.NoError(t, a.Merge(b))
+		assert.InEpsilon(t, 1000, a.Estimate(), 0.05)
+		assert.Error(t, a.Merge(NewHyperLogLog(10)), "Expected precision mismatch error")
+	})
+}
+
+func TestGenerator(t *testing.T) {
+	t.Run("should generate IDs from its configuration", func(t *testing.T) {
+		gen := NewGenerator(Config{Options: GenerateOptions{Components: 3, Separator: "_"}})
+
+		id, err := gen.Generate()
+		require.NoError(t, err, "Generate should not fail")
+		assert.Len(t, Parse(id, "_").Components, 3)
+	})
+
+	t.Run("should report live collision risk from issued IDs", func(t *testing.T) {
+		gen := NewGenerator(Config{Options: GenerateOptions{Components: 1}})
+		assert.Equal(t, 0.0, gen.CollisionAnalysis().Live.CollisionProbability)
+
+		for i := 0; i < 200; i++ {
+			_, err := gen.Generate()
+			require.NoError(t, err, "Generate should not fail")
+		}
+
+		analysis := gen.CollisionAnalysis()
+		require.NotNil(t, analysis.Live)
+		assert.LessOrEqual(t, analysis.Live.EstimatedIssued, len(Adjectives)+2)
+		assert.Greater(t, analysis.Live.NextCollisionProbability, 0.7)
+		assert.InDelta(t, 1.0, analysis.Live.CollisionProbability, 0.01)
+	})
+
+	t.Run("should leave hypothetical analyses without live risk", func(t *testing.T) {
+		assert.Nil(t, GetCollisionAnalysis(2, 1).Live)
+	})
+}
+
+func TestGeneratorSuffixModeling(t *testing.T) {
+	t.Run("should derive the suffix range from built-in generators", func(t *testing.T) {
+		gen := NewGenerator(Config{Options: GenerateOptions{Components: 2, Suffix: SuffixGenerators.Hex}})
+
+		analysis := gen.CollisionAnalysis()
+		assert.Equal(t, CalculateCombinations(2, 256), analysis.TotalCombinations)
+		assert.Empty(t, analysis.Warnings)
+	})
+
+	t.Run("should not count timestamp suffixes as entropy", func(t *testing.T) {
+		gen := NewGenerator(Config{Options: GenerateOptions{Components: 2, Suffix: SuffixGenerators.Timestamp}})
+
+		analysis := gen.CollisionAnalysis()
+		assert.Equal(t, CalculateCombinations(2, 1), analysis.TotalCombinations)
+		require.Len(t, analysis.Warnings, 1)
+		assert.Contains(t, analysis.Warnings[0], "time-derived")
+	})
+
+	t.Run("should warn even when the range is set explicitly", func(t *testing.T) {
+		gen := NewGenerator(Config{Options: GenerateOptions{Suffix: SuffixGenerators.Timestamp}, SuffixRange: 10000})
+
+		assert.NotEmpty(t, gen.CollisionAnalysis().Warnings)
+	})
+}
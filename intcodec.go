@@ -0,0 +1,71 @@
+package memorable_ids
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrValueOutOfRange is returned by Encode when a value has no phrase of
+// the requested number of words
+var ErrValueOutOfRange = errors.New("value out of range")
+
+// Encode maps n onto a phrase of components words (1-5, default: 2),
+// e.g. to show database auto-increment IDs as memorable slugs. Values run
+// from 0 to CalculateCombinations(components, 1) - 1, in the order of
+// NthID; larger values return ErrValueOutOfRange. Decode recovers n.
+//
+// Example:
+//
+//	Encode(0, 2)    // "cute-rabbit", nil
+//	Encode(1, 2)    // "dapper-rabbit", nil
+//	Encode(4242, 3) // "afraid-clam-sing", nil
+func Encode(n uint64, components int) (string, error) {
+	if components == 0 {
+		components = 2
+	}
+	if components < 1 || components > 5 {
+		return "", errors.New("components must be between 1 and 5")
+	}
+
+	dict := GetDictionary()
+	capacity, ok := wordCombinations(dict.Stats, components)
+	if ok && n >= capacity {
+		return "", fmt.Errorf("%w: %d needs more than %d words", ErrValueOutOfRange, n, components)
+	}
+
+	id := make([]byte, 0, 48)
+	for i, class := range wordClasses[:components] {
+		words := dict.Words(class)
+		if i > 0 {
+			id = append(id, '-')
+		}
+		id = append(id, words[n%uint64(len(words))]...)
+		n /= uint64(len(words))
+	}
+	return string(id), nil
+}
+
+// Decode recovers the value encoded by Encode, taking the number of
+// components from the phrase
+//
+// Example:
+//
+//	Decode("dapper-rabbit") // 1, nil
+//	Decode("rabbit-cute")   // 0, word 1 "rabbit" is not a known adjective
+func Decode(id string) (uint64, error) {
+	dict := GetDictionary()
+	words, err := splitCycleWords(dict, id, "-")
+	if err != nil {
+		return 0, err
+	}
+	if len(words) > len(wordClasses) {
+		return 0, fmt.Errorf("expected at most %d words, got %d", len(wordClasses), len(words))
+	}
+
+	var value uint64
+	for i := len(words) - 1; i >= 0; i-- {
+		class := wordClasses[i]
+		value = value*uint64(len(dict.Words(class))) + uint64(dict.IndexOf(class, words[i]))
+	}
+	return value, nil
+}
@@ -0,0 +1,66 @@
+package memorable_ids
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncode(t *testing.T) {
+	t.Run("should follow NthID order", func(t *testing.T) {
+		for _, n := range []uint64{0, 1, 4242, uint64(CalculateCombinations(2, 1) - 1)} {
+			id, err := Encode(n, 2)
+			require.NoError(t, err)
+			nth, err := NthID(int(n), GenerateOptions{})
+			require.NoError(t, err)
+			assert.Equal(t, nth, id)
+		}
+
+		id, err := Encode(0, 0)
+		require.NoError(t, err)
+		assert.Equal(t, "cute-rabbit", id)
+	})
+
+	t.Run("should round-trip values for every component count", func(t *testing.T) {
+		for components := 1; components <= 5; components++ {
+			capacity, err := CalculateCombinationsChecked(components, 1)
+			require.NoError(t, err)
+
+			for _, n := range []uint64{0, capacity - 1, uint64(rand.Int63n(int64(capacity)))} {
+				id, err := Encode(n, components)
+				require.NoError(t, err)
+				_, err = splitClassWords(GetDictionary(), id, "-", wordClasses[:components])
+				assert.NoError(t, err, id)
+
+				decoded, err := Decode(id)
+				require.NoError(t, err, id)
+				assert.Equal(t, n, decoded, id)
+			}
+		}
+	})
+
+	t.Run("should reject values beyond the space", func(t *testing.T) {
+		_, err := Encode(uint64(CalculateCombinations(2, 1)), 2)
+		assert.ErrorIs(t, err, ErrValueOutOfRange)
+		_, err = Encode(^uint64(0), 5)
+		assert.ErrorIs(t, err, ErrValueOutOfRange)
+		_, err = Encode(0, 6)
+		assert.Error(t, err)
+	})
+
+	t.Run("should reject phrases that are not encodings", func(t *testing.T) {
+		_, err := Decode("rabbit-cute")
+		assert.ErrorContains(t, err, "not a known adjective")
+		_, err = Decode("cute-rabbit-042")
+		assert.Error(t, err)
+		_, err = Decode("")
+		assert.Error(t, err)
+
+		id, err := Encode(42, 5)
+		require.NoError(t, err)
+		_, err = Decode(id + "-cute")
+		assert.ErrorContains(t, err, "at most 5 words")
+	})
+}
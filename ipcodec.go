@@ -0,0 +1,75 @@
+package memorable_ids
+
+import (
+	"errors"
+	"math/big"
+	"net"
+	"strings"
+)
+
+// ipv6PrefixBits is the number of leading IPv6 bits kept by EncodeIP
+const ipv6PrefixBits = 64
+
+// EncodeIP maps an IP address reversibly onto a hyphenated word tuple.
+// IPv4 addresses use 6 words. IPv6 addresses are truncated to their /64
+// network prefix (12 words); the interface identifier is not encoded.
+//
+// Example:
+//
+//	EncodeIP(net.ParseIP("192.168.1.10")) // "empty-parrot-play-deeply-about-early", nil
+//	EncodeIP(net.ParseIP("2001:db8::1"))  // 12 words for 2001:db8::/64
+func EncodeIP(ip net.IP) (string, error) {
+	dict := GetDictionary()
+
+	if v4 := ip.To4(); v4 != nil {
+		value := new(big.Int).SetBytes(v4)
+		return strings.Join(encodeRadix(dict, value, radixClasses(dict, 32)), "-"), nil
+	}
+
+	if v6 := ip.To16(); v6 != nil {
+		value := new(big.Int).SetBytes(v6[:ipv6PrefixBits/8])
+		return strings.Join(encodeRadix(dict, value, radixClasses(dict, ipv6PrefixBits)), "-"), nil
+	}
+
+	return "", errors.New("invalid IP address")
+}
+
+// DecodeIP recovers the IP address encoded by EncodeIP. For IPv6 the
+// /64 network address is returned, with the interface identifier zeroed.
+//
+// Example:
+//
+//	DecodeIP("empty-parrot-play-deeply-about-early") // 192.168.1.10, nil
+func DecodeIP(id string) (net.IP, error) {
+	dict := GetDictionary()
+
+	v4Classes := radixClasses(dict, 32)
+	if words, err := splitClassWords(dict, id, "-", v4Classes); err == nil {
+		value, err := decodeRadix(dict, words, v4Classes)
+		if err != nil {
+			return nil, err
+		}
+		if value.BitLen() > 32 {
+			return nil, errors.New("encoded value is out of the IPv4 range")
+		}
+		ip := make(net.IP, net.IPv4len)
+		value.FillBytes(ip)
+		return ip, nil
+	}
+
+	v6Classes := radixClasses(dict, ipv6PrefixBits)
+	words, err := splitClassWords(dict, id, "-", v6Classes)
+	if err != nil {
+		return nil, errors.New("ID does not encode an IPv4 address or IPv6 prefix")
+	}
+	value, err := decodeRadix(dict, words, v6Classes)
+	if err != nil {
+		return nil, err
+	}
+	if value.BitLen() > ipv6PrefixBits {
+		return nil, errors.New("encoded value is out of the IPv6 prefix range")
+	}
+	ip := make(net.IP, net.IPv6len)
+	value.FillBytes(ip[:ipv6PrefixBits/8])
+	return ip, nil
+}
@@ -0,0 +1,74 @@
+package memorable_ids
+
+import (
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeIP(t *testing.T) {
+	t.Run("should round trip IPv4 addresses", func(t *testing.T) {
+		for _, address := range []string{"0.0.0.0", "127.0.0.1", "192.168.1.10", "10.20.30.40", "255.255.255.255"} {
+			id, err := EncodeIP(net.ParseIP(address))
+			require.NoError(t, err, "EncodeIP should not fail for %s", address)
+
+			ip, err := DecodeIP(id)
+			require.NoError(t, err, "DecodeIP should not fail for %s", id)
+			assert.Equal(t, address, ip.String())
+		}
+	})
+
+	t.Run("should produce distinct names for distinct addresses", func(t *testing.T) {
+		a, _ := EncodeIP(net.ParseIP("10.0.0.1"))
+		b, _ := EncodeIP(net.ParseIP("10.0.0.2"))
+
+		assert.NotEqual(t, a, b)
+		assert.Len(t, radixClasses(GetDictionary(), 32), 6, "Expected 6 words for IPv4")
+	})
+
+	t.Run("should round trip the IPv6 /64 prefix", func(t *testing.T) {
+		id, err := EncodeIP(net.ParseIP("2001:db8:85a3:1234:abcd::1"))
+		require.NoError(t, err, "EncodeIP should not fail")
+
+		ip, err := DecodeIP(id)
+		require.NoError(t, err, "DecodeIP should not fail")
+		assert.Equal(t, "2001:db8:85a3:1234::", ip.String())
+	})
+
+	t.Run("should handle dictionary words containing the separator", func(t *testing.T) {
+		dict := GetDictionary()
+		classes := radixClasses(dict, 32)
+		words := make([]string, len(classes))
+		for i, class := range classes {
+			words[i] = dict.Words(class)[0]
+		}
+		words[1] = "guinea-pig"
+
+		value, err := decodeRadix(dict, words, classes)
+		require.NoError(t, err)
+		ip := make(net.IP, net.IPv4len)
+		value.FillBytes(ip)
+
+		id, err := EncodeIP(ip)
+		require.NoError(t, err)
+		assert.Equal(t, strings.Join(words, "-"), id)
+
+		back, err := DecodeIP(id)
+		require.NoError(t, err)
+		assert.Equal(t, ip.String(), back.String())
+	})
+
+	t.Run("should reject invalid input", func(t *testing.T) {
+		_, err := EncodeIP(net.IP{1, 2})
+		assert.Error(t, err)
+
+		_, err = DecodeIP("cute-rabbit")
+		assert.Error(t, err)
+
+		_, err = DecodeIP("cute-notaword-swim-gently-on-fast")
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,70 @@
+package memorable_ids
+
+import (
+	"errors"
+	"unicode/utf8"
+)
+
+// ErrUnknownSuffixLength is returned when the length of a custom suffix generator can't be determined
+var ErrUnknownSuffixLength = errors.New("unknown suffix length")
+
+// MaxIDLength returns the length in characters of the longest ID the
+// options can produce with the active dictionaries, for sizing database
+// columns and checking third-party limits. Only the built-in suffix
+// generators are supported; others return ErrUnknownSuffixLength.
+//
+// Example:
+//
+//	MaxIDLength(GenerateOptions{})                                // 22, nil
+//	MaxIDLength(GenerateOptions{Suffix: SuffixGenerators.Number}) // 26, nil
+func MaxIDLength(options GenerateOptions) (int, error) {
+	return idLength(options, func(a, b int) int { return max(a, b) })
+}
+
+// MinIDLength returns the length in characters of the shortest ID the
+// options can produce with the active dictionaries
+//
+// Example:
+//
+//	MinIDLength(GenerateOptions{})                                // 7, nil
+//	MinIDLength(GenerateOptions{Suffix: SuffixGenerators.Number}) // 11, nil
+func MinIDLength(options GenerateOptions) (int, error) {
+	return idLength(options, func(a, b int) int { return min(a, b) })
+}
+
+// idLength sums the word lengths selected by pick plus separators and suffix
+func idLength(options GenerateOptions, pick func(a, b int) int) (int, error) {
+	if options.Separator == "" {
+		options.Separator = "-"
+	}
+	dict := options.dictionary()
+	classes, err := options.classes()
+	if err != nil {
+		return 0, err
+	}
+	separatorLength := utf8.RuneCountInString(options.Separator)
+
+	total := (len(classes) - 1) * separatorLength
+	for _, class := range classes {
+		words := dict.Words(class)
+		if len(words) == 0 {
+			return 0, ErrEmptyWordClass
+		}
+		selected := utf8.RuneCountInString(words[0])
+		for _, word := range words[1:] {
+			selected = pick(selected, utf8.RuneCountInString(word))
+		}
+		total += selected
+	}
+
+	if options.hasSuffix() {
+		info, ok := options.suffixInfo()
+		if !ok {
+			return 0, ErrUnknownSuffixLength
+		}
+		shortest, longest := info.lengths()
+		total += separatorLength + pick(shortest, longest)
+	}
+
+	return total, nil
+}
@@ -0,0 +1,51 @@
+package memorable_ids
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIDLength(t *testing.T) {
+	t.Run("should bound generated IDs", func(t *testing.T) {
+		for components := 1; components <= 5; components++ {
+			for _, info := range builtinSuffixes {
+				options := GenerateOptions{Components: components, Suffix: info.generator, Separator: "__"}
+				maxLength, err := MaxIDLength(options)
+				require.NoError(t, err, "MaxIDLength should not fail")
+				minLength, err := MinIDLength(options)
+				require.NoError(t, err, "MinIDLength should not fail")
+
+				for i := 0; i < 20; i++ {
+					id, err := Generate(options)
+					require.NoError(t, err, "Generate should not fail")
+					assert.LessOrEqual(t, len(id), maxLength)
+					assert.GreaterOrEqual(t, len(id), minLength)
+				}
+			}
+		}
+	})
+
+	t.Run("should compute exact bounds for the default options", func(t *testing.T) {
+		maxLength, err := MaxIDLength(GenerateOptions{})
+		require.NoError(t, err)
+		minLength, err := MinIDLength(GenerateOptions{Suffix: SuffixGenerators.Number})
+		require.NoError(t, err)
+
+		assert.Equal(t, len("comfortable")+1+len("kingfisher"), maxLength)
+		assert.Equal(t, len("bad")+1+len("fox")+1+3, minLength)
+	})
+
+	t.Run("should reject unknown suffix generators", func(t *testing.T) {
+		custom := func() *string { return nil }
+
+		_, err := MaxIDLength(GenerateOptions{Suffix: custom})
+		assert.ErrorIs(t, err, ErrUnknownSuffixLength)
+	})
+
+	t.Run("should reject invalid component counts", func(t *testing.T) {
+		_, err := MinIDLength(GenerateOptions{Components: 6})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,24 @@
+package memorable_ids
+
+import "log/slog"
+
+/**
+ * Structured logging
+ *
+ * Generators, namespaces and sharded stores accept an optional
+ * *slog.Logger. Collision retries are logged at debug level, invalid
+ * options and exhausted ID spaces at warn level, and store failures at
+ * error level, each with structured attributes. A nil logger disables
+ * logging.
+ */
+
+// discardLogger is used when no logger is configured
+var discardLogger = slog.New(slog.DiscardHandler)
+
+// loggerOr returns logger, or a logger discarding all records if it is nil
+func loggerOr(logger *slog.Logger) *slog.Logger {
+	if logger == nil {
+		return discardLogger
+	}
+	return logger
+}
@@ -0,0 +1,72 @@
+package memorable_ids
+
+import (
+	"bytes"
+	"errors"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestLogger returns a logger writing every level as text to buf
+func newTestLogger(buf *bytes.Buffer) *slog.Logger {
+	return slog.New(slog.NewTextHandler(buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+}
+
+func TestLogging(t *testing.T) {
+	t.Run("should log collision retries and exhaustion", func(t *testing.T) {
+		var buf bytes.Buffer
+		registry := &Namespaces{Logger: newTestLogger(&buf), MaxAttempts: 3}
+		registry.Register("acme", NamespaceProfile{Options: GenerateOptions{Components: 1}})
+
+		namespace := registry.Namespace("acme")
+		_, err := namespace.Store().Reserve("x")
+		require.NoError(t, err)
+
+		var exhausted error
+		for range 200 {
+			if _, exhausted = registry.Generate("acme"); exhausted != nil {
+				break
+			}
+		}
+		require.ErrorIs(t, exhausted, ErrSpaceExhausted)
+
+		out := buf.String()
+		assert.Contains(t, out, "memorable ID namespace registered")
+		assert.Contains(t, out, "memorable ID collision, retrying")
+		assert.Contains(t, out, "memorable ID space exhausted")
+		assert.Contains(t, out, "namespace=acme")
+	})
+
+	t.Run("should log store errors", func(t *testing.T) {
+		var buf bytes.Buffer
+		store := &ShardedStore{
+			Logger:   newTestLogger(&buf),
+			NewStore: func(string) Store { return failingStore{err: errors.New("connection refused")} },
+		}
+
+		_, err := store.GenerateInShard("eu1", GenerateOptions{})
+		require.Error(t, err)
+		assert.Contains(t, buf.String(), "memorable ID store reserve failed")
+		assert.Contains(t, buf.String(), "shard=eu1")
+		assert.Contains(t, buf.String(), "connection refused")
+	})
+
+	t.Run("should log invalid options", func(t *testing.T) {
+		var buf bytes.Buffer
+		gen := NewGenerator(Config{Options: GenerateOptions{Components: 9}, Logger: newTestLogger(&buf)})
+
+		_, err := gen.Generate()
+		require.Error(t, err)
+		assert.Contains(t, buf.String(), "memorable ID generation failed")
+		assert.Contains(t, buf.String(), "components=9")
+	})
+
+	t.Run("should not require a logger", func(t *testing.T) {
+		gen := NewGenerator(Config{Options: GenerateOptions{Components: 9}})
+		_, err := gen.Generate()
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,87 @@
+package memorable_ids
+
+import (
+	"crypto/sha256"
+	"errors"
+	"math"
+	"net"
+)
+
+// MACNameOptions contains configuration for NameForMAC
+type MACNameOptions struct {
+	// Components is the number of word components (1-5, default: 3)
+	Components int
+	// Digits is the length of the numeric suffix (0-9, default: 0)
+	Digits int
+	// Salt is a per-site secret mixed into the hash so names differ between sites (default: "")
+	Salt string
+	// Separator between parts (default: "-")
+	Separator string
+}
+
+// defaults applies default values to the options
+func (o MACNameOptions) defaults() (MACNameOptions, error) {
+	if o.Components == 0 {
+		o.Components = 3
+	}
+	if o.Separator == "" {
+		o.Separator = "-"
+	}
+	if o.Components < 1 || o.Components > 5 {
+		return o, errors.New("components must be between 1 and 5")
+	}
+	if o.Digits < 0 || o.Digits > 9 {
+		return o, errors.New("digits must be between 0 and 9")
+	}
+	return o, nil
+}
+
+// NameForMAC deterministically derives a stable human name from a hardware
+// address, so IoT devices keep the same name across reboots and re-enrollment.
+// The name is a hash of the salt and address, so it is not reversible.
+//
+// Names are uniformly distributed over CalculateCombinations(Components, 10^Digits),
+// so collision odds follow the Birthday Paradox (see MACNameCollisionProbability).
+// With the built-in dictionaries:
+//
+//	Components  Digits  Combinations   1,000 devices  10,000 devices
+//	3           0       ~250K          ~86%           ~100%
+//	3           3       ~250M          ~0.2%          ~18%
+//	4           3       ~6.7B          <0.01%         ~0.7%
+//
+// Example:
+//
+//	mac, _ := net.ParseMAC("00:1a:2b:3c:4d:5e")
+//	NameForMAC(mac, MACNameOptions{})                      // "thirsty-moth-walk", nil
+//	NameForMAC(mac, MACNameOptions{Digits: 3, Salt: "hq"}) // "near-heron-sing-384", nil
+func NameForMAC(mac net.HardwareAddr, options MACNameOptions) (string, error) {
+	if len(mac) == 0 {
+		return "", errors.New("hardware address is empty")
+	}
+	options, err := options.defaults()
+	if err != nil {
+		return "", err
+	}
+
+	hasher := sha256.New()
+	hasher.Write([]byte(options.Salt))
+	hasher.Write([]byte{0})
+	hasher.Write(mac)
+
+	return nameFromHash(GetDictionary(), hasher.Sum(nil), options.Components, options.Digits, options.Separator), nil
+}
+
+// MACNameCollisionProbability returns the probability that at least two of
+// the given number of devices share a name under the options
+//
+// Example:
+//
+//	MACNameCollisionProbability(MACNameOptions{Digits: 3}, 1000) // ~0.002
+func MACNameCollisionProbability(options MACNameOptions, devices int) (float64, error) {
+	options, err := options.defaults()
+	if err != nil {
+		return 0, err
+	}
+	total := CalculateCombinations(options.Components, int(math.Pow10(options.Digits)))
+	return CalculateCollisionProbability(total, devices), nil
+}
@@ -0,0 +1,60 @@
+package memorable_ids
+
+import (
+	"net"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNameForMAC(t *testing.T) {
+	mac, err := net.ParseMAC("00:1a:2b:3c:4d:5e")
+	require.NoError(t, err)
+
+	t.Run("should be deterministic", func(t *testing.T) {
+		first, err := NameForMAC(mac, MACNameOptions{})
+		require.NoError(t, err, "NameForMAC should not fail")
+		second, err := NameForMAC(mac, MACNameOptions{})
+		require.NoError(t, err, "NameForMAC should not fail")
+
+		assert.Equal(t, first, second)
+		assert.Len(t, splitWords(first), 3, "Expected 3 components by default")
+	})
+
+	t.Run("should vary with the salt", func(t *testing.T) {
+		a, _ := NameForMAC(mac, MACNameOptions{Components: 4, Salt: "site-a"})
+		b, _ := NameForMAC(mac, MACNameOptions{Components: 4, Salt: "site-b"})
+
+		assert.NotEqual(t, a, b)
+	})
+
+	t.Run("should append numeric suffix", func(t *testing.T) {
+		name, err := NameForMAC(mac, MACNameOptions{Digits: 3, Separator: "_"})
+		require.NoError(t, err, "NameForMAC should not fail")
+
+		assert.Regexp(t, regexp.MustCompile(`_\d{3}$`), name)
+	})
+
+	t.Run("should reject invalid options", func(t *testing.T) {
+		_, err := NameForMAC(nil, MACNameOptions{})
+		assert.Error(t, err)
+
+		_, err = NameForMAC(mac, MACNameOptions{Components: 6})
+		assert.Error(t, err)
+
+		_, err = NameForMAC(mac, MACNameOptions{Digits: 12})
+		assert.Error(t, err)
+	})
+
+	t.Run("should report collision probability", func(t *testing.T) {
+		small, err := MACNameCollisionProbability(MACNameOptions{}, 1000)
+		require.NoError(t, err)
+		large, err := MACNameCollisionProbability(MACNameOptions{Components: 4, Digits: 3}, 1000)
+		require.NoError(t, err)
+
+		assert.Greater(t, small, 0.5)
+		assert.Less(t, large, 0.001)
+	})
+}
@@ -0,0 +1,63 @@
+package memorable_ids
+
+import (
+	"fmt"
+	"sync"
+)
+
+// MappedDictionary is a dictionary whose words live in a memory-mapped
+// .mdict pack. Its words must not be used after Close; copy any word that
+// has to outlive the mapping with strings.Clone, and never close a
+// dictionary registered with RegisterTheme, which keeps it for the life
+// of the process.
+type MappedDictionary struct {
+	Dictionary
+
+	closeOnce sync.Once
+	unmap     func() error
+	err       error
+}
+
+// MapPack memory-maps the .mdict pack file at path and verifies its
+// checksum. Words reference the mapping rather than the heap and lookups
+// index the dictionary on first use, so startup stays fast and memory
+// stays bounded for wordlists of 100k+ words. On platforms without mmap
+// the file is read instead.
+//
+// Example:
+//
+//	dict, err := MapPack("huge.mdict")
+//	if err != nil {
+//	  return err
+//	}
+//	defer dict.Close()
+//	Generate(GenerateOptions{Dictionary: &dict.Dictionary}) // "ancient-dragon"
+//
+// A mapped theme stays mapped, since themes live for the process:
+//
+//	dict, err := MapPack("huge.mdict")
+//	if err != nil {
+//	  return err
+//	}
+//	RegisterTheme("huge", dict.Dictionary)
+//	GenerateThemed("huge", GenerateOptions{}) // "ancient-dragon"
+func MapPack(path string) (*MappedDictionary, error) {
+	data, unmap, err := mapFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	dict, err := decodePackWords(data, true)
+	if err != nil {
+		unmap()
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return &MappedDictionary{Dictionary: dict, unmap: unmap}, nil
+}
+
+// Close releases the mapping. Calling Close more than once is safe.
+func (m *MappedDictionary) Close() error {
+	m.closeOnce.Do(func() {
+		m.err = m.unmap()
+	})
+	return m.err
+}
@@ -0,0 +1,73 @@
+package memorable_ids
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMapPack(t *testing.T) {
+	t.Run("should map the built-in dictionary", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "builtin.mdict")
+		require.NoError(t, SavePack(path, GetDictionary()))
+
+		dict, err := MapPack(path)
+		require.NoError(t, err)
+		defer dict.Close()
+
+		for _, class := range allWordClasses {
+			assert.Equal(t, GetDictionary().Words(class), dict.Words(class), class.String())
+		}
+		assert.Equal(t, GetDictionaryStats(), dict.Stats)
+		assert.True(t, dict.Contains(ClassNoun, "rabbit"))
+		assert.Equal(t, GetDictionary().IndexOf(ClassAdjective, "cute"), dict.IndexOf(ClassAdjective, "cute"))
+	})
+
+	t.Run("should index lazily", func(t *testing.T) {
+		words := make([]string, 100_000)
+		for i := range words {
+			words[i] = fmt.Sprintf("word%d", i)
+		}
+		path := filepath.Join(t.TempDir(), "huge.mdict")
+		require.NoError(t, SavePack(path, NewDictionary(words, words, nil, nil, nil)))
+
+		dict, err := MapPack(path)
+		require.NoError(t, err)
+		defer dict.Close()
+
+		assert.Nil(t, dict.index.positions[ClassAdjective])
+		assert.Equal(t, 99_999, dict.IndexOf(ClassNoun, "word99999"))
+		assert.NotNil(t, dict.index.positions[ClassAdjective])
+	})
+
+	t.Run("should reject corrupted and empty files", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "corrupt.mdict")
+		require.NoError(t, SavePack(path, GetDictionary()))
+		data, err := os.ReadFile(path)
+		require.NoError(t, err)
+		data[len(data)/2] ^= 0xff
+		require.NoError(t, os.WriteFile(path, data, 0o644))
+
+		_, err = MapPack(path)
+		assert.ErrorIs(t, err, ErrPackChecksum)
+
+		empty := filepath.Join(t.TempDir(), "empty.mdict")
+		require.NoError(t, os.WriteFile(empty, nil, 0o644))
+		_, err = MapPack(empty)
+		assert.ErrorIs(t, err, ErrInvalidPack)
+	})
+
+	t.Run("should close more than once", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "builtin.mdict")
+		require.NoError(t, SavePack(path, GetDictionary()))
+
+		dict, err := MapPack(path)
+		require.NoError(t, err)
+		assert.NoError(t, dict.Close())
+		assert.NoError(t, dict.Close())
+	})
+}
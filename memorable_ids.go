@@ -5,10 +5,8 @@ import (
 	"fmt"
 	"math"
 	"math/rand"
-	"regexp"
-	"strconv"
 	"strings"
-	"time"
+	"unicode"
 )
 
 /**
@@ -31,8 +29,81 @@ type GenerateOptions struct {
 	Components int
 	// Suffix is the suffix generator function (default: nil)
 	Suffix SuffixGenerator
+	// SuffixProvider produces suffixes that carry their spec, e.g. a
+	// NumberSuffix; it overrides Suffix, and its errors fail generation
+	// (default: nil)
+	SuffixProvider SuffixProvider
 	// Separator between parts (default: "-")
 	Separator string
+	// Dictionary overrides the word lists for this call, e.g. a themed
+	// word set, without modifying the package-level collections
+	// (default: nil, the built-in dictionary)
+	Dictionary *Dictionary
+	// Pattern is a word-order template of class names joined by "-", e.g.
+	// "adjective-adjective-noun" or "noun-verb", see ParsePattern; it
+	// overrides Components (default: "", Components words in component order)
+	Pattern string
+}
+
+// dictionary returns the dictionary to draw words from
+func (o GenerateOptions) dictionary() Dictionary {
+	if o.Dictionary != nil {
+		return *o.Dictionary
+	}
+	return GetDictionary()
+}
+
+// ErrInvalidOptions is returned by GenerateOptions.Validate
+var ErrInvalidOptions = errors.New("invalid generate options")
+
+// Validate checks the options before any IDs are generated: the component
+// count or pattern, the dictionary classes they draw from (other classes
+// may be empty), separators that are blank or make dictionary words
+// containing them ambiguous to split back (words such as "guinea-pig" are
+// fine), and suffixes whose characters include the separator. Errors wrap
+// ErrInvalidOptions.
+//
+// Example:
+//
+//	GenerateOptions{Separator: " "}.Validate()
+//	// invalid generate options: separator " " is blank
+//	GenerateOptions{Separator: "e"}.Validate()
+//	// invalid generate options: separator "e" makes 33 dictionary words ambiguous, e.g. "cute"
+func (o GenerateOptions) Validate() error {
+	classes, err := o.classes()
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidOptions, err)
+	}
+
+	separator := o.Separator
+	if separator == "" {
+		separator = "-"
+	}
+	if strings.TrimSpace(separator) == "" {
+		return fmt.Errorf("%w: separator %q is blank", ErrInvalidOptions, separator)
+	}
+	// Only the classes the options draw from need words
+	if o.Dictionary != nil {
+		err = o.Dictionary.validateClasses(classes)
+	} else {
+		err = requireWords(GetDictionary(), classes)
+	}
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidOptions, err)
+	}
+	if conflicts := o.dictionary().ambiguousConflicts(separator, classes); len(conflicts) > 0 {
+		return fmt.Errorf("%w: separator %q makes %d dictionary words ambiguous, e.g. %q",
+			ErrInvalidOptions, separator, len(conflicts), conflicts[0])
+	}
+	if validator, ok := o.SuffixProvider.(interface{ Validate() error }); ok {
+		if err := validator.Validate(); err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidOptions, err)
+		}
+	}
+	if info, ok := o.suffixInfo(); ok && strings.ContainsAny(separator, info.charset) {
+		return fmt.Errorf("%w: separator %q occurs in %s suffixes", ErrInvalidOptions, separator, info.name)
+	}
+	return nil
 }
 
 // ParsedID represents parsed ID components structure
@@ -41,6 +112,13 @@ type ParsedID struct {
 	Components []string
 	// Suffix is the suffix part if detected, nil otherwise
 	Suffix *string
+	// SuffixKind is the family of the suffix, SuffixNone without one
+	SuffixKind SuffixKind
+	// Separator is the separator the ID was split on
+	Separator string
+
+	// suffix backs Suffix when filled by ParseInto, avoiding an allocation per call
+	suffix string
 }
 
 // CollisionScenario represents collision scenario analysis
@@ -51,6 +129,25 @@ type CollisionScenario struct {
 	Probability float64
 	// Percentage is the formatted percentage string
 	Percentage string
+	// ExpectedCollisions is the expected number of IDs repeating an earlier one
+	ExpectedCollisions float64
+}
+
+// DefaultAnalysisThreshold is the default share of total combinations
+// above which scenarios are considered unrealistic
+const DefaultAnalysisThreshold = 0.8
+
+// DefaultAnalysisScenarios are the default ID counts analysed by GetCollisionAnalysis
+var DefaultAnalysisScenarios = []int{50, 100, 200, 500, 1000, 2000, 5000, 10000, 20000, 50000}
+
+// AnalysisOptions contains configuration for collision analysis
+type AnalysisOptions struct {
+	// Threshold drops scenarios with at least Threshold × total combinations IDs (default: 0.8)
+	Threshold float64
+	// Scenarios are the ID counts to analyse (default: DefaultAnalysisScenarios)
+	Scenarios []int
+	// Format renders CollisionScenario.Percentage (default: FormatDefault)
+	Format *NumberFormat
 }
 
 // CollisionAnalysis represents collision analysis result
@@ -59,6 +156,26 @@ type CollisionAnalysis struct {
 	TotalCombinations int
 	// Scenarios is the array of collision scenarios
 	Scenarios []CollisionScenario
+	// Threshold is the share of total combinations above which scenarios are dropped
+	Threshold float64
+	// Cutoff is the number of IDs at and above which scenarios are dropped
+	Cutoff int
+	// Live is the current collision risk of a Generator, nil for hypothetical analyses
+	Live *LiveCollisionRisk
+	// Warnings lists caveats about the accuracy of the analysis
+	Warnings []string
+	// Saturated reports that TotalCombinations was capped at math.MaxInt
+	Saturated bool
+}
+
+// LiveCollisionRisk represents the collision risk based on IDs actually issued
+type LiveCollisionRisk struct {
+	// EstimatedIssued is the estimated number of distinct IDs issued so far
+	EstimatedIssued int
+	// CollisionProbability is the probability that any two issued IDs collided
+	CollisionProbability float64
+	// NextCollisionProbability is the probability that the next ID repeats an issued one
+	NextCollisionProbability float64
 }
 
 // Generate creates a memorable ID
@@ -82,7 +199,29 @@ type CollisionAnalysis struct {
 //	  Components: 2,
 //	  Separator: "_",
 //	}) // "warm_duck"
+//
+//	// Custom words
+//	Generate(GenerateOptions{Dictionary: &spaceWords}) // "stellar-comet"
+//
+//	// Custom word order
+//	Generate(GenerateOptions{Pattern: "adjective-adjective-noun"}) // "cute-fluffy-rabbit"
 func Generate(options GenerateOptions) (string, error) {
+	return generate(GetDictionary(), options, rand.Intn)
+}
+
+// generate creates a memorable ID from the given dictionary, or from
+// options.Dictionary when set, drawing word indices from intn
+func generate(dict Dictionary, options GenerateOptions, intn func(int) int) (string, error) {
+	var buf [64]byte
+	id, err := appendGenerate(buf[:0], dict, options, intn)
+	if err != nil {
+		return "", err
+	}
+	return string(id), nil
+}
+
+// appendGenerate appends a generated ID to dst
+func appendGenerate(dst []byte, dict Dictionary, options GenerateOptions, intn func(int) int) ([]byte, error) {
 	// Set defaults
 	if options.Components == 0 {
 		options.Components = 2
@@ -90,40 +229,45 @@ func Generate(options GenerateOptions) (string, error) {
 	if options.Separator == "" {
 		options.Separator = "-"
 	}
+	if options.Dictionary != nil {
+		dict = *options.Dictionary
+	}
 
-	// Validate components range (after setting defaults)
-	if options.Components < 1 || options.Components > 5 {
-		return "", errors.New("components must be between 1 and 5")
+	if options.Pattern != "" {
+		classes, err := patternClasses(dict, options.Pattern)
+		if err != nil {
+			return dst, err
+		}
+		dst = appendPattern(dst, dict, classes, options.Separator, intn)
+		return options.appendSuffix(dst)
 	}
 
-	var parts []string
-	componentGenerators := []func() string{
-		func() string { return randomItem(Adjectives) },   // 0: adjective
-		func() string { return randomItem(Nouns) },        // 1: noun
-		func() string { return randomItem(Verbs) },        // 2: verb
-		func() string { return randomItem(Adverbs) },      // 3: adverb
-		func() string { return randomItem(Prepositions) }, // 4: preposition
+	// Validate components range (after setting defaults)
+	if options.Components < 1 || options.Components > 5 {
+		return dst, errors.New("components must be between 1 and 5")
 	}
 
-	// Generate requested number of components
-	for i := 0; i < options.Components; i++ {
-		parts = append(parts, componentGenerators[i]())
+	space, err := wordSpace(dict, wordClasses[:options.Components])
+	if err != nil {
+		return dst, err
 	}
 
-	// Add suffix if provided
-	if options.Suffix != nil {
-		suffixValue := options.Suffix()
-		if suffixValue != nil {
-			parts = append(parts, *suffixValue)
+	// Draw the whole word combination at once when the space fits an int,
+	// otherwise fall back to one draw per component
+	if space > 0 {
+		dst = appendWords(dst, dict, wordClasses[:options.Components], options.Separator, intn(space))
+	} else {
+		for i := 0; i < options.Components; i++ {
+			words := dict.Words(wordClasses[i])
+			if i > 0 {
+				dst = append(dst, options.Separator...)
+			}
+			dst = append(dst, words[intn(len(words))]...)
 		}
 	}
 
-	return strings.Join(parts, options.Separator), nil
-}
-
-// randomItem returns a random item from a string slice
-func randomItem(items []string) string {
-	return items[rand.Intn(len(items))]
+	// Add suffix if provided
+	return options.appendSuffix(dst)
 }
 
 // DefaultSuffix generates a random 3-digit number suffix
@@ -133,7 +277,7 @@ func randomItem(items []string) string {
 //	DefaultSuffix() // "042"
 //	DefaultSuffix() // "789"
 func DefaultSuffix() *string {
-	suffix := fmt.Sprintf("%03d", rand.Intn(1000))
+	suffix := drawNumber(rand.Intn)
 	return &suffix
 }
 
@@ -142,7 +286,7 @@ func DefaultSuffix() *string {
 // Example:
 //
 //	Parse("cute-rabbit-042", "-")
-//	// ParsedID{Components: ["cute", "rabbit"], Suffix: "042"}
+//	// ParsedID{Components: ["cute", "rabbit"], Suffix: "042", SuffixKind: SuffixNumber}
 //
 //	Parse("large-fox-swim", "-")
 //	// ParsedID{Components: ["large", "fox", "swim"], Suffix: nil}
@@ -155,14 +299,15 @@ func Parse(id string, separator string) ParsedID {
 	result := ParsedID{
 		Components: make([]string, 0),
 		Suffix:     nil,
+		Separator:  separator,
 	}
 
 	// Last part is likely suffix if it's numeric
 	if len(parts) > 0 {
 		lastPart := parts[len(parts)-1]
-		matched, _ := regexp.MatchString(`^\d+$`, lastPart)
-		if matched {
+		if isDigits(lastPart) {
 			result.Suffix = &lastPart
+			result.SuffixKind = DetectSuffixKind(lastPart)
 			result.Components = parts[:len(parts)-1]
 		} else {
 			result.Components = parts
@@ -172,7 +317,280 @@ func Parse(id string, separator string) ParsedID {
 	return result
 }
 
-// CalculateCombinations calculates total possible combinations for given configuration
+// ParseOptions contains configuration for ParseWithOptions
+type ParseOptions struct {
+	// Separator between parts (default: "-")
+	Separator string
+	// Separators is a set of accepted separators, for inputs mixing
+	// conventions; each ID is split on the first one it contains that
+	// yields dictionary words, so "cute_guinea-pig" splits on "_", and
+	// ParsedID.Separator reports which. Overrides Separator (default: nil)
+	Separators []string
+	// CaseInsensitive accepts IDs with any casing and returns the canonical
+	// lowercase components (default: false)
+	CaseInsensitive bool
+	// Suffix is the generator the IDs were created with; when it has a
+	// spec, the last part is a suffix only if the spec matches it, instead
+	// of guessing from digits (default: nil)
+	Suffix SuffixGenerator
+	// SuffixProvider is the provider the IDs were created with, used like
+	// Suffix; it overrides Suffix (default: nil)
+	SuffixProvider SuffixProvider
+	// ExplicitSuffix disables the digits heuristic: only a suffix matching
+	// the spec of Suffix is recognized, and without one every part is a
+	// component, for dictionaries with numeric-looking words (default: false)
+	ExplicitSuffix bool
+	// DetectSuffix also recognizes hex and letter suffixes without a spec:
+	// a last part looking like one is a suffix unless it is a dictionary
+	// word of the class expected at its position (default: false)
+	DetectSuffix bool
+	// Dictionary overrides the word lists DetectSuffix and ParseStrict
+	// check words against (default: nil, the built-in dictionary)
+	Dictionary *Dictionary
+	// Pattern is the word-order template ParseStrict checks words against,
+	// see GenerateOptions.Pattern (default: "", words in component order)
+	Pattern string
+	// MaxLength is the longest input in bytes accepted by ParseChecked
+	// (default: DefaultMaxParseLength)
+	MaxLength int
+	// MaxParts is the most separator-delimited parts accepted by
+	// ParseChecked (default: DefaultMaxParseParts)
+	MaxParts int
+}
+
+// Default limits applied by ParseChecked
+const (
+	DefaultMaxParseLength = 256
+	DefaultMaxParseParts  = 16
+	// MaxSeparatorLength is the longest separator accepted by ParseChecked
+	MaxSeparatorLength = 8
+)
+
+// Errors returned by ParseChecked for inputs outside its limits
+var (
+	ErrInputTooLong     = errors.New("input too long")
+	ErrTooManyParts     = errors.New("too many parts")
+	ErrInvalidSeparator = errors.New("invalid separator")
+)
+
+// ParseWithOptions parses a memorable ID like Parse, with additional options
+//
+// Example:
+//
+//	ParseWithOptions("CUTE-Rabbit-042", ParseOptions{CaseInsensitive: true})
+//	// ParsedID{Components: ["cute", "rabbit"], Suffix: "042"}
+//	ParseWithOptions("cute-rabbit-ff", ParseOptions{Suffix: SuffixGenerators.Hex})
+//	// ParsedID{Components: ["cute", "rabbit"], Suffix: "ff"}
+//	ParseWithOptions("cute_rabbit_042", ParseOptions{Separators: []string{"-", "_"}})
+//	// ParsedID{Components: ["cute", "rabbit"], Suffix: "042", Separator: "_"}
+//	ParseWithOptions("agent-007", ParseOptions{ExplicitSuffix: true})
+//	// ParsedID{Components: ["agent", "007"], Suffix: nil}
+func ParseWithOptions(id string, options ParseOptions) ParsedID {
+	if options.CaseInsensitive {
+		id = Canonicalize(id)
+	}
+	separator := options.separatorFor(id)
+	spec, ok := suffixSpecOf(options.SuffixProvider, options.Suffix)
+	if !ok && !options.ExplicitSuffix {
+		parsed := Parse(id, separator)
+		if options.DetectSuffix && parsed.Suffix == nil {
+			detectSuffix(&parsed, options.dictionary(), options.classes())
+		}
+		return parsed
+	}
+
+	parts := strings.Split(id, separator)
+	last := parts[len(parts)-1]
+	if ok && len(parts) > 1 && spec.Matches(last) {
+		return ParsedID{Components: parts[:len(parts)-1], Suffix: &last, SuffixKind: suffixKindOf(spec.Name), Separator: separator}
+	}
+	return ParsedID{Components: parts, Separator: separator}
+}
+
+// dictionary returns the dictionary to check words against
+func (o ParseOptions) dictionary() Dictionary {
+	if o.Dictionary != nil {
+		return *o.Dictionary
+	}
+	return GetDictionary()
+}
+
+// classes returns the word classes expected at each position: the
+// classes of Pattern if it is valid, otherwise component order
+func (o ParseOptions) classes() []WordClass {
+	if classes, err := ParsePattern(o.Pattern); o.Pattern != "" && err == nil {
+		return classes
+	}
+	return wordClasses
+}
+
+// detectSuffix moves a last component looking like a hex or letter suffix
+// to the suffix, unless it is a word of the class expected at its position
+func detectSuffix(parsed *ParsedID, dict Dictionary, classes []WordClass) {
+	position := len(parsed.Components) - 1
+	if position < 1 {
+		return
+	}
+	last := parsed.Components[position]
+	kind := DetectSuffixKind(last)
+	if kind != SuffixHex && kind != SuffixLetter {
+		return
+	}
+	if position < len(classes) && dict.Contains(classes[position], last) {
+		return
+	}
+	parsed.Components = parsed.Components[:position]
+	parsed.Suffix = &last
+	parsed.SuffixKind = kind
+}
+
+// separatorFor returns the separator to split id on. Of the Separators
+// contained in id, that is the first splitting it into dictionary words of
+// the expected classes, as words like "guinea-pig" may contain another
+// separator; failing that, the one occurring most often. Otherwise it is
+// the first of Separators, otherwise Separator or the default "-".
+func (o ParseOptions) separatorFor(id string) string {
+	var contained []string
+	for _, separator := range o.Separators {
+		if separator != "" && strings.Contains(id, separator) {
+			contained = append(contained, separator)
+		}
+	}
+	if len(contained) == 1 {
+		return contained[0]
+	}
+	if len(contained) > 1 {
+		for _, separator := range contained {
+			if o.splitsIntoWords(id, separator) {
+				return separator
+			}
+		}
+		best := contained[0]
+		for _, separator := range contained[1:] {
+			if strings.Count(id, separator) > strings.Count(id, best) {
+				best = separator
+			}
+		}
+		return best
+	}
+	if len(o.Separators) > 0 && o.Separators[0] != "" {
+		return o.Separators[0]
+	}
+	if o.Separator == "" {
+		return "-"
+	}
+	return o.Separator
+}
+
+// splitsIntoWords reports whether splitting id on separator gives
+// dictionary words of the expected classes, as checked by ParseStrict
+func (o ParseOptions) splitsIntoWords(id, separator string) bool {
+	o.Separator, o.Separators = separator, nil
+	_, err := ParseStrict(id, o)
+	return err == nil
+}
+
+// ParseChecked parses a memorable ID like ParseWithOptions, but first
+// enforces input limits so untrusted input can't make it split
+// pathological payloads. Inputs longer than MaxLength return
+// ErrInputTooLong, inputs with more than MaxParts parts return
+// ErrTooManyParts, and separators that are longer than MaxSeparatorLength
+// or contain letters or digits return ErrInvalidSeparator.
+//
+// Example:
+//
+//	ParseChecked("cute-rabbit-042", ParseOptions{})
+//	// ParsedID{Components: ["cute", "rabbit"], Suffix: "042"}, nil
+//	ParseChecked(strings.Repeat("a-", 1000), ParseOptions{})
+//	// ParsedID{}, input too long: 2000 bytes, limit 256
+func ParseChecked(id string, options ParseOptions) (ParsedID, error) {
+	maxLength := options.MaxLength
+	if maxLength < 1 {
+		maxLength = DefaultMaxParseLength
+	}
+	maxParts := options.MaxParts
+	if maxParts < 1 {
+		maxParts = DefaultMaxParseParts
+	}
+	for _, separator := range append([]string{options.Separator}, options.Separators...) {
+		if len(separator) > MaxSeparatorLength || strings.IndexFunc(separator, isAlphanumeric) >= 0 {
+			return ParsedID{}, fmt.Errorf("%w: %q", ErrInvalidSeparator, separator)
+		}
+	}
+	if len(id) > maxLength {
+		return ParsedID{}, fmt.Errorf("%w: %d bytes, limit %d", ErrInputTooLong, len(id), maxLength)
+	}
+	if parts := strings.Count(id, options.separatorFor(id)) + 1; parts > maxParts {
+		return ParsedID{}, fmt.Errorf("%w: %d parts, limit %d", ErrTooManyParts, parts, maxParts)
+	}
+
+	return ParseWithOptions(id, options), nil
+}
+
+// isAlphanumeric reports whether r is a letter or digit
+func isAlphanumeric(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// Canonicalize returns the canonical form of an ID retyped by a user:
+// surrounding whitespace removed and all letters lowercased
+//
+// Example:
+//
+//	Canonicalize(" Cute-Rabbit-042 ") // "cute-rabbit-042"
+func Canonicalize(id string) string {
+	return strings.ToLower(strings.TrimSpace(id))
+}
+
+// ParseInto parses a memorable ID like Parse, but stores the result in dst,
+// reusing its Components slice and suffix storage so high-throughput
+// ingestion paths don't allocate per call. The Suffix pointer refers to
+// storage inside dst and is overwritten by the next ParseInto call.
+//
+// Example:
+//
+//	var parsed ParsedID
+//	for _, id := range incoming {
+//	  ParseInto(id, "-", &parsed)
+//	  process(parsed.Components, parsed.Suffix)
+//	}
+func ParseInto(id string, separator string, dst *ParsedID) {
+	if separator == "" {
+		separator = "-"
+	}
+
+	components := dst.Components[:0]
+	rest := id
+	for {
+		i := strings.Index(rest, separator)
+		if i < 0 {
+			break
+		}
+		components = append(components, rest[:i])
+		rest = rest[i+len(separator):]
+	}
+
+	// Last part is likely suffix if it's numeric
+	if isDigits(rest) {
+		dst.suffix = rest
+		dst.Suffix = &dst.suffix
+		dst.SuffixKind = DetectSuffixKind(rest)
+	} else {
+		components = append(components, rest)
+		dst.Suffix = nil
+		dst.SuffixKind = SuffixNone
+	}
+	dst.Components = components
+	dst.Separator = separator
+}
+
+// ErrCombinationOverflow is returned when a combination count does not fit in uint64
+var ErrCombinationOverflow = errors.New("combination count overflows uint64")
+
+// CalculateCombinations calculates total possible combinations for given
+// configuration. Counts beyond math.MaxInt, possible with large custom
+// dictionaries and suffix ranges, saturate at math.MaxInt; use
+// CalculateCombinationsChecked to detect that case.
 //
 // Example:
 //
@@ -180,28 +598,53 @@ func Parse(id string, separator string) ParsedID {
 //	CalculateCombinations(2, 1000) // 5,304,000 (2 components + 3-digit suffix)
 //	CalculateCombinations(3, 1)    // 212,160 (3 components, no suffix)
 func CalculateCombinations(components int, suffixRange int) int {
+	return combinationsFor(GetDictionaryStats(), components, suffixRange)
+}
+
+// combinationsFor calculates combinations like CalculateCombinations for
+// a dictionary with the given stats
+func combinationsFor(stats DictionaryStats, components int, suffixRange int) int {
 	if components < 1 || components > 5 {
 		return 0
 	}
+
+	total, err := combinationsCheckedFor(stats, components, suffixRange)
+	if err != nil || total > math.MaxInt {
+		return math.MaxInt
+	}
+	return int(total)
+}
+
+// CalculateCombinationsChecked calculates total possible combinations like
+// CalculateCombinations, returning ErrCombinationOverflow instead of
+// saturating when the count does not fit in uint64
+//
+// Example:
+//
+//	CalculateCombinationsChecked(5, 10000) // 1,758,931,200,000, nil
+func CalculateCombinationsChecked(components int, suffixRange int) (uint64, error) {
+	return combinationsCheckedFor(GetDictionaryStats(), components, suffixRange)
+}
+
+// combinationsCheckedFor calculates combinations like
+// CalculateCombinationsChecked for a dictionary with the given stats
+func combinationsCheckedFor(stats DictionaryStats, components int, suffixRange int) (uint64, error) {
+	if components < 1 || components > 5 {
+		return 0, errors.New("components must be between 1 and 5")
+	}
 	if suffixRange < 1 {
 		suffixRange = 1
 	}
 
-	stats := GetDictionaryStats()
-	componentSizes := []int{
-		stats.Adjectives,   // 78 adjectives
-		stats.Nouns,        // 68 nouns
-		stats.Verbs,        // 40 verbs
-		stats.Adverbs,      // 27 adverbs
-		stats.Prepositions, // 26 prepositions
+	words, ok := wordCombinations(stats, components)
+	if !ok {
+		return 0, ErrCombinationOverflow
 	}
-
-	total := 1
-	for i := 0; i < components; i++ {
-		total *= componentSizes[i]
+	total, ok := mulUint64(words, uint64(suffixRange))
+	if !ok {
+		return 0, ErrCombinationOverflow
 	}
-
-	return total * suffixRange
+	return total, nil
 }
 
 // CalculateCollisionProbability calculates collision probability using Birthday Paradox
@@ -222,10 +665,65 @@ func CalculateCollisionProbability(totalCombinations int, generatedIDs int) floa
 	}
 
 	// Birthday paradox approximation: 1 - e^(-n²/2N)
-	exponent := -float64(generatedIDs*generatedIDs) / (2.0 * float64(totalCombinations))
+	exponent := -float64(generatedIDs) * float64(generatedIDs) / (2.0 * float64(totalCombinations))
 	return 1.0 - math.Exp(exponent)
 }
 
+// CalculateExpectedCollisions calculates the expected number of generated IDs
+// that repeat an earlier one, i.e. generatedIDs minus the expected number of
+// distinct IDs. This is what capacity discussions usually need
+// ("~3 collisions at 100k IDs") rather than the any-collision probability.
+//
+// Example:
+//
+//	// For 3 components + 3-digit suffix (~250M total), generating 100,000 IDs
+//	CalculateExpectedCollisions(250560000, 100000) // ~19.95
+func CalculateExpectedCollisions(totalCombinations int, generatedIDs int) float64 {
+	if totalCombinations < 1 || generatedIDs <= 1 {
+		return 0
+	}
+
+	// Expected distinct values: N * (1 - (1 - 1/N)^n), computed without losing precision
+	n, total := float64(generatedIDs), float64(totalCombinations)
+	distinct := -total * math.Expm1(n*math.Log1p(-1/total))
+	return max(n-distinct, 0)
+}
+
+// MaxIDsAt returns how many IDs the options can issue before the
+// collision probability exceeds maxCollisionProb, the inverse of
+// CalculateCollisionProbability. Time-derived and unknown custom suffixes
+// count as a 1x multiplier, so the answer errs on the safe side.
+//
+// Example:
+//
+//	MaxIDsAt(GenerateOptions{}, 0.01)                                               // 11
+//	MaxIDsAt(GenerateOptions{Components: 3, Suffix: SuffixGenerators.Number}, 0.01) // 2244
+//	MaxIDsAt(GenerateOptions{Pattern: "noun-verb"}, 1)                              // 2880
+func MaxIDsAt(options GenerateOptions, maxCollisionProb float64) int {
+	suffixRange, err := options.suffixRange()
+	if err != nil {
+		suffixRange = 1
+	}
+	total, err := options.combinations(suffixRange)
+	if err != nil {
+		return 0
+	}
+
+	if maxCollisionProb >= 1 {
+		return total
+	}
+	if maxCollisionProb <= 0 {
+		return 1
+	}
+
+	// Invert the birthday approximation p = 1 - e^(-n²/2N)
+	n := int(math.Sqrt(-2 * float64(total) * math.Log1p(-maxCollisionProb)))
+	for n > 1 && CalculateCollisionProbability(total, n) > maxCollisionProb {
+		n--
+	}
+	return max(min(n, total), 1)
+}
+
 // GetCollisionAnalysis gets collision analysis for different ID generation scenarios
 //
 // Example:
@@ -234,37 +732,104 @@ func CalculateCollisionProbability(totalCombinations int, generatedIDs int) floa
 //	// CollisionAnalysis{
 //	//   TotalCombinations: 5304,
 //	//   Scenarios: [
-//	//     {IDs: 100, Probability: 0.0093, Percentage: "0.93%"},
+//	//     {IDs: 100, Probability: 0.0093, Percentage: "0.93%", ExpectedCollisions: 0.93},
 //	//     {IDs: 500, Probability: 0.218, Percentage: "21.8%"},
 //	//     ...
 //	//   ]
 //	// }
 func GetCollisionAnalysis(components int, suffixRange int) CollisionAnalysis {
-	if suffixRange < 1 {
-		suffixRange = 1
+	return GetCollisionAnalysisWithOptions(components, suffixRange, AnalysisOptions{})
+}
+
+// GetCollisionAnalysisWithOptions gets collision analysis for a custom list
+// of scenarios and realism threshold
+//
+// Example:
+//
+//	// Keep scenarios up to the full combination space
+//	GetCollisionAnalysisWithOptions(2, 1, AnalysisOptions{
+//	  Threshold: 1,
+//	  Scenarios: []int{1000, 5000, 6000},
+//	})
+//	// CollisionAnalysis{Threshold: 1, Cutoff: 6264, Scenarios: [{IDs: 1000, ...}, ...]}
+func GetCollisionAnalysisWithOptions(components int, suffixRange int, options AnalysisOptions) CollisionAnalysis {
+	return collisionAnalysisFor(combinationsFor(GetDictionaryStats(), components, suffixRange), options)
+}
+
+// collisionAnalysisFor gets collision analysis like
+// GetCollisionAnalysisWithOptions for a space of total combinations
+func collisionAnalysisFor(total int, options AnalysisOptions) CollisionAnalysis {
+	if options.Threshold <= 0 {
+		options.Threshold = DefaultAnalysisThreshold
+	}
+	if options.Scenarios == nil {
+		options.Scenarios = DefaultAnalysisScenarios
+	}
+	format := FormatDefault
+	if options.Format != nil {
+		format = *options.Format
 	}
 
-	total := CalculateCombinations(components, suffixRange)
-	testSizes := []int{50, 100, 200, 500, 1000, 2000, 5000, 10000, 20000, 50000}
+	key := analysisKey{
+		total:     total,
+		threshold: options.Threshold,
+		scenarios: fmt.Sprint(options.Scenarios),
+		format:    format,
+	}
+	if cached, ok := analysisCache.get(key); ok {
+		return cached
+	}
 
 	var scenarios []CollisionScenario
-	threshold := int(float64(total) * 0.8) // Only show realistic scenarios
+	cutoff := int(float64(total) * options.Threshold) // Only show realistic scenarios
 
-	for _, size := range testSizes {
-		if size < threshold {
+	for _, size := range options.Scenarios {
+		if size < cutoff {
 			probability := CalculateCollisionProbability(total, size)
 			scenarios = append(scenarios, CollisionScenario{
-				IDs:         size,
-				Probability: probability,
-				Percentage:  fmt.Sprintf("%.2f%%", probability*100),
+				IDs:                size,
+				Probability:        probability,
+				Percentage:         format.Percentage(probability),
+				ExpectedCollisions: CalculateExpectedCollisions(total, size),
 			})
 		}
 	}
 
-	return CollisionAnalysis{
+	analysis := CollisionAnalysis{
 		TotalCombinations: total,
 		Scenarios:         scenarios,
+		Threshold:         options.Threshold,
+		Cutoff:            cutoff,
+	}
+	if total == math.MaxInt {
+		analysis.Saturated = true
+		analysis.Warnings = append(analysis.Warnings, "combination count exceeds the int range; probabilities use a lower bound")
 	}
+	analysisCache.put(key, analysis)
+	return analysis
+}
+
+// GetCollisionAnalysisForSuffix gets collision analysis like
+// GetCollisionAnalysisWithOptions, deriving the suffix range from the
+// suffix generator instead of taking it as a number. Time-derived suffixes
+// count as a 1x multiplier and add a warning; generators of unknown range
+// return ErrUnknownSuffixRange.
+//
+// Example:
+//
+//	GetCollisionAnalysisForSuffix(2, SuffixGenerators.Hex, AnalysisOptions{})
+//	// CollisionAnalysis{TotalCombinations: 1357824, ...}, nil
+func GetCollisionAnalysisForSuffix(components int, suffix SuffixGenerator, options AnalysisOptions) (CollisionAnalysis, error) {
+	suffixRange, err := SuffixRange(suffix)
+	if err != nil {
+		return CollisionAnalysis{}, err
+	}
+
+	analysis := GetCollisionAnalysisWithOptions(components, suffixRange, options)
+	if info, ok := lookupSuffixInfo(suffix); ok && info.timeDerived {
+		analysis.Warnings = append(analysis.Warnings, timeDerivedSuffixWarning)
+	}
+	return analysis, nil
 }
 
 // SuffixGeneratorCollection contains predefined suffix generators
@@ -282,7 +847,8 @@ type SuffixGeneratorCollection struct {
 	Hex func() *string
 
 	// Timestamp generates last 4 digits of current timestamp
-	// Adds ~10,000x multiplier (time-based, not truly random)
+	// Time-based, not truly random: IDs issued in the same millisecond share
+	// the suffix, so collision analysis counts it as a 1x multiplier
 	Timestamp func() *string
 
 	// Letter generates random lowercase letter (a-z)
@@ -290,32 +856,28 @@ type SuffixGeneratorCollection struct {
 	Letter func() *string
 }
 
-// SuffixGenerators contains collection of predefined suffix generators
+// SuffixGenerators contains collection of predefined suffix generators in
+// the v1 form; Suffixes holds the same generators as SuffixFunc
 var SuffixGenerators = SuffixGeneratorCollection{
 	Number: DefaultSuffix,
 
 	Number4: func() *string {
-		suffix := fmt.Sprintf("%04d", rand.Intn(10000))
+		suffix := drawNumber4(rand.Intn)
 		return &suffix
 	},
 
 	Hex: func() *string {
-		suffix := fmt.Sprintf("%02x", rand.Intn(256))
+		suffix := drawHex(rand.Intn)
 		return &suffix
 	},
 
 	Timestamp: func() *string {
-		timestamp := strconv.FormatInt(time.Now().UnixNano()/int64(time.Millisecond), 10)
-		if len(timestamp) >= 4 {
-			suffix := timestamp[len(timestamp)-4:]
-			return &suffix
-		}
-		suffix := fmt.Sprintf("%04d", rand.Intn(10000))
+		suffix := clockSuffix(SystemClock)
 		return &suffix
 	},
 
 	Letter: func() *string {
-		suffix := string(rune('a' + rand.Intn(26)))
+		suffix := drawLetter(rand.Intn)
 		return &suffix
 	},
 }
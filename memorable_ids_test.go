@@ -687,3 +687,143 @@ func sliceEqual(a, b []string) bool {
 	}
 	return true
 }
+
+func TestGenerateOptionsValidate(t *testing.T) {
+	t.Run("should accept valid options", func(t *testing.T) {
+		assert.NoError(t, GenerateOptions{}.Validate())
+		assert.NoError(t, GenerateOptions{Components: 5, Suffix: SuffixGenerators.Hex, Separator: "_"}.Validate())
+		assert.NoError(t, GenerateOptions{Separator: "::"}.Validate())
+	})
+
+	t.Run("should reject invalid component counts", func(t *testing.T) {
+		assert.ErrorIs(t, GenerateOptions{Components: 6}.Validate(), ErrInvalidOptions)
+		assert.ErrorIs(t, GenerateOptions{Components: -1}.Validate(), ErrInvalidOptions)
+	})
+
+	t.Run("should reject blank separators", func(t *testing.T) {
+		err := GenerateOptions{Separator: "  "}.Validate()
+		assert.ErrorIs(t, err, ErrInvalidOptions)
+		assert.ErrorContains(t, err, "blank")
+	})
+
+	t.Run("should reject separators occurring in dictionary words", func(t *testing.T) {
+		err := GenerateOptions{Separator: "e"}.Validate()
+		assert.ErrorIs(t, err, ErrInvalidOptions)
+		assert.ErrorContains(t, err, "dictionary words")
+	})
+
+	t.Run("should reject separators occurring in suffixes", func(t *testing.T) {
+		err := GenerateOptions{Separator: "0", Suffix: SuffixGenerators.Number}.Validate()
+		assert.ErrorIs(t, err, ErrInvalidOptions)
+		assert.ErrorContains(t, err, "number suffixes")
+	})
+
+	t.Run("should be checked when creating a generator", func(t *testing.T) {
+		gen := NewGenerator(Config{Options: GenerateOptions{Separator: " "}})
+		assert.ErrorIs(t, gen.Err(), ErrInvalidOptions)
+
+		_, err := gen.Generate()
+		assert.ErrorIs(t, err, ErrInvalidOptions)
+
+		assert.NoError(t, NewGenerator(Config{}).Err())
+	})
+}
+
+func TestGenerateWithDictionary(t *testing.T) {
+	space := NewDictionary([]string{"stellar", "cosmic"}, []string{"comet", "nebula", "quasar"}, []string{"orbit"}, []string{"swiftly"}, []string{"beyond"})
+
+	t.Run("should draw words from the per-call dictionary", func(t *testing.T) {
+		for range 20 {
+			id, err := Generate(GenerateOptions{Components: 3, Suffix: SuffixGenerators.Number, Dictionary: &space})
+			require.NoError(t, err)
+
+			parsed := Parse(id, "-")
+			require.Len(t, parsed.Components, 3)
+			assert.Contains(t, space.Adjectives, parsed.Components[0])
+			assert.Contains(t, space.Nouns, parsed.Components[1])
+			assert.Equal(t, "orbit", parsed.Components[2])
+			assert.NotNil(t, parsed.Suffix)
+		}
+	})
+
+	t.Run("should leave the built-in dictionary untouched", func(t *testing.T) {
+		_, err := Generate(GenerateOptions{Dictionary: &space})
+		require.NoError(t, err)
+
+		id, err := Generate(GenerateOptions{})
+		require.NoError(t, err)
+		parsed := Parse(id, "-")
+		assert.Contains(t, Adjectives, parsed.Components[0])
+		assert.Contains(t, Nouns, parsed.Components[1])
+	})
+
+	t.Run("should size the space from the per-call dictionary", func(t *testing.T) {
+		options := GenerateOptions{Dictionary: &space}
+		assert.Equal(t, 6, MaxIDsAt(options, 1))
+		_, err := GenerateN(7, options, BatchOptions{Unique: true})
+		assert.ErrorIs(t, err, ErrBatchExhausted)
+
+		length, err := MaxIDLength(options)
+		require.NoError(t, err)
+		assert.Equal(t, len("stellar-quasar"), length)
+	})
+
+	t.Run("should size a struct-literal dictionary from its word lists", func(t *testing.T) {
+		literal := Dictionary{Adjectives: []string{"stellar", "cosmic"}, Nouns: []string{"comet", "nebula", "quasar"}}
+		options := GenerateOptions{Dictionary: &literal}
+		assert.Equal(t, 6, MaxIDsAt(options, 1))
+
+		ids, err := GenerateN(6, options, BatchOptions{Unique: true})
+		require.NoError(t, err)
+		assert.Len(t, ids, 6)
+		_, err = GenerateN(7, options, BatchOptions{Unique: true})
+		assert.ErrorIs(t, err, ErrBatchExhausted)
+
+		comparison, err := CompareConfigs(options)
+		require.NoError(t, err)
+		assert.Equal(t, 6, comparison.Configs[0].Combinations)
+
+		analysis := NewGenerator(Config{Dictionary: &literal}).CollisionAnalysis()
+		assert.Equal(t, 6, analysis.TotalCombinations)
+	})
+
+	t.Run("should validate the per-call dictionary", func(t *testing.T) {
+		empty := NewDictionary([]string{"stellar"}, nil, nil, nil, nil)
+		err := GenerateOptions{Dictionary: &empty}.Validate()
+		assert.ErrorIs(t, err, ErrInvalidOptions)
+		assert.ErrorIs(t, err, ErrEmptyWordClass)
+
+		hyphenated := NewDictionary([]string{"far", "far-off"}, []string{"comet"}, []string{"orbit"}, []string{"swiftly"}, []string{"beyond"})
+		assert.ErrorIs(t, GenerateOptions{Dictionary: &hyphenated}.Validate(), ErrInvalidOptions)
+		assert.NoError(t, GenerateOptions{Dictionary: &hyphenated, Separator: "_"}.Validate())
+	})
+}
+
+func TestMaxIDsAt(t *testing.T) {
+	t.Run("should invert the collision probability", func(t *testing.T) {
+		options := GenerateOptions{Components: 3, Suffix: SuffixGenerators.Number}
+		total := CalculateCombinations(3, 1000)
+
+		for _, p := range []float64{0.001, 0.01, 0.5} {
+			n := MaxIDsAt(options, p)
+			assert.LessOrEqual(t, CalculateCollisionProbability(total, n), p)
+			assert.Greater(t, CalculateCollisionProbability(total, n+1), p)
+		}
+	})
+
+	t.Run("should grow with the configuration space", func(t *testing.T) {
+		small := MaxIDsAt(GenerateOptions{}, 0.01)
+		large := MaxIDsAt(GenerateOptions{Components: 4, Suffix: SuffixGenerators.Number4}, 0.01)
+		assert.Greater(t, large, small*100)
+	})
+
+	t.Run("should handle edge probabilities", func(t *testing.T) {
+		assert.Equal(t, 1, MaxIDsAt(GenerateOptions{}, 0))
+		assert.Equal(t, CalculateCombinations(2, 1), MaxIDsAt(GenerateOptions{}, 1))
+		assert.Equal(t, 0, MaxIDsAt(GenerateOptions{Components: 6}, 0.01))
+	})
+
+	t.Run("should count time-derived suffixes as 1x", func(t *testing.T) {
+		assert.Equal(t, MaxIDsAt(GenerateOptions{}, 0.01), MaxIDsAt(GenerateOptions{Suffix: SuffixGenerators.Timestamp}, 0.01))
+	})
+}
@@ -0,0 +1,125 @@
+package memorablepb
+
+import (
+	"errors"
+	"fmt"
+
+	memorable "github.com/riipandi/memorable-ids"
+)
+
+// ErrUnknownSuffix is returned when a suffix generator has no wire name
+var ErrUnknownSuffix = errors.New("suffix generator has no wire name")
+
+// FromGenerateOptions converts generation options to their wire form.
+// Only suffix generators with a spec name can be sent (see
+// memorable.DescribeSuffix); others, and suffix providers, return
+// ErrUnknownSuffix.
+func FromGenerateOptions(options memorable.GenerateOptions) (*GenerateOptions, error) {
+	message := &GenerateOptions{Components: int32(options.Components), Separator: options.Separator}
+	if options.SuffixProvider != nil {
+		return nil, ErrUnknownSuffix
+	}
+	if options.Suffix != nil {
+		spec, ok := memorable.DescribeSuffix(options.Suffix)
+		if !ok || spec.Name == "" {
+			return nil, ErrUnknownSuffix
+		}
+		message.Suffix = spec.Name
+	}
+	return message, nil
+}
+
+// ToGenerateOptions converts the wire form back to generation options,
+// resolving the suffix with memorable.SuffixByName
+func (m *GenerateOptions) ToGenerateOptions() (memorable.GenerateOptions, error) {
+	options := memorable.GenerateOptions{Components: int(m.Components), Separator: m.Separator}
+	if m.Suffix != "" {
+		suffix, ok := memorable.SuffixByName(m.Suffix)
+		if !ok {
+			return memorable.GenerateOptions{}, fmt.Errorf("%w: %q", ErrUnknownSuffix, m.Suffix)
+		}
+		options.Suffix = suffix
+	}
+	return options, nil
+}
+
+// FromParsedID converts a parsed ID to its wire form
+func FromParsedID(parsed memorable.ParsedID) *ParsedID {
+	message := &ParsedID{Components: append([]string(nil), parsed.Components...), Separator: parsed.Separator}
+	if parsed.Suffix != nil {
+		suffix := *parsed.Suffix
+		message.Suffix = &suffix
+		message.SuffixKind = int32(parsed.SuffixKind)
+	}
+	return message
+}
+
+// ToParsedID converts the wire form back to a parsed ID. A suffix without
+// a kind, from producers predating suffix_kind, or with a kind unknown to
+// this version is reported as memorable.SuffixCustom.
+func (m *ParsedID) ToParsedID() memorable.ParsedID {
+	parsed := memorable.ParsedID{Components: append([]string{}, m.Components...), Separator: m.Separator}
+	if m.Suffix != nil {
+		suffix := *m.Suffix
+		parsed.Suffix = &suffix
+		parsed.SuffixKind = memorable.SuffixKind(m.SuffixKind)
+		if parsed.SuffixKind <= memorable.SuffixNone || parsed.SuffixKind > memorable.SuffixCustom {
+			parsed.SuffixKind = memorable.SuffixCustom
+		}
+	}
+	return parsed
+}
+
+// FromCollisionAnalysis converts a collision analysis to its wire form
+func FromCollisionAnalysis(analysis memorable.CollisionAnalysis) *CollisionAnalysis {
+	message := &CollisionAnalysis{
+		TotalCombinations: int64(analysis.TotalCombinations),
+		Threshold:         analysis.Threshold,
+		Cutoff:            int64(analysis.Cutoff),
+		Warnings:          append([]string(nil), analysis.Warnings...),
+		Saturated:         analysis.Saturated,
+	}
+	for _, scenario := range analysis.Scenarios {
+		message.Scenarios = append(message.Scenarios, CollisionScenario{
+			IDs:                int64(scenario.IDs),
+			Probability:        scenario.Probability,
+			Percentage:         scenario.Percentage,
+			ExpectedCollisions: scenario.ExpectedCollisions,
+		})
+	}
+	if analysis.Live != nil {
+		message.Live = &LiveCollisionRisk{
+			EstimatedIssued:          int64(analysis.Live.EstimatedIssued),
+			CollisionProbability:     analysis.Live.CollisionProbability,
+			NextCollisionProbability: analysis.Live.NextCollisionProbability,
+		}
+	}
+	return message
+}
+
+// ToCollisionAnalysis converts the wire form back to a collision analysis
+func (m *CollisionAnalysis) ToCollisionAnalysis() memorable.CollisionAnalysis {
+	analysis := memorable.CollisionAnalysis{
+		TotalCombinations: int(m.TotalCombinations),
+		Threshold:         m.Threshold,
+		Cutoff:            int(m.Cutoff),
+		Warnings:          append([]string(nil), m.Warnings...),
+		Saturated:         m.Saturated,
+	}
+	for _, scenario := range m.Scenarios {
+		analysis.Scenarios = append(analysis.Scenarios, memorable.CollisionScenario{
+			IDs:                int(scenario.IDs),
+			Probability:        scenario.Probability,
+			Percentage:         scenario.Percentage,
+			ExpectedCollisions: scenario.ExpectedCollisions,
+		})
+	}
+	if m.Live != nil {
+		analysis.Live = &memorable.LiveCollisionRisk{
+			EstimatedIssued:          int(m.Live.EstimatedIssued),
+			CollisionProbability:     m.Live.CollisionProbability,
+			NextCollisionProbability: m.Live.NextCollisionProbability,
+		}
+	}
+	return analysis
+}
@@ -0,0 +1,289 @@
+// Package memorablepb provides the wire types of memorable_ids.proto, the
+// protocol buffer schema of the memorable-ids package types, with
+// converters from and to the package types.
+//
+// The types are hand-maintained to match the schema and encode the
+// standard proto3 binary wire format using the standard library only, so
+// services can exchange them with peers using protoc-generated code
+// without adding a protobuf runtime to every consumer. Golden tests pin
+// the encoding to the bytes of protoc-gen-go types of the schema. Unknown
+// fields are skipped when decoding, so older readers accept newer messages.
+//
+//	analysis := memorable.GetCollisionAnalysis(2, 1000)
+//	payload := memorablepb.FromCollisionAnalysis(analysis).Marshal()
+//	publish("naming.analysis", payload)
+package memorablepb
+
+// GenerateOptions is the wire form of memorable.GenerateOptions
+type GenerateOptions struct {
+	// Components is the number of word components (0: default of 2)
+	Components int32
+	// Suffix is the spec name of a built-in suffix generator ("": none)
+	Suffix string
+	// Separator is the separator between parts ("": default "-")
+	Separator string
+}
+
+// ParsedID is the wire form of memorable.ParsedID
+type ParsedID struct {
+	Components []string
+	// Suffix is nil when the ID has no suffix
+	Suffix    *string
+	Separator string
+	// SuffixKind is the suffix family, numbered like memorable.SuffixKind
+	SuffixKind int32
+}
+
+// CollisionScenario is the wire form of memorable.CollisionScenario
+type CollisionScenario struct {
+	IDs                int64
+	Probability        float64
+	Percentage         string
+	ExpectedCollisions float64
+}
+
+// LiveCollisionRisk is the wire form of memorable.LiveCollisionRisk
+type LiveCollisionRisk struct {
+	EstimatedIssued          int64
+	CollisionProbability     float64
+	NextCollisionProbability float64
+}
+
+// CollisionAnalysis is the wire form of memorable.CollisionAnalysis
+type CollisionAnalysis struct {
+	TotalCombinations int64
+	Scenarios         []CollisionScenario
+	Threshold         float64
+	Cutoff            int64
+	// Live is nil for hypothetical analyses
+	Live      *LiveCollisionRisk
+	Warnings  []string
+	Saturated bool
+}
+
+// Marshal encodes the message in the protobuf wire format
+func (m *GenerateOptions) Marshal() []byte {
+	var b []byte
+	b = appendInt64Field(b, 1, int64(m.Components))
+	b = appendStringField(b, 2, m.Suffix)
+	b = appendStringField(b, 3, m.Separator)
+	return b
+}
+
+// Unmarshal decodes a message in the protobuf wire format
+func (m *GenerateOptions) Unmarshal(b []byte) error {
+	*m = GenerateOptions{}
+	d := decoder{b}
+	for {
+		field, wireType, ok, err := d.next()
+		if err != nil || !ok {
+			return err
+		}
+		switch field {
+		case 1:
+			var v uint64
+			v, err = d.varint(wireType)
+			m.Components = int32(v)
+		case 2:
+			m.Suffix, err = d.string(wireType)
+		case 3:
+			m.Separator, err = d.string(wireType)
+		default:
+			err = d.skip(wireType)
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// Marshal encodes the message in the protobuf wire format
+func (m *ParsedID) Marshal() []byte {
+	var b []byte
+	for _, component := range m.Components {
+		b = appendBytesField(b, 1, []byte(component))
+	}
+	if m.Suffix != nil {
+		b = appendBytesField(b, 2, []byte(*m.Suffix))
+	}
+	b = appendStringField(b, 3, m.Separator)
+	b = appendInt64Field(b, 4, int64(m.SuffixKind))
+	return b
+}
+
+// Unmarshal decodes a message in the protobuf wire format
+func (m *ParsedID) Unmarshal(b []byte) error {
+	*m = ParsedID{}
+	d := decoder{b}
+	for {
+		field, wireType, ok, err := d.next()
+		if err != nil || !ok {
+			return err
+		}
+		switch field {
+		case 1:
+			var component string
+			component, err = d.string(wireType)
+			m.Components = append(m.Components, component)
+		case 2:
+			var suffix string
+			suffix, err = d.string(wireType)
+			m.Suffix = &suffix
+		case 3:
+			m.Separator, err = d.string(wireType)
+		case 4:
+			var v uint64
+			v, err = d.varint(wireType)
+			m.SuffixKind = int32(v)
+		default:
+			err = d.skip(wireType)
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// Marshal encodes the message in the protobuf wire format
+func (m *CollisionScenario) Marshal() []byte {
+	var b []byte
+	b = appendInt64Field(b, 1, m.IDs)
+	b = appendDoubleField(b, 2, m.Probability)
+	b = appendStringField(b, 3, m.Percentage)
+	b = appendDoubleField(b, 4, m.ExpectedCollisions)
+	return b
+}
+
+// Unmarshal decodes a message in the protobuf wire format
+func (m *CollisionScenario) Unmarshal(b []byte) error {
+	*m = CollisionScenario{}
+	d := decoder{b}
+	for {
+		field, wireType, ok, err := d.next()
+		if err != nil || !ok {
+			return err
+		}
+		switch field {
+		case 1:
+			var v uint64
+			v, err = d.varint(wireType)
+			m.IDs = int64(v)
+		case 2:
+			m.Probability, err = d.double(wireType)
+		case 3:
+			m.Percentage, err = d.string(wireType)
+		case 4:
+			m.ExpectedCollisions, err = d.double(wireType)
+		default:
+			err = d.skip(wireType)
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// Marshal encodes the message in the protobuf wire format
+func (m *LiveCollisionRisk) Marshal() []byte {
+	var b []byte
+	b = appendInt64Field(b, 1, m.EstimatedIssued)
+	b = appendDoubleField(b, 2, m.CollisionProbability)
+	b = appendDoubleField(b, 3, m.NextCollisionProbability)
+	return b
+}
+
+// Unmarshal decodes a message in the protobuf wire format
+func (m *LiveCollisionRisk) Unmarshal(b []byte) error {
+	*m = LiveCollisionRisk{}
+	d := decoder{b}
+	for {
+		field, wireType, ok, err := d.next()
+		if err != nil || !ok {
+			return err
+		}
+		switch field {
+		case 1:
+			var v uint64
+			v, err = d.varint(wireType)
+			m.EstimatedIssued = int64(v)
+		case 2:
+			m.CollisionProbability, err = d.double(wireType)
+		case 3:
+			m.NextCollisionProbability, err = d.double(wireType)
+		default:
+			err = d.skip(wireType)
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// Marshal encodes the message in the protobuf wire format
+func (m *CollisionAnalysis) Marshal() []byte {
+	var b []byte
+	b = appendInt64Field(b, 1, m.TotalCombinations)
+	for i := range m.Scenarios {
+		b = appendBytesField(b, 2, m.Scenarios[i].Marshal())
+	}
+	b = appendDoubleField(b, 3, m.Threshold)
+	b = appendInt64Field(b, 4, m.Cutoff)
+	if m.Live != nil {
+		b = appendBytesField(b, 5, m.Live.Marshal())
+	}
+	for _, warning := range m.Warnings {
+		b = appendBytesField(b, 6, []byte(warning))
+	}
+	b = appendBoolField(b, 7, m.Saturated)
+	return b
+}
+
+// Unmarshal decodes a message in the protobuf wire format
+func (m *CollisionAnalysis) Unmarshal(b []byte) error {
+	*m = CollisionAnalysis{}
+	d := decoder{b}
+	for {
+		field, wireType, ok, err := d.next()
+		if err != nil || !ok {
+			return err
+		}
+		switch field {
+		case 1:
+			var v uint64
+			v, err = d.varint(wireType)
+			m.TotalCombinations = int64(v)
+		case 2:
+			var payload []byte
+			if payload, err = d.bytes(wireType); err == nil {
+				var scenario CollisionScenario
+				err = scenario.Unmarshal(payload)
+				m.Scenarios = append(m.Scenarios, scenario)
+			}
+		case 3:
+			m.Threshold, err = d.double(wireType)
+		case 4:
+			var v uint64
+			v, err = d.varint(wireType)
+			m.Cutoff = int64(v)
+		case 5:
+			var payload []byte
+			if payload, err = d.bytes(wireType); err == nil {
+				m.Live = &LiveCollisionRisk{}
+				err = m.Live.Unmarshal(payload)
+			}
+		case 6:
+			var warning string
+			warning, err = d.string(wireType)
+			m.Warnings = append(m.Warnings, warning)
+		case 7:
+			var v uint64
+			v, err = d.varint(wireType)
+			m.Saturated = v != 0
+		default:
+			err = d.skip(wireType)
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
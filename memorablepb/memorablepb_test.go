@@ -0,0 +1,194 @@
+package memorablepb
+
+import (
+	"encoding/hex"
+	"math"
+	"testing"
+
+	memorable "github.com/riipandi/memorable-ids"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateOptions(t *testing.T) {
+	t.Run("should encode the proto3 wire format", func(t *testing.T) {
+		message, err := FromGenerateOptions(memorable.GenerateOptions{Components: 3, Suffix: memorable.SuffixGenerators.Number})
+		require.NoError(t, err)
+		assert.Equal(t, []byte{0x08, 0x03, 0x12, 0x06, 'n', 'u', 'm', 'b', 'e', 'r'}, message.Marshal())
+		assert.Empty(t, (&GenerateOptions{}).Marshal())
+	})
+
+	t.Run("should round-trip through the wire form", func(t *testing.T) {
+		message, err := FromGenerateOptions(memorable.GenerateOptions{Components: 4, Suffix: memorable.SuffixGenerators.Hex, Separator: "_"})
+		require.NoError(t, err)
+
+		var decoded GenerateOptions
+		require.NoError(t, decoded.Unmarshal(message.Marshal()))
+		options, err := decoded.ToGenerateOptions()
+		require.NoError(t, err)
+
+		id, err := memorable.Generate(options)
+		require.NoError(t, err)
+		parsed := memorable.ParseWithOptions(id, memorable.ParseOptions{Separator: "_", Suffix: options.Suffix})
+		assert.Len(t, parsed.Components, 4)
+		assert.Len(t, *parsed.Suffix, 2)
+	})
+
+	t.Run("should reject suffixes without a wire name", func(t *testing.T) {
+		_, err := FromGenerateOptions(memorable.GenerateOptions{Suffix: func() *string { return nil }})
+		assert.ErrorIs(t, err, ErrUnknownSuffix)
+
+		_, err = (&GenerateOptions{Suffix: "emoji"}).ToGenerateOptions()
+		assert.ErrorIs(t, err, ErrUnknownSuffix)
+	})
+}
+
+func TestParsedID(t *testing.T) {
+	t.Run("should round-trip suffix presence", func(t *testing.T) {
+		for _, id := range []string{"cute-rabbit-042", "large-fox-swim", "cute-rabbit-000"} {
+			parsed := memorable.Parse(id, "-")
+
+			var decoded ParsedID
+			require.NoError(t, decoded.Unmarshal(FromParsedID(parsed).Marshal()))
+			assert.Equal(t, parsed, decoded.ToParsedID(), id)
+		}
+	})
+
+	t.Run("should carry the suffix kind instead of detecting it", func(t *testing.T) {
+		parsed := memorable.ParseWithOptions("cute-rabbit-1234", memorable.ParseOptions{Suffix: memorable.SuffixGenerators.Timestamp})
+		require.Equal(t, memorable.SuffixTimestamp, parsed.SuffixKind)
+
+		var decoded ParsedID
+		require.NoError(t, decoded.Unmarshal(FromParsedID(parsed).Marshal()))
+		assert.Equal(t, parsed, decoded.ToParsedID())
+	})
+
+	t.Run("should report suffixes without a known kind as custom", func(t *testing.T) {
+		for _, kind := range []int32{0, 99} {
+			parsed := (&ParsedID{Components: []string{"cute", "rabbit"}, Suffix: stringPointer("042"), SuffixKind: kind}).ToParsedID()
+			assert.Equal(t, memorable.SuffixCustom, parsed.SuffixKind, kind)
+		}
+	})
+}
+
+func TestCollisionAnalysis(t *testing.T) {
+	t.Run("should round-trip analyses", func(t *testing.T) {
+		gen := memorable.NewGenerator(memorable.Config{Options: memorable.GenerateOptions{Suffix: memorable.SuffixGenerators.Number}})
+		_, err := gen.Generate()
+		require.NoError(t, err)
+
+		for _, analysis := range []memorable.CollisionAnalysis{
+			memorable.GetCollisionAnalysis(2, 1000),
+			gen.CollisionAnalysis(),
+			{Warnings: []string{"suffix is time-derived"}, Saturated: true},
+		} {
+			var decoded CollisionAnalysis
+			require.NoError(t, decoded.Unmarshal(FromCollisionAnalysis(analysis).Marshal()))
+			assert.Equal(t, analysis, decoded.ToCollisionAnalysis())
+		}
+	})
+
+	t.Run("should skip unknown fields", func(t *testing.T) {
+		payload := FromCollisionAnalysis(memorable.CollisionAnalysis{TotalCombinations: 6264}).Marshal()
+		payload = appendStringField(payload, 99, "added later")
+		payload = appendVarintField(payload, 100, 7)
+
+		var decoded CollisionAnalysis
+		require.NoError(t, decoded.Unmarshal(payload))
+		assert.Equal(t, int64(6264), decoded.TotalCombinations)
+	})
+
+	t.Run("should reject truncated messages", func(t *testing.T) {
+		payload := FromCollisionAnalysis(memorable.GetCollisionAnalysis(2, 1000)).Marshal()
+
+		var decoded CollisionAnalysis
+		assert.ErrorIs(t, decoded.Unmarshal(payload[:len(payload)-3]), ErrMalformed)
+	})
+}
+
+// message is implemented by every wire type
+type message interface {
+	Marshal() []byte
+	Unmarshal([]byte) error
+}
+
+func TestGoldenEncoding(t *testing.T) {
+	// The golden bytes were encoded by the protoc-gen-go (protobuf-go
+	// v1.36.11) types of memorable_ids.proto with deterministic
+	// marshaling. Regenerate them after every schema change.
+	for _, golden := range []struct {
+		name    string
+		message message
+		empty   message
+		hex     string
+	}{
+		{
+			name:    "generate options",
+			message: &GenerateOptions{Components: 3, Suffix: "number", Separator: "_"},
+			empty:   &GenerateOptions{},
+			hex:     "080312066e756d6265721a015f",
+		},
+		{
+			name:    "negative components",
+			message: &GenerateOptions{Components: -1},
+			empty:   &GenerateOptions{},
+			hex:     "08ffffffffffffffffff01",
+		},
+		{
+			name:    "parsed ID",
+			message: &ParsedID{Components: []string{"cute", "guinea-pig"}, Suffix: stringPointer("042"), Separator: "-", SuffixKind: 1},
+			empty:   &ParsedID{},
+			hex:     "0a04637574650a0a6775696e65612d70696712033034321a012d2001",
+		},
+		{
+			name:    "parsed ID with an empty suffix",
+			message: &ParsedID{Components: []string{"cute"}, Suffix: stringPointer(""), SuffixKind: 6},
+			empty:   &ParsedID{},
+			hex:     "0a046375746512002006",
+		},
+		{
+			name:    "parsed ID without a suffix",
+			message: &ParsedID{Components: []string{"large", "fox", "swim"}, Separator: "-"},
+			empty:   &ParsedID{},
+			hex:     "0a056c617267650a03666f780a047377696d1a012d",
+		},
+		{
+			name: "collision analysis",
+			message: &CollisionAnalysis{
+				TotalCombinations: 5637600,
+				Scenarios: []CollisionScenario{
+					{IDs: 100, Probability: 0.000878, Percentage: "0.09%", ExpectedCollisions: 0.000878},
+					{IDs: 1 << 40, Probability: 1, Percentage: "100%", ExpectedCollisions: 1.5e17},
+				},
+				Threshold: 0.01,
+				Cutoff:    336,
+				Live:      &LiveCollisionRisk{EstimatedIssued: 42, CollisionProbability: 0.0002, NextCollisionProbability: math.Copysign(0, -1)},
+				Warnings:  []string{"suffix is time-derived", "ünïcode"},
+				Saturated: true,
+			},
+			empty: &CollisionAnalysis{},
+			hex: "08e08bd802121b0864115dfa97a432c54c3f1a05302e303925215dfa97a432c54c3f121f08808080808020110000" +
+				"00000000f03f1a043130302521007862a441a78043197b14ae47e17a843f20d0022a14082a112d431cebe2362a3f19" +
+				"000000000000008032167375666669782069732074696d652d646572697665643209c3bc6ec3af636f64653801",
+		},
+		{
+			name:    "collision analysis with an empty live risk",
+			message: &CollisionAnalysis{Live: &LiveCollisionRisk{}},
+			empty:   &CollisionAnalysis{},
+			hex:     "2a00",
+		},
+	} {
+		t.Run("should match protoc-gen-go for "+golden.name, func(t *testing.T) {
+			assert.Equal(t, golden.hex, hex.EncodeToString(golden.message.Marshal()))
+
+			payload, err := hex.DecodeString(golden.hex)
+			require.NoError(t, err)
+			require.NoError(t, golden.empty.Unmarshal(payload))
+			assert.Equal(t, golden.message, golden.empty)
+		})
+	}
+}
+
+func stringPointer(s string) *string {
+	return &s
+}
@@ -0,0 +1,141 @@
+package memorablepb
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+)
+
+// Protocol buffer wire types
+const (
+	wireVarint  = 0
+	wireFixed64 = 1
+	wireBytes   = 2
+	wireFixed32 = 5
+)
+
+// ErrMalformed is returned when a message can't be decoded
+var ErrMalformed = errors.New("malformed protobuf message")
+
+func appendTag(b []byte, field, wireType int) []byte {
+	return binary.AppendUvarint(b, uint64(field)<<3|uint64(wireType))
+}
+
+func appendVarintField(b []byte, field int, v uint64) []byte {
+	if v == 0 {
+		return b
+	}
+	return binary.AppendUvarint(appendTag(b, field, wireVarint), v)
+}
+
+func appendInt64Field(b []byte, field int, v int64) []byte {
+	return appendVarintField(b, field, uint64(v))
+}
+
+func appendBoolField(b []byte, field int, v bool) []byte {
+	if !v {
+		return b
+	}
+	return appendVarintField(b, field, 1)
+}
+
+func appendDoubleField(b []byte, field int, v float64) []byte {
+	if v == 0 && !math.Signbit(v) {
+		return b
+	}
+	return binary.LittleEndian.AppendUint64(appendTag(b, field, wireFixed64), math.Float64bits(v))
+}
+
+// appendBytesField always writes the field, for repeated and explicit-presence fields
+func appendBytesField(b []byte, field int, v []byte) []byte {
+	b = binary.AppendUvarint(appendTag(b, field, wireBytes), uint64(len(v)))
+	return append(b, v...)
+}
+
+func appendStringField(b []byte, field int, v string) []byte {
+	if v == "" {
+		return b
+	}
+	return appendBytesField(b, field, []byte(v))
+}
+
+// decoder reads the fields of one message
+type decoder struct {
+	b []byte
+}
+
+// next returns the next field number and wire type, or false at the end
+func (d *decoder) next() (int, int, bool, error) {
+	if len(d.b) == 0 {
+		return 0, 0, false, nil
+	}
+	tag, n := binary.Uvarint(d.b)
+	if n <= 0 || tag>>3 == 0 || tag>>3 > math.MaxInt32 {
+		return 0, 0, false, fmt.Errorf("%w: invalid tag", ErrMalformed)
+	}
+	d.b = d.b[n:]
+	return int(tag >> 3), int(tag & 7), true, nil
+}
+
+func (d *decoder) varint(wireType int) (uint64, error) {
+	if wireType != wireVarint {
+		return 0, fmt.Errorf("%w: expected varint, got wire type %d", ErrMalformed, wireType)
+	}
+	v, n := binary.Uvarint(d.b)
+	if n <= 0 {
+		return 0, fmt.Errorf("%w: invalid varint", ErrMalformed)
+	}
+	d.b = d.b[n:]
+	return v, nil
+}
+
+func (d *decoder) double(wireType int) (float64, error) {
+	if wireType != wireFixed64 || len(d.b) < 8 {
+		return 0, fmt.Errorf("%w: invalid double", ErrMalformed)
+	}
+	v := math.Float64frombits(binary.LittleEndian.Uint64(d.b))
+	d.b = d.b[8:]
+	return v, nil
+}
+
+func (d *decoder) bytes(wireType int) ([]byte, error) {
+	if wireType != wireBytes {
+		return nil, fmt.Errorf("%w: expected length-delimited field, got wire type %d", ErrMalformed, wireType)
+	}
+	length, n := binary.Uvarint(d.b)
+	if n <= 0 || length > uint64(len(d.b)-n) {
+		return nil, fmt.Errorf("%w: invalid length", ErrMalformed)
+	}
+	v := d.b[n : n+int(length)]
+	d.b = d.b[n+int(length):]
+	return v, nil
+}
+
+func (d *decoder) string(wireType int) (string, error) {
+	v, err := d.bytes(wireType)
+	return string(v), err
+}
+
+// skip discards a field unknown to this version of the schema
+func (d *decoder) skip(wireType int) error {
+	switch wireType {
+	case wireVarint:
+		_, err := d.varint(wireType)
+		return err
+	case wireFixed64:
+		_, err := d.double(wireType)
+		return err
+	case wireBytes:
+		_, err := d.bytes(wireType)
+		return err
+	case wireFixed32:
+		if len(d.b) < 4 {
+			return fmt.Errorf("%w: invalid fixed32", ErrMalformed)
+		}
+		d.b = d.b[4:]
+		return nil
+	default:
+		return fmt.Errorf("%w: unsupported wire type %d", ErrMalformed, wireType)
+	}
+}
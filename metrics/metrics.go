@@ -0,0 +1,352 @@
+// Package metrics instruments memorable ID generation and registers the
+// measurements into the metrics registry of the service.
+//
+// The package depends on the standard library only, so using the
+// generator as shared infrastructure doesn't pull a metrics client into
+// every consumer. Register adds the metrics to any registry through the
+// small Registerer interface; a Prometheus registry is adapted like this:
+//
+//	type promRegisterer struct{ prometheus.Registerer }
+//
+//	func (r promRegisterer) CounterFunc(name, help string, value func() float64) error {
+//	  return r.Register(prometheus.NewCounterFunc(prometheus.CounterOpts{Name: name, Help: help}, value))
+//	}
+//
+//	func (r promRegisterer) GaugeFunc(name, help string, value func() float64) error {
+//	  return r.Register(prometheus.NewGaugeFunc(prometheus.GaugeOpts{Name: name, Help: help}, value))
+//	}
+//
+//	func (r promRegisterer) HistogramFunc(name, help string, value func() metrics.HistogramSnapshot) error {
+//	  return r.Register(histogramCollector{prometheus.NewDesc(name, help, nil, nil), value})
+//	}
+//
+//	// histogramCollector implements prometheus.Collector
+//	type histogramCollector struct {
+//	  desc  *prometheus.Desc
+//	  value func() metrics.HistogramSnapshot
+//	}
+//
+//	func (c histogramCollector) Describe(ch chan<- *prometheus.Desc) { ch <- c.desc }
+//
+//	func (c histogramCollector) Collect(ch chan<- prometheus.Metric) {
+//	  s := c.value()
+//	  ch <- prometheus.MustNewConstHistogram(c.desc, s.Count, s.Sum, s.Buckets)
+//	}
+//
+// Then instrument the generator and register the metrics:
+//
+//	m := metrics.New("")
+//	registry := &memorable.Namespaces{
+//	  NewStore: func(string) memorable.Store { return m.Store(memorable.NewMemoryStore()) },
+//	}
+//	generate := m.Instrument(func() (string, error) { return registry.Generate("acme") })
+//	m.Register(promRegisterer{prometheus.DefaultRegisterer})
+//
+// Services without a metrics client can serve a Metrics value, which
+// writes the Prometheus text exposition format, on their scrape path
+// instead: http.Handle("/metrics", m).
+package metrics
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	memorable "github.com/riipandi/memorable-ids"
+)
+
+// DefaultPrefix is the metric name prefix used when New is given none
+const DefaultPrefix = "memorable_ids"
+
+// DefaultLatencyBuckets are the store latency histogram bounds in seconds
+var DefaultLatencyBuckets = []float64{0.0005, 0.001, 0.0025, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1}
+
+// Metrics collects counters, a store latency histogram and a pool depth
+// gauge. Metrics is safe for concurrent use.
+type Metrics struct {
+	prefix string
+
+	generated        atomic.Uint64
+	generateFailures atomic.Uint64
+	reservations     atomic.Uint64
+	retries          atomic.Uint64
+	storeErrors      atomic.Uint64
+
+	latency *histogram
+
+	mu        sync.Mutex
+	poolDepth func() int
+}
+
+// Registerer receives the metrics of a Metrics value, see Register. Its
+// methods match the callback-based constructors of metrics clients; the
+// value functions are called at every scrape.
+type Registerer interface {
+	// CounterFunc registers a monotonically increasing value
+	CounterFunc(name, help string, value func() float64) error
+	// GaugeFunc registers a value that can go up and down
+	GaugeFunc(name, help string, value func() float64) error
+	// HistogramFunc registers a histogram
+	HistogramFunc(name, help string, value func() HistogramSnapshot) error
+}
+
+// HistogramSnapshot is the state of a histogram at a scrape, in the form
+// taken by prometheus.MustNewConstHistogram
+type HistogramSnapshot struct {
+	Count uint64
+	Sum   float64
+	// Buckets maps every upper bound to the cumulative count of observations
+	Buckets map[float64]uint64
+}
+
+// New creates a Metrics value whose metric names start with prefix (default: DefaultPrefix)
+func New(prefix string) *Metrics {
+	if prefix == "" {
+		prefix = DefaultPrefix
+	}
+	return &Metrics{prefix: prefix, latency: newHistogram(DefaultLatencyBuckets)}
+}
+
+// Instrument wraps a generation function, counting generated IDs and failures
+//
+// Example:
+//
+//	gen := memorable.NewGenerator(memorable.Config{})
+//	generate := m.Instrument(gen.Generate)
+//	generate() // "cute-rabbit", counted in memorable_ids_generated_total
+func (m *Metrics) Instrument(generate func() (string, error)) func() (string, error) {
+	return func() (string, error) {
+		id, err := generate()
+		if err != nil {
+			m.generateFailures.Add(1)
+		} else {
+			m.generated.Add(1)
+		}
+		return id, err
+	}
+}
+
+// Register registers every metric with r, so they are exposed by the
+// service's registry. The pool depth gauge reports 0 until SetPoolDepth
+// is called.
+func (m *Metrics) Register(r Registerer) error {
+	for _, c := range m.counters() {
+		value := c.value
+		if err := r.CounterFunc(c.name, c.help, func() float64 { return float64(value.Load()) }); err != nil {
+			return fmt.Errorf("metrics: registering %s: %w", c.name, err)
+		}
+	}
+	name := m.prefix + "_store_latency_seconds"
+	if err := r.HistogramFunc(name, latencyHelp, m.latency.snapshot); err != nil {
+		return fmt.Errorf("metrics: registering %s: %w", name, err)
+	}
+	name = m.prefix + "_pool_depth"
+	if err := r.GaugeFunc(name, poolDepthHelp, m.sampleDepth); err != nil {
+		return fmt.Errorf("metrics: registering %s: %w", name, err)
+	}
+	return nil
+}
+
+// Store wraps a uniqueness store, recording reservation latency, retries
+// caused by collisions, and store errors. Stores implementing
+// memorable.Lister or memorable.PrefixLister stay listable.
+func (m *Metrics) Store(store memorable.Store) memorable.Store {
+	instrumented := &instrumentedStore{store: store, metrics: m}
+	if lister, ok := store.(memorable.PrefixLister); ok {
+		return &instrumentedPrefixLister{instrumentedLister{instrumentedStore: instrumented, lister: lister}, lister}
+	}
+	if lister, ok := store.(memorable.Lister); ok {
+		return &instrumentedLister{instrumentedStore: instrumented, lister: lister}
+	}
+	return instrumented
+}
+
+// SetPoolDepth registers a function reporting the number of pre-generated
+// IDs waiting in a pool, sampled at every scrape
+func (m *Metrics) SetPoolDepth(depth func() int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.poolDepth = depth
+}
+
+// instrumentedStore is the Store returned by Metrics.Store
+type instrumentedStore struct {
+	store   memorable.Store
+	metrics *Metrics
+}
+
+// Reserve implements memorable.Store
+func (s *instrumentedStore) Reserve(id string) (bool, error) {
+	start := time.Now()
+	reserved, err := s.store.Reserve(id)
+	s.metrics.latency.observe(time.Since(start).Seconds())
+
+	switch {
+	case err != nil:
+		s.metrics.storeErrors.Add(1)
+	case reserved:
+		s.metrics.reservations.Add(1)
+	default:
+		s.metrics.retries.Add(1)
+	}
+	return reserved, err
+}
+
+// instrumentedLister is the Store returned by Metrics.Store for listable stores
+type instrumentedLister struct {
+	*instrumentedStore
+	lister memorable.Lister
+}
+
+// List implements memorable.Lister
+func (s *instrumentedLister) List() ([]string, error) {
+	return s.lister.List()
+}
+
+// instrumentedPrefixLister is the Store returned by Metrics.Store for
+// stores implementing memorable.PrefixLister
+type instrumentedPrefixLister struct {
+	instrumentedLister
+	prefixLister memorable.PrefixLister
+}
+
+// ListPrefix implements memorable.PrefixLister
+func (s *instrumentedPrefixLister) ListPrefix(prefix string) ([]string, error) {
+	return s.prefixLister.ListPrefix(prefix)
+}
+
+// Help texts of the metrics without an entry in counters
+const (
+	latencyHelp   = "Uniqueness store reservation latency."
+	poolDepthHelp = "Pre-generated IDs waiting in the pool."
+)
+
+// counter is a counter metric of a Metrics value
+type counter struct {
+	name, help string
+	value      *atomic.Uint64
+}
+
+// counters lists the counter metrics with their full names
+func (m *Metrics) counters() []counter {
+	return []counter{
+		{m.prefix + "_generated_total", "Memorable IDs generated.", &m.generated},
+		{m.prefix + "_generate_failures_total", "Memorable ID generations that failed.", &m.generateFailures},
+		{m.prefix + "_reservations_total", "IDs reserved in a uniqueness store.", &m.reservations},
+		{m.prefix + "_retries_total", "Generation retries caused by IDs already taken.", &m.retries},
+		{m.prefix + "_store_errors_total", "Uniqueness store reservations that failed.", &m.storeErrors},
+	}
+}
+
+// sampleDepth returns the current pool depth, or 0 without SetPoolDepth
+func (m *Metrics) sampleDepth() float64 {
+	m.mu.Lock()
+	depth := m.poolDepth
+	m.mu.Unlock()
+	if depth == nil {
+		return 0
+	}
+	return float64(depth())
+}
+
+// WriteTo writes all metrics in the Prometheus text exposition format
+func (m *Metrics) WriteTo(w io.Writer) (int64, error) {
+	buffered := bufio.NewWriter(w)
+	out := &countingWriter{w: buffered}
+
+	for _, c := range m.counters() {
+		fmt.Fprintf(out, "# HELP %s %s\n# TYPE %s counter\n%s %d\n", c.name, c.help, c.name, c.name, c.value.Load())
+	}
+	m.latency.write(out, m.prefix+"_store_latency_seconds", latencyHelp)
+
+	m.mu.Lock()
+	depth := m.poolDepth
+	m.mu.Unlock()
+	if depth != nil {
+		name := m.prefix + "_pool_depth"
+		fmt.Fprintf(out, "# HELP %s %s\n# TYPE %s gauge\n%s %d\n", name, poolDepthHelp, name, name, depth())
+	}
+
+	if err := buffered.Flush(); err != nil {
+		return out.n, err
+	}
+	return out.n, out.err
+}
+
+// ServeHTTP implements http.Handler, serving the metrics to Prometheus scrapers
+func (m *Metrics) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	m.WriteTo(w)
+}
+
+// histogram is a cumulative Prometheus-style histogram
+type histogram struct {
+	mu     sync.Mutex
+	bounds []float64
+	counts []uint64
+	count  uint64
+	sum    float64
+}
+
+func newHistogram(bounds []float64) *histogram {
+	return &histogram{bounds: bounds, counts: make([]uint64, len(bounds))}
+}
+
+func (h *histogram) observe(value float64) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for i, bound := range h.bounds {
+		if value <= bound {
+			h.counts[i]++
+		}
+	}
+	h.count++
+	h.sum += value
+}
+
+// snapshot returns the current state of the histogram
+func (h *histogram) snapshot() HistogramSnapshot {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	buckets := make(map[float64]uint64, len(h.bounds))
+	for i, bound := range h.bounds {
+		buckets[bound] = h.counts[i]
+	}
+	return HistogramSnapshot{Count: h.count, Sum: h.sum, Buckets: buckets}
+}
+
+func (h *histogram) write(w io.Writer, name, help string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s histogram\n", name, help, name)
+	for i, bound := range h.bounds {
+		fmt.Fprintf(w, "%s_bucket{le=\"%s\"} %d\n", name, strconv.FormatFloat(bound, 'g', -1, 64), h.counts[i])
+	}
+	fmt.Fprintf(w, "%s_bucket{le=\"+Inf\"} %d\n", name, h.count)
+	fmt.Fprintf(w, "%s_sum %s\n%s_count %d\n", name, strconv.FormatFloat(h.sum, 'g', -1, 64), name, h.count)
+}
+
+// countingWriter counts bytes written and remembers the first error
+type countingWriter struct {
+	w   io.Writer
+	n   int64
+	err error
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	if c.err != nil {
+		return 0, c.err
+	}
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	c.err = err
+	return n, err
+}
@@ -0,0 +1,194 @@
+package metrics
+
+import (
+	"errors"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	memorable "github.com/riipandi/memorable-ids"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// failingStore is a Store whose reservations always fail
+type failingStore struct{}
+
+func (failingStore) Reserve(string) (bool, error) { return false, errors.New("unavailable") }
+
+// listOnlyStore hides the ListPrefix method of a MemoryStore
+type listOnlyStore struct{ store *memorable.MemoryStore }
+
+func (s listOnlyStore) Reserve(id string) (bool, error) { return s.store.Reserve(id) }
+func (s listOnlyStore) List() ([]string, error)         { return s.store.List() }
+
+// failingRegisterer is a Registerer rejecting every metric
+type failingRegisterer struct{}
+
+func (failingRegisterer) CounterFunc(string, string, func() float64) error {
+	return errors.New("duplicate")
+}
+
+func (failingRegisterer) GaugeFunc(string, string, func() float64) error {
+	return errors.New("duplicate")
+}
+
+func (failingRegisterer) HistogramFunc(string, string, func() HistogramSnapshot) error {
+	return errors.New("duplicate")
+}
+
+// recordingRegisterer is a Registerer keeping the registered value functions
+type recordingRegisterer struct {
+	values     map[string]func() float64
+	histograms map[string]func() HistogramSnapshot
+}
+
+func newRecordingRegisterer() *recordingRegisterer {
+	return &recordingRegisterer{values: map[string]func() float64{}, histograms: map[string]func() HistogramSnapshot{}}
+}
+
+func (r *recordingRegisterer) CounterFunc(name, _ string, value func() float64) error {
+	r.values[name] = value
+	return nil
+}
+
+func (r *recordingRegisterer) GaugeFunc(name, _ string, value func() float64) error {
+	r.values[name] = value
+	return nil
+}
+
+func (r *recordingRegisterer) HistogramFunc(name, _ string, value func() HistogramSnapshot) error {
+	r.histograms[name] = value
+	return nil
+}
+
+func TestMetrics(t *testing.T) {
+	t.Run("should count generated IDs and failures", func(t *testing.T) {
+		m := New("")
+		ok := m.Instrument(func() (string, error) { return "cute-rabbit", nil })
+		fail := m.Instrument(func() (string, error) { return "", errors.New("invalid") })
+
+		ok()
+		ok()
+		fail()
+
+		var out strings.Builder
+		_, err := m.WriteTo(&out)
+		require.NoError(t, err)
+		assert.Contains(t, out.String(), "memorable_ids_generated_total 2\n")
+		assert.Contains(t, out.String(), "memorable_ids_generate_failures_total 1\n")
+	})
+
+	t.Run("should record store reservations, retries and errors", func(t *testing.T) {
+		m := New("naming")
+		store := m.Store(memorable.NewMemoryStore())
+
+		store.Reserve("cute-rabbit")
+		store.Reserve("cute-rabbit")
+		m.Store(failingStore{}).Reserve("large-fox")
+
+		var out strings.Builder
+		m.WriteTo(&out)
+		text := out.String()
+		assert.Contains(t, text, "naming_reservations_total 1\n")
+		assert.Contains(t, text, "naming_retries_total 1\n")
+		assert.Contains(t, text, "naming_store_errors_total 1\n")
+		assert.Contains(t, text, "# TYPE naming_store_latency_seconds histogram\n")
+		assert.Contains(t, text, "naming_store_latency_seconds_bucket{le=\"+Inf\"} 3\n")
+		assert.Contains(t, text, "naming_store_latency_seconds_count 3\n")
+	})
+
+	t.Run("should count retries of namespaced generation", func(t *testing.T) {
+		m := New("")
+		registry := &memorable.Namespaces{
+			Default:  memorable.NamespaceProfile{Options: memorable.GenerateOptions{Components: 3}},
+			NewStore: func(string) memorable.Store { return m.Store(memorable.NewMemoryStore()) },
+		}
+		generate := m.Instrument(func() (string, error) { return registry.Generate("acme") })
+
+		for range 10 {
+			_, err := generate()
+			require.NoError(t, err)
+		}
+
+		var out strings.Builder
+		m.WriteTo(&out)
+		assert.Contains(t, out.String(), "memorable_ids_generated_total 10\n")
+		assert.Contains(t, out.String(), "memorable_ids_reservations_total 10\n")
+	})
+
+	t.Run("should sample the pool depth at every scrape", func(t *testing.T) {
+		m := New("")
+		depth := 5
+		m.SetPoolDepth(func() int { return depth })
+
+		var out strings.Builder
+		m.WriteTo(&out)
+		assert.Contains(t, out.String(), "memorable_ids_pool_depth 5\n")
+
+		depth = 3
+		out.Reset()
+		m.WriteTo(&out)
+		assert.Contains(t, out.String(), "memorable_ids_pool_depth 3\n")
+	})
+
+	t.Run("should serve the text exposition format", func(t *testing.T) {
+		m := New("")
+		recorder := httptest.NewRecorder()
+		m.ServeHTTP(recorder, httptest.NewRequest("GET", "/metrics", nil))
+
+		assert.Equal(t, 200, recorder.Code)
+		assert.Contains(t, recorder.Header().Get("Content-Type"), "version=0.0.4")
+		assert.Contains(t, recorder.Body.String(), "# TYPE memorable_ids_generated_total counter\n")
+	})
+
+	t.Run("should register the metrics with a registerer", func(t *testing.T) {
+		m := New("")
+		r := newRecordingRegisterer()
+		require.NoError(t, m.Register(r))
+
+		assert.Len(t, r.values, 6)
+		assert.Equal(t, 0.0, r.values["memorable_ids_pool_depth"]())
+
+		m.Instrument(func() (string, error) { return "cute-rabbit", nil })()
+		m.Store(memorable.NewMemoryStore()).Reserve("cute-rabbit")
+		m.SetPoolDepth(func() int { return 4 })
+
+		assert.Equal(t, 1.0, r.values["memorable_ids_generated_total"]())
+		assert.Equal(t, 1.0, r.values["memorable_ids_reservations_total"]())
+		assert.Equal(t, 4.0, r.values["memorable_ids_pool_depth"]())
+		latency := r.histograms["memorable_ids_store_latency_seconds"]()
+		assert.Equal(t, uint64(1), latency.Count)
+		assert.NotEmpty(t, latency.Buckets)
+	})
+
+	t.Run("should report registration errors", func(t *testing.T) {
+		m := New("")
+		err := m.Register(failingRegisterer{})
+		assert.ErrorContains(t, err, "memorable_ids_generated_total")
+	})
+
+	t.Run("should keep instrumented stores listable", func(t *testing.T) {
+		m := New("")
+		store := m.Store(memorable.NewMemoryStore())
+		store.Reserve("cute-rabbit")
+
+		lister, ok := store.(memorable.Lister)
+		require.True(t, ok)
+		ids, err := lister.List()
+		require.NoError(t, err)
+		assert.Equal(t, []string{"cute-rabbit"}, ids)
+
+		prefixLister, ok := store.(memorable.PrefixLister)
+		require.True(t, ok)
+		ids, err = prefixLister.ListPrefix("large")
+		require.NoError(t, err)
+		assert.Empty(t, ids)
+
+		_, ok = m.Store(listOnlyStore{memorable.NewMemoryStore()}).(memorable.PrefixLister)
+		assert.False(t, ok)
+
+		_, ok = m.Store(failingStore{}).(memorable.Lister)
+		assert.False(t, ok)
+	})
+}
@@ -0,0 +1,92 @@
+// Package mini is a minimal memorable ID generator for size-constrained
+// binaries such as serverless functions and firmware. It carries a
+// 10-word-per-class dictionary and only generates names: it doesn't import
+// the main package, so none of its dictionaries, analysis helpers or stores
+// are linked in.
+//
+//	mini.Generate(mini.Options{})                          // "cute-rabbit"
+//	mini.Generate(mini.Options{Components: 3, Suffix: true}) // "large-fox-dance-042"
+package mini
+
+import (
+	"errors"
+	"math/rand"
+	"strconv"
+)
+
+// Adjectives contains the minimal adjective collection
+var Adjectives = []string{
+	"cute", "dapper", "large", "small", "long", "short", "thick", "narrow", "deep", "flat",
+}
+
+// Nouns contains the minimal noun collection
+var Nouns = []string{
+	"rabbit", "badger", "fox", "chicken", "bat", "deer", "snake", "hare", "hedgehog", "mouse",
+}
+
+// Verbs contains the minimal verb collection
+var Verbs = []string{
+	"sing", "play", "knit", "dance", "listen", "run", "talk", "cuddle", "sit", "hug",
+}
+
+// Adverbs contains the minimal adverb collection
+var Adverbs = []string{
+	"jovially", "merrily", "cordially", "carefully", "correctly", "eagerly", "easily", "loudly", "quickly", "quietly",
+}
+
+// Prepositions contains the minimal preposition collection
+var Prepositions = []string{
+	"in", "on", "at", "by", "for", "with", "from", "to", "under", "over",
+}
+
+// classes lists the collections in component order
+var classes = [][]string{Adjectives, Nouns, Verbs, Adverbs, Prepositions}
+
+// Options contains configuration options for ID generation
+type Options struct {
+	// Components is the number of word components (1-5, default: 2)
+	Components int
+	// Separator between parts (default: "-")
+	Separator string
+	// Suffix appends a random 3-digit number (default: false)
+	Suffix bool
+}
+
+// Generate creates a memorable ID from the minimal dictionary
+//
+// Example:
+//
+//	Generate(Options{})                            // "cute-rabbit"
+//	Generate(Options{Separator: "_", Suffix: true}) // "deep_hare_042"
+func Generate(options Options) (string, error) {
+	if options.Components == 0 {
+		options.Components = 2
+	}
+	if options.Separator == "" {
+		options.Separator = "-"
+	}
+	if options.Components < 1 || options.Components > len(classes) {
+		return "", errors.New("components must be between 1 and 5")
+	}
+
+	id := make([]byte, 0, 48)
+	for i, words := range classes[:options.Components] {
+		if i > 0 {
+			id = append(id, options.Separator...)
+		}
+		id = append(id, words[rand.Intn(len(words))]...)
+	}
+
+	if options.Suffix {
+		n := rand.Intn(1000)
+		id = append(id, options.Separator...)
+		if n < 100 {
+			id = append(id, '0')
+		}
+		if n < 10 {
+			id = append(id, '0')
+		}
+		id = strconv.AppendInt(id, int64(n), 10)
+	}
+	return string(id), nil
+}
@@ -0,0 +1,53 @@
+package mini
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerate(t *testing.T) {
+	t.Run("should generate 2 components by default", func(t *testing.T) {
+		id, err := Generate(Options{})
+		require.NoError(t, err)
+
+		parts := strings.Split(id, "-")
+		require.Len(t, parts, 2)
+		assert.Contains(t, Adjectives, parts[0])
+		assert.Contains(t, Nouns, parts[1])
+	})
+
+	t.Run("should append a 3-digit suffix", func(t *testing.T) {
+		for range 100 {
+			id, err := Generate(Options{Components: 5, Separator: "_", Suffix: true})
+			require.NoError(t, err)
+
+			parts := strings.Split(id, "_")
+			require.Len(t, parts, 6)
+			for i, words := range classes {
+				assert.Contains(t, words, parts[i])
+			}
+			assert.Regexp(t, `^\d{3}$`, parts[5])
+		}
+	})
+
+	t.Run("should reject invalid component counts", func(t *testing.T) {
+		_, err := Generate(Options{Components: 6})
+		assert.Error(t, err)
+		_, err = Generate(Options{Components: -1})
+		assert.Error(t, err)
+	})
+
+	t.Run("should hold 10 unique words per class", func(t *testing.T) {
+		for _, words := range classes {
+			assert.Len(t, words, 10)
+			seen := make(map[string]bool)
+			for _, word := range words {
+				assert.False(t, seen[word], word)
+				seen[word] = true
+			}
+		}
+	})
+}
@@ -0,0 +1,38 @@
+//go:build unix
+
+package memorable_ids
+
+import (
+	"fmt"
+	"os"
+	"syscall"
+)
+
+// mapFile maps the file at path read-only and returns its contents with
+// the function releasing the mapping. The mapping is private, so it is
+// never written back to the file.
+func mapFile(path string) ([]byte, func() error, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return nil, nil, err
+	}
+	size := info.Size()
+	if size == 0 {
+		return nil, nil, fmt.Errorf("%w: empty file", ErrInvalidPack)
+	}
+	if int64(int(size)) != size {
+		return nil, nil, fmt.Errorf("%w: file too large to map", ErrInvalidPack)
+	}
+
+	data, err := syscall.Mmap(int(file.Fd()), 0, int(size), syscall.PROT_READ, syscall.MAP_PRIVATE)
+	if err != nil {
+		return nil, nil, err
+	}
+	return data, func() error { return syscall.Munmap(data) }, nil
+}
@@ -0,0 +1,148 @@
+package memorable_ids
+
+import (
+	"fmt"
+	"log/slog"
+	"math/rand"
+	"slices"
+	"sync"
+)
+
+// NamespaceProfile is the naming profile of a tenant
+type NamespaceProfile struct {
+	// Options are the generation options of the tenant
+	Options GenerateOptions
+	// Dictionary overrides the built-in dictionary for the tenant (default: nil)
+	Dictionary *Dictionary
+}
+
+// Namespaces is a registry giving every tenant an isolated uniqueness
+// domain and, optionally, its own dictionary and options, so one naming
+// service can serve many tenants without cross-tenant collision coupling.
+// The zero value is ready to use and safe for concurrent use.
+//
+// Example:
+//
+//	registry := &Namespaces{Default: NamespaceProfile{Options: GenerateOptions{Components: 3}}}
+//	registry.Register("acme", NamespaceProfile{Options: GenerateOptions{Suffix: SuffixGenerators.Number}})
+//
+//	registry.Generate("acme")   // "cute-rabbit-042", unique within acme
+//	registry.Generate("globex") // "large-fox-swim", unique within globex
+type Namespaces struct {
+	// Default is the profile of tenants used without registration
+	Default NamespaceProfile
+	// NewStore creates the uniqueness store of a tenant (default: in-memory store)
+	NewStore func(tenant string) Store
+	// MaxAttempts is the number of re-rolls before giving up on a unique ID (default: 100)
+	MaxAttempts int
+	// Logger receives retries and store errors, with a "namespace"
+	// attribute (default: nil, no logging)
+	Logger *slog.Logger
+
+	mu      sync.Mutex
+	tenants map[string]*Namespace
+}
+
+// Namespace is the uniqueness domain of a single tenant
+type Namespace struct {
+	name        string
+	profile     NamespaceProfile
+	store       Store
+	maxAttempts int
+	logger      *slog.Logger
+}
+
+// Register creates the namespace of a tenant with its own profile.
+// Registering a tenant twice is an error.
+func (r *Namespaces) Register(tenant string, profile NamespaceProfile) (*Namespace, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.tenants[tenant]; exists {
+		return nil, fmt.Errorf("namespace %q is already registered", tenant)
+	}
+	loggerOr(r.Logger).Info("memorable ID namespace registered",
+		slog.String("namespace", tenant),
+		slog.Bool("custom_dictionary", profile.Dictionary != nil))
+	return r.create(tenant, profile), nil
+}
+
+// Namespace returns the namespace of a tenant, creating it with the
+// default profile on first use
+func (r *Namespaces) Namespace(tenant string) *Namespace {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if namespace, exists := r.tenants[tenant]; exists {
+		return namespace
+	}
+	return r.create(tenant, r.Default)
+}
+
+// create registers a namespace; the caller must hold r.mu
+func (r *Namespaces) create(tenant string, profile NamespaceProfile) *Namespace {
+	if r.tenants == nil {
+		r.tenants = make(map[string]*Namespace)
+	}
+
+	var store Store
+	if r.NewStore != nil {
+		store = r.NewStore(tenant)
+	} else {
+		store = NewMemoryStore()
+	}
+
+	namespace := &Namespace{
+		name:        tenant,
+		profile:     profile,
+		store:       store,
+		maxAttempts: r.MaxAttempts,
+		logger:      loggerOr(r.Logger).With(slog.String("namespace", tenant)),
+	}
+	r.tenants[tenant] = namespace
+	return namespace
+}
+
+// Generate creates an ID that is unique within the tenant's namespace
+func (r *Namespaces) Generate(tenant string) (string, error) {
+	return r.Namespace(tenant).Generate()
+}
+
+// Tenants returns the names of all namespaces in sorted order
+func (r *Namespaces) Tenants() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	tenants := make([]string, 0, len(r.tenants))
+	for tenant := range r.tenants {
+		tenants = append(tenants, tenant)
+	}
+	slices.Sort(tenants)
+	return tenants
+}
+
+// Name returns the tenant name
+func (n *Namespace) Name() string {
+	return n.name
+}
+
+// Store returns the uniqueness store of the namespace
+func (n *Namespace) Store() Store {
+	return n.store
+}
+
+// Generate creates an ID that is unique within the namespace
+func (n *Namespace) Generate() (string, error) {
+	dict := GetDictionary()
+	if n.profile.Dictionary != nil {
+		dict = *n.profile.Dictionary
+	}
+
+	id, err := reserveUnique(n.logger, n.store, n.maxAttempts, func() (string, error) {
+		return generate(dict, n.profile.Options, rand.Intn)
+	})
+	if err != nil {
+		return "", fmt.Errorf("namespace %q: %w", n.name, err)
+	}
+	return id, nil
+}
@@ -0,0 +1,78 @@
+package memorable_ids
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryStore(t *testing.T) {
+	t.Run("should reserve each ID once", func(t *testing.T) {
+		var store MemoryStore
+
+		reserved, err := store.Reserve("cute-rabbit")
+		require.NoError(t, err)
+		assert.True(t, reserved)
+
+		reserved, err = store.Reserve("cute-rabbit")
+		require.NoError(t, err)
+		assert.False(t, reserved)
+
+		assert.True(t, store.Contains("cute-rabbit"))
+		assert.Equal(t, 1, store.Len())
+
+		_, err = store.Reserve("brave-badger")
+		require.NoError(t, err)
+		ids, err := store.List()
+		require.NoError(t, err)
+		assert.Equal(t, []string{"brave-badger", "cute-rabbit"}, ids)
+	})
+}
+
+func TestNamespaces(t *testing.T) {
+	t.Run("should keep tenants isolated", func(t *testing.T) {
+		dict := NewDictionary([]string{"solo"}, []string{"id"}, nil, nil, nil)
+		registry := &Namespaces{Default: NamespaceProfile{Dictionary: &dict}}
+
+		a, err := registry.Generate("acme")
+		require.NoError(t, err, "Generate should not fail")
+		b, err := registry.Generate("globex")
+		require.NoError(t, err, "Generate should not fail")
+
+		assert.Equal(t, "solo-id", a)
+		assert.Equal(t, a, b, "Expected the same ID to be available in both tenants")
+
+		_, err = registry.Generate("acme")
+		assert.ErrorIs(t, err, ErrSpaceExhausted)
+		assert.ErrorContains(t, err, `namespace "acme"`)
+	})
+
+	t.Run("should apply registered profiles", func(t *testing.T) {
+		registry := &Namespaces{}
+		_, err := registry.Register("acme", NamespaceProfile{Options: GenerateOptions{Components: 3, Separator: "_"}})
+		require.NoError(t, err)
+
+		id, err := registry.Generate("acme")
+		require.NoError(t, err)
+		assert.Len(t, Parse(id, "_").Components, 3)
+
+		_, err = registry.Register("acme", NamespaceProfile{})
+		assert.Error(t, err, "Expected duplicate registration to fail")
+	})
+
+	t.Run("should use the store factory per tenant", func(t *testing.T) {
+		stores := map[string]*MemoryStore{}
+		registry := &Namespaces{NewStore: func(tenant string) Store {
+			stores[tenant] = NewMemoryStore()
+			return stores[tenant]
+		}}
+
+		id, err := registry.Generate("acme")
+		require.NoError(t, err)
+
+		assert.True(t, stores["acme"].Contains(id))
+		assert.Equal(t, []string{"acme"}, registry.Tenants())
+		assert.Same(t, stores["acme"], registry.Namespace("acme").Store())
+	})
+}
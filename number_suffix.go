@@ -0,0 +1,68 @@
+package memorable_ids
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+)
+
+// NumberSuffix is a SuffixProvider drawing numbers uniformly from a
+// custom inclusive range, such as 100-999 to avoid leading zeros or 1-49
+// to match legacy IDs
+//
+// Example:
+//
+//	suffix := NumberSuffix{Min: 100, Max: 999}
+//	Generate(GenerateOptions{SuffixProvider: suffix}) // "cute-rabbit-417"
+//	CalculateCombinations(2, suffix.Range())         // 6,264 * 900
+type NumberSuffix struct {
+	// Min is the smallest suffix value (inclusive)
+	Min int
+	// Max is the largest suffix value (inclusive)
+	Max int
+	// Width zero-pads suffixes to at least this many digits (default: 0, no padding)
+	Width int
+}
+
+// Validate checks that the range is non-empty, non-negative and that its
+// size fits in an int
+func (s NumberSuffix) Validate() error {
+	if s.Min < 0 {
+		return errors.New("number suffix minimum must not be negative")
+	}
+	if s.Max < s.Min {
+		return fmt.Errorf("number suffix maximum %d is below minimum %d", s.Max, s.Min)
+	}
+	if s.Max-s.Min == math.MaxInt {
+		return fmt.Errorf("number suffix range %d-%d has more than %d values", s.Min, s.Max, math.MaxInt)
+	}
+	if s.Width < 0 {
+		return errors.New("number suffix width must not be negative")
+	}
+	return nil
+}
+
+// Range returns the number of distinct suffix values, for combination math
+func (s NumberSuffix) Range() int {
+	return s.Max - s.Min + 1
+}
+
+// Next implements SuffixProvider, drawing a value from the range
+func (s NumberSuffix) Next() (string, error) {
+	if err := s.Validate(); err != nil {
+		return "", err
+	}
+	return formatPadded(s.Min+rand.Intn(s.Range()), s.Width), nil
+}
+
+// Spec implements SuffixProvider
+func (s NumberSuffix) Spec() SuffixSpec {
+	return SuffixSpec{
+		Name:      "number-range",
+		Range:     s.Range(),
+		Charset:   "0123456789",
+		MinLength: len(formatPadded(s.Min, s.Width)),
+		MaxLength: len(formatPadded(s.Max, s.Width)),
+	}
+}
@@ -0,0 +1,59 @@
+package memorable_ids
+
+import (
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNumberSuffix(t *testing.T) {
+	t.Run("should stay within the range", func(t *testing.T) {
+		suffix := NumberSuffix{Min: 1, Max: 49}
+
+		seen := make(map[int]bool)
+		for range 2000 {
+			next, err := suffix.Next()
+			require.NoError(t, err)
+			value, err := strconv.Atoi(next)
+			require.NoError(t, err)
+			assert.GreaterOrEqual(t, value, 1)
+			assert.LessOrEqual(t, value, 49)
+			seen[value] = true
+		}
+		assert.Len(t, seen, 49, "Expected every value to be drawn")
+	})
+
+	t.Run("should avoid or add leading zeros", func(t *testing.T) {
+		suffix, err := NumberSuffix{Min: 100, Max: 999}.Next()
+		require.NoError(t, err)
+		assert.Regexp(t, `^[1-9]\d\d$`, suffix)
+
+		padded, err := NumberSuffix{Min: 0, Max: 9, Width: 3}.Next()
+		require.NoError(t, err)
+		assert.Regexp(t, `^00\d$`, padded)
+	})
+
+	t.Run("should use the actual range size in combination math", func(t *testing.T) {
+		suffix := NumberSuffix{Min: 100, Max: 999}
+		assert.Equal(t, 900, suffix.Range())
+		assert.Equal(t, CalculateCombinations(2, 1)*900, CalculateCombinations(2, suffix.Range()))
+	})
+
+	t.Run("should reject invalid ranges", func(t *testing.T) {
+		_, err := NumberSuffix{Min: 10, Max: 1}.Next()
+		assert.Error(t, err)
+		_, err = NumberSuffix{Min: -1, Max: 1}.Next()
+		assert.Error(t, err)
+		_, err = NumberSuffix{Min: 0, Max: math.MaxInt}.Next()
+		assert.ErrorContains(t, err, "more than")
+
+		_, err = NumberSuffix{Min: 1, Max: math.MaxInt}.Next()
+		assert.NoError(t, err)
+
+		err = GenerateOptions{SuffixProvider: NumberSuffix{Min: 10, Max: 1}}.Validate()
+		assert.ErrorIs(t, err, ErrInvalidOptions)
+	})
+}
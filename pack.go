@@ -0,0 +1,324 @@
+package memorable_ids
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"unsafe"
+)
+
+/**
+ * Binary dictionary packs
+ *
+ * A .mdict pack stores a dictionary in a compact binary form that loads
+ * without parsing text line by line, and carries a checksum so truncated
+ * or corrupted files are detected before any word is used. All integers
+ * are little-endian:
+ *
+ *	magic    [4]byte  "MDCT"
+ *	version  uint16   packVersion
+ *	classes  uint16   number of class entries (6)
+ *	entries  classes × {offset, count, size uint32}, relative to the data
+ *	data     per class, count words as uvarint length + UTF-8 bytes
+ *	checksum uint32   CRC-32C of every preceding byte
+ *
+ * Class entries are in WordClass order; readers ignore unknown extra
+ * classes so the format can grow, and read packs of the five positional
+ * classes only, written before the color class, with no colors.
+ *
+ * Signed packs append an Ed25519 signature over every preceding byte and
+ * the trailer magic "MSIG". LoadPack accepts them without verification;
+ * LoadVerifiedPack requires a valid signature from the given key.
+ *
+ * MapPack memory-maps a pack instead of reading it: words point into the
+ * mapping and the membership index is built on first lookup, so opening
+ * a dictionary of 100k+ words costs one checksum pass and one string
+ * header per word. See mapped_pack.go.
+ */
+
+// packMagic starts every dictionary pack
+const packMagic = "MDCT"
+
+// packVersion is the pack format version written by WritePack
+const packVersion = 1
+
+// packHeaderSize is the size of the fixed header before the class entries
+const packHeaderSize = 8
+
+// packEntrySize is the size of one class entry
+const packEntrySize = 12
+
+// packSignatureMagic ends every signed pack
+const packSignatureMagic = "MSIG"
+
+// ErrInvalidPack is returned when a dictionary pack is malformed
+var ErrInvalidPack = errors.New("invalid dictionary pack")
+
+// ErrPackChecksum is returned when a dictionary pack fails its checksum
+var ErrPackChecksum = errors.New("dictionary pack checksum mismatch")
+
+// ErrPackSignature is returned when a dictionary pack is unsigned or its
+// signature doesn't verify against the trusted key
+var ErrPackSignature = errors.New("dictionary pack signature invalid")
+
+var packTable = crc32.MakeTable(crc32.Castagnoli)
+
+// encodePack returns the binary pack of d
+func encodePack(d Dictionary) ([]byte, error) {
+	var data []byte
+	entries := make([]byte, 0, len(allWordClasses)*packEntrySize)
+	for _, class := range allWordClasses {
+		words := d.Words(class)
+		offset := len(data)
+		for _, word := range words {
+			if word == "" {
+				return nil, fmt.Errorf("%w: empty %s", ErrInvalidPack, class)
+			}
+			data = binary.AppendUvarint(data, uint64(len(word)))
+			data = append(data, word...)
+		}
+		entries = binary.LittleEndian.AppendUint32(entries, uint32(offset))
+		entries = binary.LittleEndian.AppendUint32(entries, uint32(len(words)))
+		entries = binary.LittleEndian.AppendUint32(entries, uint32(len(data)-offset))
+	}
+
+	pack := make([]byte, 0, packHeaderSize+len(entries)+len(data)+4)
+	pack = append(pack, packMagic...)
+	pack = binary.LittleEndian.AppendUint16(pack, packVersion)
+	pack = binary.LittleEndian.AppendUint16(pack, uint16(len(allWordClasses)))
+	pack = append(pack, entries...)
+	pack = append(pack, data...)
+	return binary.LittleEndian.AppendUint32(pack, crc32.Checksum(pack, packTable)), nil
+}
+
+// splitSignature separates the signature of a signed pack from the pack
+func splitSignature(file []byte) (pack, signature []byte) {
+	trailer := ed25519.SignatureSize + len(packSignatureMagic)
+	if len(file) < trailer || string(file[len(file)-len(packSignatureMagic):]) != packSignatureMagic {
+		return file, nil
+	}
+	return file[:len(file)-trailer], file[len(file)-trailer : len(file)-len(packSignatureMagic)]
+}
+
+// decodePack parses a binary pack, signed or not. Words share a single
+// string allocation.
+func decodePack(pack []byte) (Dictionary, error) {
+	return decodePackWords(pack, false)
+}
+
+// decodePackWords parses a binary pack. Mapped packs are referenced by
+// the words instead of copied, and indexed lazily.
+func decodePackWords(pack []byte, mapped bool) (Dictionary, error) {
+	pack, _ = splitSignature(pack)
+	if len(pack) < packHeaderSize+4 || string(pack[:4]) != packMagic {
+		return Dictionary{}, fmt.Errorf("%w: not a dictionary pack", ErrInvalidPack)
+	}
+	body, sum := pack[:len(pack)-4], binary.LittleEndian.Uint32(pack[len(pack)-4:])
+	if crc32.Checksum(body, packTable) != sum {
+		return Dictionary{}, ErrPackChecksum
+	}
+	if version := binary.LittleEndian.Uint16(body[4:]); version != packVersion {
+		return Dictionary{}, fmt.Errorf("%w: unsupported version %d", ErrInvalidPack, version)
+	}
+
+	classCount := int(binary.LittleEndian.Uint16(body[6:]))
+	dataStart := packHeaderSize + classCount*packEntrySize
+	if classCount < len(wordClasses) || dataStart > len(body) {
+		return Dictionary{}, fmt.Errorf("%w: truncated class table", ErrInvalidPack)
+	}
+	data := body[dataStart:]
+	var text string
+	if mapped && len(data) > 0 {
+		text = unsafe.String(&data[0], len(data))
+	} else {
+		text = string(data)
+	}
+
+	// Packs written before the color class have only the five positional classes
+	var classes [6][]string
+	for i := range min(classCount, len(classes)) {
+		entry := body[packHeaderSize+i*packEntrySize:]
+		offset := int(binary.LittleEndian.Uint32(entry))
+		count := int(binary.LittleEndian.Uint32(entry[4:]))
+		size := int(binary.LittleEndian.Uint32(entry[8:]))
+		if offset > len(data) || size > len(data)-offset || count > size {
+			return Dictionary{}, fmt.Errorf("%w: %s out of bounds", ErrInvalidPack, allWordClasses[i])
+		}
+
+		words := make([]string, 0, count)
+		position, end := offset, offset+size
+		for range count {
+			length, n := binary.Uvarint(data[position:end])
+			if n <= 0 || length == 0 || length > uint64(end-position-n) {
+				return Dictionary{}, fmt.Errorf("%w: malformed %s word", ErrInvalidPack, allWordClasses[i])
+			}
+			position += n
+			words = append(words, text[position:position+int(length)])
+			position += int(length)
+		}
+		if position != end {
+			return Dictionary{}, fmt.Errorf("%w: trailing %s data", ErrInvalidPack, allWordClasses[i])
+		}
+		classes[i] = words
+	}
+
+	dict := Dictionary{
+		Adjectives:   classes[ClassAdjective],
+		Nouns:        classes[ClassNoun],
+		Verbs:        classes[ClassVerb],
+		Adverbs:      classes[ClassAdverb],
+		Prepositions: classes[ClassPreposition],
+	}
+	dict.Stats = statsOf(dict)
+	if len(classes[ClassColor]) > 0 {
+		dict.Colors = classes[ClassColor]
+	}
+	if mapped {
+		dict.index = lazyIndex(dict)
+	} else {
+		dict.index = buildIndex(dict)
+	}
+	return dict, nil
+}
+
+// WritePack writes d as a binary .mdict dictionary pack
+func WritePack(w io.Writer, d Dictionary) error {
+	pack, err := encodePack(d)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(pack)
+	return err
+}
+
+// ReadPack reads a binary .mdict dictionary pack, verifying its checksum.
+// Malformed packs fail with ErrInvalidPack, corrupted ones with ErrPackChecksum.
+func ReadPack(r io.Reader) (Dictionary, error) {
+	var buf bytes.Buffer
+	if _, err := buf.ReadFrom(r); err != nil {
+		return Dictionary{}, err
+	}
+	return decodePack(buf.Bytes())
+}
+
+// WriteSignedPack writes d as a .mdict pack signed with key
+func WriteSignedPack(w io.Writer, d Dictionary, key ed25519.PrivateKey) error {
+	pack, err := encodeSignedPack(d, key)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(pack)
+	return err
+}
+
+// ReadVerifiedPack reads a signed .mdict pack, failing with
+// ErrPackSignature unless it was signed by the private key of trusted
+func ReadVerifiedPack(trusted ed25519.PublicKey, r io.Reader) (Dictionary, error) {
+	var buf bytes.Buffer
+	if _, err := buf.ReadFrom(r); err != nil {
+		return Dictionary{}, err
+	}
+	return decodeVerifiedPack(trusted, buf.Bytes())
+}
+
+// encodeSignedPack returns the pack of d followed by its signature trailer
+func encodeSignedPack(d Dictionary, key ed25519.PrivateKey) ([]byte, error) {
+	if len(key) != ed25519.PrivateKeySize {
+		return nil, fmt.Errorf("%w: invalid private key", ErrPackSignature)
+	}
+	pack, err := encodePack(d)
+	if err != nil {
+		return nil, err
+	}
+	pack = append(pack, ed25519.Sign(key, pack)...)
+	return append(pack, packSignatureMagic...), nil
+}
+
+// decodeVerifiedPack verifies the signature of a signed pack before parsing it
+func decodeVerifiedPack(trusted ed25519.PublicKey, file []byte) (Dictionary, error) {
+	if len(trusted) != ed25519.PublicKeySize {
+		return Dictionary{}, fmt.Errorf("%w: invalid public key", ErrPackSignature)
+	}
+	pack, signature := splitSignature(file)
+	if signature == nil {
+		return Dictionary{}, fmt.Errorf("%w: pack is not signed", ErrPackSignature)
+	}
+	if !ed25519.Verify(trusted, pack, signature) {
+		return Dictionary{}, ErrPackSignature
+	}
+	return decodePack(pack)
+}
+
+// SavePack writes d to the .mdict pack file at path
+//
+// Example:
+//
+//	dict, _ := ReadDictionary(words) // text dictionary, see ReadDictionary
+//	SavePack("fantasy.mdict", dict)
+func SavePack(path string, d Dictionary) error {
+	pack, err := encodePack(d)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, pack, 0o644)
+}
+
+// LoadPack reads the .mdict pack file at path, verifying its checksum
+//
+// Example:
+//
+//	dict, err := LoadPack("fantasy.mdict")
+//	RegisterTheme("fantasy", dict)
+//	GenerateThemed("fantasy", GenerateOptions{}) // "ancient-dragon"
+func LoadPack(path string) (Dictionary, error) {
+	pack, err := os.ReadFile(path)
+	if err != nil {
+		return Dictionary{}, err
+	}
+	dict, err := decodePack(pack)
+	if err != nil {
+		return Dictionary{}, fmt.Errorf("%s: %w", path, err)
+	}
+	return dict, nil
+}
+
+// SaveSignedPack writes d to the .mdict pack file at path, signed with key
+//
+// Example:
+//
+//	_, key, _ := ed25519.GenerateKey(nil) // kept by the publishing team
+//	SaveSignedPack("approved.mdict", dict, key)
+func SaveSignedPack(path string, d Dictionary, key ed25519.PrivateKey) error {
+	pack, err := encodeSignedPack(d, key)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, pack, 0o644)
+}
+
+// LoadVerifiedPack reads the signed .mdict pack file at path, refusing
+// unsigned packs and packs not signed by the private key of trusted
+// with ErrPackSignature
+//
+// Example:
+//
+//	dict, err := LoadVerifiedPack(approvedKey, "approved.mdict")
+//	if errors.Is(err, ErrPackSignature) {
+//	  log.Fatal("refusing unapproved vocabulary")
+//	}
+func LoadVerifiedPack(trusted ed25519.PublicKey, path string) (Dictionary, error) {
+	file, err := os.ReadFile(path)
+	if err != nil {
+		return Dictionary{}, err
+	}
+	dict, err := decodeVerifiedPack(trusted, file)
+	if err != nil {
+		return Dictionary{}, fmt.Errorf("%s: %w", path, err)
+	}
+	return dict, nil
+}
@@ -0,0 +1,128 @@
+package memorable_ids
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/binary"
+	"hash/crc32"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPack(t *testing.T) {
+	t.Run("should round-trip the built-in dictionary", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "builtin.mdict")
+		require.NoError(t, SavePack(path, GetDictionary()))
+
+		dict, err := LoadPack(path)
+		require.NoError(t, err)
+		for _, class := range allWordClasses {
+			assert.Equal(t, GetDictionary().Words(class), dict.Words(class), class.String())
+		}
+		assert.Equal(t, GetDictionaryStats(), dict.Stats)
+		assert.True(t, dict.Contains(ClassNoun, "rabbit"))
+	})
+
+	t.Run("should detect corruption", func(t *testing.T) {
+		var pack bytes.Buffer
+		require.NoError(t, WritePack(&pack, NewDictionary([]string{"ancient"}, []string{"dragon"}, nil, nil, nil)))
+
+		corrupted := bytes.Clone(pack.Bytes())
+		corrupted[len(corrupted)-8] ^= 0xff
+		_, err := ReadPack(bytes.NewReader(corrupted))
+		assert.ErrorIs(t, err, ErrPackChecksum)
+
+		_, err = ReadPack(bytes.NewReader(pack.Bytes()[:pack.Len()-3]))
+		assert.Error(t, err)
+	})
+
+	t.Run("should reject files that are not packs", func(t *testing.T) {
+		_, err := ReadPack(bytes.NewReader([]byte("[adjective]\nancient\n")))
+		assert.ErrorIs(t, err, ErrInvalidPack)
+	})
+
+	t.Run("should reject malformed class tables with a valid checksum", func(t *testing.T) {
+		var pack bytes.Buffer
+		require.NoError(t, WritePack(&pack, NewDictionary([]string{"ancient"}, []string{"dragon"}, nil, nil, nil)))
+
+		// Claim a huge adjective count, then fix up the checksum
+		body := bytes.Clone(pack.Bytes()[:pack.Len()-4])
+		body[packHeaderSize+4] = 0xff
+		forged := binary.LittleEndian.AppendUint32(body, crc32.Checksum(body, packTable))
+
+		_, err := ReadPack(bytes.NewReader(forged))
+		assert.ErrorIs(t, err, ErrInvalidPack)
+	})
+
+	t.Run("should read packs without the color class", func(t *testing.T) {
+		var pack bytes.Buffer
+		require.NoError(t, WritePack(&pack, NewDictionary([]string{"ancient"}, []string{"dragon"}, []string{"fly"}, []string{"high"}, []string{"above"})))
+
+		// Drop the color entry, which has no data, then fix up the checksum
+		body := bytes.Clone(pack.Bytes()[:pack.Len()-4])
+		colors := packHeaderSize + 5*packEntrySize
+		body = append(body[:colors], body[colors+packEntrySize:]...)
+		binary.LittleEndian.PutUint16(body[6:], 5)
+		legacy := binary.LittleEndian.AppendUint32(body, crc32.Checksum(body, packTable))
+
+		dict, err := ReadPack(bytes.NewReader(legacy))
+		require.NoError(t, err)
+		assert.Equal(t, []string{"dragon"}, dict.Nouns)
+		assert.Equal(t, []string{"above"}, dict.Prepositions)
+		assert.Empty(t, dict.Words(ClassColor))
+	})
+
+	t.Run("should reject empty words", func(t *testing.T) {
+		err := WritePack(&bytes.Buffer{}, NewDictionary([]string{""}, nil, nil, nil, nil))
+		assert.ErrorIs(t, err, ErrInvalidPack)
+	})
+}
+
+func TestSignedPack(t *testing.T) {
+	public, private, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	dict := NewDictionary([]string{"ancient", "brave"}, []string{"dragon", "knight"}, nil, nil, nil)
+
+	t.Run("should load packs signed by the trusted key", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "approved.mdict")
+		require.NoError(t, SaveSignedPack(path, dict, private))
+
+		verified, err := LoadVerifiedPack(public, path)
+		require.NoError(t, err)
+		assert.Equal(t, dict.Nouns, verified.Nouns)
+
+		unverified, err := LoadPack(path)
+		require.NoError(t, err)
+		assert.Equal(t, dict.Nouns, unverified.Nouns)
+	})
+
+	t.Run("should refuse packs signed by another key", func(t *testing.T) {
+		_, other, err := ed25519.GenerateKey(nil)
+		require.NoError(t, err)
+		var pack bytes.Buffer
+		require.NoError(t, WriteSignedPack(&pack, dict, other))
+
+		_, err = ReadVerifiedPack(public, &pack)
+		assert.ErrorIs(t, err, ErrPackSignature)
+	})
+
+	t.Run("should refuse tampered packs", func(t *testing.T) {
+		var pack bytes.Buffer
+		require.NoError(t, WriteSignedPack(&pack, dict, private))
+
+		tampered := bytes.Replace(pack.Bytes(), []byte("knight"), []byte("goblin"), 1)
+		_, err := ReadVerifiedPack(public, bytes.NewReader(tampered))
+		assert.ErrorIs(t, err, ErrPackSignature)
+	})
+
+	t.Run("should refuse unsigned packs", func(t *testing.T) {
+		var pack bytes.Buffer
+		require.NoError(t, WritePack(&pack, dict))
+
+		_, err := ReadVerifiedPack(public, &pack)
+		assert.ErrorIs(t, err, ErrPackSignature)
+	})
+}
@@ -0,0 +1,143 @@
+package memorable_ids
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"runtime"
+	"sync"
+	"time"
+)
+
+// maxStalledRounds is the number of top-up rounds without a new unique ID before giving up
+const maxStalledRounds = 100
+
+// ParallelOptions contains configuration for GenerateNParallel
+type ParallelOptions struct {
+	// Generate are the options used for every generated ID
+	Generate GenerateOptions
+	// Dedupe removes duplicates and tops up the result to n unique IDs (default: false)
+	Dedupe bool
+	// Seed seeds the per-worker random sources (default: current time)
+	Seed int64
+}
+
+// GenerateNParallel creates n memorable IDs by sharding generation across
+// workers goroutines (default: GOMAXPROCS), each with its own random source,
+// for seeding test databases with millions of names. Word selection is
+// reproducible for a fixed Seed and worker count when Dedupe is off.
+//
+// Example:
+//
+//	ids, err := GenerateNParallel(ctx, 10_000_000, 8, ParallelOptions{
+//	  Generate: GenerateOptions{Components: 3, Suffix: SuffixGenerators.Number4},
+//	  Dedupe:   true,
+//	})
+func GenerateNParallel(ctx context.Context, n, workers int, options ParallelOptions) ([]string, error) {
+	if n < 0 {
+		return nil, errors.New("n must not be negative")
+	}
+	if workers < 1 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	if options.Seed == 0 {
+		options.Seed = time.Now().UnixNano()
+	}
+
+	if options.Dedupe {
+		if capacity, ok := batchCapacity(options.Generate); ok && capacity < n {
+			return nil, fmt.Errorf("%w: only %d combinations for %d unique IDs", ErrBatchExhausted, capacity, n)
+		}
+	}
+
+	ids, err := generateShards(ctx, n, workers, options.Generate, options.Seed)
+	if err != nil || !options.Dedupe {
+		return ids, err
+	}
+
+	seen := make(map[string]struct{}, n)
+	unique := ids[:0]
+	for _, id := range ids {
+		if _, ok := seen[id]; !ok {
+			seen[id] = struct{}{}
+			unique = append(unique, id)
+		}
+	}
+
+	// Top up the duplicates that were dropped, oversampling to absorb repeats
+	stalled := 0
+	for round := int64(1); len(unique) < n; round++ {
+		missing := n - len(unique)
+		count := missing*2 + 16
+		extra, err := generateShards(ctx, count, min(workers, count), options.Generate, options.Seed+round*int64(workers))
+		if err != nil {
+			return nil, err
+		}
+
+		added := 0
+		for _, id := range extra {
+			if _, ok := seen[id]; !ok && len(unique) < n {
+				seen[id] = struct{}{}
+				unique = append(unique, id)
+				added++
+			}
+		}
+
+		if added > 0 {
+			stalled = 0
+		} else if stalled++; stalled >= maxStalledRounds {
+			return unique, fmt.Errorf("%w: generated %d of %d unique IDs", ErrBatchExhausted, len(unique), n)
+		}
+	}
+
+	return unique, nil
+}
+
+// generateShards splits n generations across workers and concatenates the results
+func generateShards(ctx context.Context, n, workers int, options GenerateOptions, seed int64) ([]string, error) {
+	ids := make([]string, n)
+	if n == 0 {
+		return ids, nil
+	}
+
+	shard := (n + workers - 1) / workers
+	errs := make([]error, workers)
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		start := w * shard
+		end := min(start+shard, n)
+		if start >= end {
+			break
+		}
+
+		wg.Add(1)
+		go func(w int, out []string) {
+			defer wg.Done()
+
+			rng := rand.New(rand.NewSource(seed + int64(w)))
+			dict := GetDictionary()
+			for i := range out {
+				if i%1024 == 0 {
+					if err := ctx.Err(); err != nil {
+						errs[w] = err
+						return
+					}
+				}
+				id, err := generate(dict, options, rng.Intn)
+				if err != nil {
+					errs[w] = err
+					return
+				}
+				out[i] = id
+			}
+		}(w, ids[start:end])
+	}
+	wg.Wait()
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
@@ -0,0 +1,69 @@
+package memorable_ids
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateNParallel(t *testing.T) {
+	t.Run("should generate n IDs across workers", func(t *testing.T) {
+		ids, err := GenerateNParallel(context.Background(), 10000, 4, ParallelOptions{
+			Generate: GenerateOptions{Components: 3},
+		})
+		require.NoError(t, err, "GenerateNParallel should not fail")
+
+		assert.Len(t, ids, 10000)
+		for _, id := range ids {
+			assert.NotEmpty(t, id)
+		}
+	})
+
+	t.Run("should be reproducible for a fixed seed", func(t *testing.T) {
+		options := ParallelOptions{Generate: GenerateOptions{Components: 2}, Seed: 42}
+		first, err := GenerateNParallel(context.Background(), 500, 3, options)
+		require.NoError(t, err)
+		second, err := GenerateNParallel(context.Background(), 500, 3, options)
+		require.NoError(t, err)
+
+		assert.Equal(t, first, second)
+	})
+
+	t.Run("should dedupe and top up", func(t *testing.T) {
+		ids, err := GenerateNParallel(context.Background(), 3000, 4, ParallelOptions{
+			Generate: GenerateOptions{Components: 2},
+			Dedupe:   true,
+		})
+		require.NoError(t, err, "GenerateNParallel should not fail")
+
+		seen := make(map[string]bool)
+		for _, id := range ids {
+			assert.False(t, seen[id], "Duplicate ID '%s'", id)
+			seen[id] = true
+		}
+		assert.Len(t, ids, 3000)
+	})
+
+	t.Run("should fail when the space is too small to dedupe", func(t *testing.T) {
+		_, err := GenerateNParallel(context.Background(), len(Adjectives)+1, 2, ParallelOptions{
+			Generate: GenerateOptions{Components: 1},
+			Dedupe:   true,
+		})
+		assert.ErrorIs(t, err, ErrBatchExhausted)
+	})
+
+	t.Run("should stop on context cancellation", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err := GenerateNParallel(ctx, 10000, 2, ParallelOptions{})
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+
+	t.Run("should propagate option errors", func(t *testing.T) {
+		_, err := GenerateNParallel(context.Background(), 10, 2, ParallelOptions{Generate: GenerateOptions{Components: 8}})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,152 @@
+package memorable_ids
+
+import (
+	"errors"
+	"hash/fnv"
+	"strings"
+)
+
+/**
+ * Cross-language parity algorithm
+ *
+ * ParityGenerator implements a small, versioned generation algorithm that
+ * only needs 32-bit integer arithmetic, so the companion JavaScript
+ * library can produce identical IDs for the same seed and options, and
+ * frontend previews match what the backend persists. Version 1:
+ *
+ *  1. String seeds are hashed to uint32 with 32-bit FNV-1a of their UTF-8
+ *     bytes (ParitySeed).
+ *  2. The random source is mulberry32 returning raw uint32 values:
+ *
+ *	function mulberry32(a) {
+ *	  return function () {
+ *	    a = (a + 0x6d2b79f5) | 0
+ *	    let t = Math.imul(a ^ (a >>> 15), a | 1)
+ *	    t = (t + Math.imul(t ^ (t >>> 7), t | 61)) ^ t
+ *	    return (t ^ (t >>> 14)) >>> 0
+ *	  }
+ *	}
+ *
+ *  3. A uniform index below n draws values until one is below
+ *     2^32 - (2^32 mod n) and returns it mod n.
+ *  4. Each ID draws one index per component in component order
+ *     (adjective, noun, verb, adverb, preposition) into the built-in word
+ *     lists, then the suffix: number is index(1000) as 3 digits, number4
+ *     index(10000) as 4 digits, hex index(256) as 2 lowercase hex digits,
+ *     letter index(26) as a-z.
+ *  5. Parts are joined with the separator (default "-"), with 2
+ *     components by default. Consecutive IDs continue the same stream.
+ *
+ * Any change to these steps or to the built-in word lists requires a new
+ * ParityVersion.
+ */
+
+// ParityVersion is the version of the parity algorithm implemented by ParityGenerator
+const ParityVersion = 1
+
+// ErrNotPortable is returned for options the parity algorithm can't reproduce in other languages
+var ErrNotPortable = errors.New("options are not portable")
+
+// ParityGenerator issues IDs with the cross-language parity algorithm.
+// A ParityGenerator is not safe for concurrent use.
+//
+// Example:
+//
+//	gen := NewParityGenerator(ParitySeed("preview-42"))
+//	gen.Generate(GenerateOptions{Suffix: SuffixGenerators.Number}) // same ID as the JS library
+type ParityGenerator struct {
+	state uint32
+}
+
+// NewParityGenerator creates a parity generator with the given seed
+func NewParityGenerator(seed uint32) *ParityGenerator {
+	return &ParityGenerator{state: seed}
+}
+
+// ParitySeed hashes a string seed to a parity seed with 32-bit FNV-1a
+//
+// Example:
+//
+//	ParitySeed("") // 2166136261
+func ParitySeed(seed string) uint32 {
+	hash := fnv.New32a()
+	hash.Write([]byte(seed))
+	return hash.Sum32()
+}
+
+// next returns the next mulberry32 output
+func (g *ParityGenerator) next() uint32 {
+	g.state += 0x6d2b79f5
+	t := g.state
+	t = (t ^ t>>15) * (t | 1)
+	t = (t + (t^t>>7)*(t|61)) ^ t
+	return t ^ t>>14
+}
+
+// intn returns a uniform index below n by rejection sampling
+func (g *ParityGenerator) intn(n int) int {
+	limit := uint64(1<<32) - uint64(1<<32)%uint64(n)
+	for {
+		if value := uint64(g.next()); value < limit {
+			return int(value % uint64(n))
+		}
+	}
+}
+
+// Generate issues the next ID of the stream. Only component order, the
+// built-in word lists and the built-in random suffixes are portable;
+// patterns, dictionary overrides and other suffixes return ErrNotPortable.
+// A failed call doesn't advance the stream.
+func (g *ParityGenerator) Generate(options GenerateOptions) (string, error) {
+	if err := options.Validate(); err != nil {
+		return "", err
+	}
+	if options.Pattern != "" || options.Dictionary != nil {
+		return "", ErrNotPortable
+	}
+	var suffix func(intn func(int) int) string
+	if options.hasSuffix() {
+		spec, ok := DescribeSuffix(options.Suffix)
+		if suffix = replayableSuffixes[spec.Name]; !ok || suffix == nil || options.SuffixProvider != nil {
+			return "", ErrNotPortable
+		}
+	}
+	components := options.Components
+	if components == 0 {
+		components = 2
+	}
+	separator := options.Separator
+	if separator == "" {
+		separator = "-"
+	}
+
+	dict := GetDictionary()
+	parts := make([]string, 0, components+1)
+	for _, class := range wordClasses[:components] {
+		words := dict.Words(class)
+		parts = append(parts, words[g.intn(len(words))])
+	}
+	if suffix != nil {
+		parts = append(parts, suffix(g.intn))
+	}
+	return strings.Join(parts, separator), nil
+}
+
+// GenerateParity returns the first n IDs of the parity stream of seed
+//
+// Example:
+//
+//	GenerateParity(ParitySeed("preview-42"), 3, GenerateOptions{})
+//	// ["near-weasel", "sour-weasel", "happy-duck"], nil
+func GenerateParity(seed uint32, n int, options GenerateOptions) ([]string, error) {
+	gen := NewParityGenerator(seed)
+	ids := make([]string, 0, max(n, 0))
+	for range n {
+		id, err := gen.Generate(options)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
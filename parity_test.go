@@ -0,0 +1,62 @@
+package memorable_ids
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParityGenerator(t *testing.T) {
+	// Reference vectors computed by an independent implementation of the
+	// documented algorithm; the JavaScript library must produce the same
+	t.Run("should match the reference vectors", func(t *testing.T) {
+		assert.Equal(t, uint32(1203761460), ParitySeed("preview-42"))
+		assert.Equal(t, uint32(2166136261), ParitySeed(""))
+
+		ids, err := GenerateParity(ParitySeed("preview-42"), 3, GenerateOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"near-weasel", "sour-weasel", "happy-duck"}, ids)
+
+		ids, err = GenerateParity(ParitySeed("preview-42"), 3, GenerateOptions{Components: 3, Suffix: SuffixGenerators.Number})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"near-weasel-marry-392", "happy-duck-hop-555", "sour-duck-whisper-985"}, ids)
+
+		ids, err = GenerateParity(0, 3, GenerateOptions{Components: 5, Suffix: SuffixGenerators.Hex})
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			"large-hare-cry-easily-above-31",
+			"fair-guinea-pig-marry-carefully-without-05",
+			"sour-spider-run-deeply-to-46",
+		}, ids)
+	})
+
+	t.Run("should continue the stream across calls", func(t *testing.T) {
+		gen := NewParityGenerator(ParitySeed("preview-42"))
+		first, err := gen.Generate(GenerateOptions{})
+		require.NoError(t, err)
+		second, err := gen.Generate(GenerateOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"near-weasel", "sour-weasel"}, []string{first, second})
+	})
+
+	t.Run("should reject suffixes other languages can't reproduce", func(t *testing.T) {
+		gen := NewParityGenerator(1)
+		_, err := gen.Generate(GenerateOptions{Suffix: SuffixGenerators.Timestamp})
+		assert.ErrorIs(t, err, ErrNotPortable)
+
+		_, err = gen.Generate(GenerateOptions{Suffix: func() *string { return nil }})
+		assert.ErrorIs(t, err, ErrNotPortable)
+	})
+
+	t.Run("should draw uniform indexes", func(t *testing.T) {
+		gen := NewParityGenerator(7)
+		counts := make([]int, 3)
+		for range 30000 {
+			counts[gen.intn(3)]++
+		}
+		for _, count := range counts {
+			assert.InDelta(t, 10000, count, 500)
+		}
+	})
+}
@@ -0,0 +1,222 @@
+package memorable_ids
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseInto(t *testing.T) {
+	t.Run("should match Parse", func(t *testing.T) {
+		inputs := []string{"cute-rabbit-042", "large-fox-swim", "cute", "123", "", "cute-123abc-456", "a--b"}
+		var parsed ParsedID
+		for _, input := range inputs {
+			expected := Parse(input, "-")
+			ParseInto(input, "-", &parsed)
+
+			assert.Equal(t, expected.Components, parsed.Components, "Components mismatch for %q", input)
+			if expected.Suffix == nil {
+				assert.Nil(t, parsed.Suffix, "Expected nil suffix for %q", input)
+			} else {
+				require.NotNil(t, parsed.Suffix, "Expected suffix for %q", input)
+				assert.Equal(t, *expected.Suffix, *parsed.Suffix)
+			}
+		}
+	})
+
+	t.Run("should support multi-character separators", func(t *testing.T) {
+		var parsed ParsedID
+		ParseInto("cute::rabbit::042", "::", &parsed)
+
+		assert.Equal(t, []string{"cute", "rabbit"}, parsed.Components)
+		require.NotNil(t, parsed.Suffix)
+		assert.Equal(t, "042", *parsed.Suffix)
+	})
+
+	t.Run("should not allocate when reusing dst", func(t *testing.T) {
+		parsed := ParsedID{Components: make([]string, 0, 8)}
+		allocs := testing.AllocsPerRun(100, func() {
+			ParseInto("cute-rabbit-swim-042", "-", &parsed)
+		})
+
+		assert.Equal(t, 0.0, allocs)
+	})
+}
+
+func BenchmarkParse(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		Parse("cute-rabbit-swim-042", "-")
+	}
+}
+
+func BenchmarkParseInto(b *testing.B) {
+	b.ReportAllocs()
+	var parsed ParsedID
+	for i := 0; i < b.N; i++ {
+		ParseInto("cute-rabbit-swim-042", "-", &parsed)
+	}
+}
+
+func TestParseWithOptions(t *testing.T) {
+	t.Run("should canonicalize mixed-case IDs", func(t *testing.T) {
+		for _, id := range []string{"Cute-Rabbit-042", "CUTE-RABBIT-042", " cute-rabbit-042\n"} {
+			parsed := ParseWithOptions(id, ParseOptions{CaseInsensitive: true})
+			assert.Equal(t, []string{"cute", "rabbit"}, parsed.Components)
+			require.NotNil(t, parsed.Suffix)
+			assert.Equal(t, "042", *parsed.Suffix)
+		}
+	})
+
+	t.Run("should keep casing by default", func(t *testing.T) {
+		parsed := ParseWithOptions("Cute_Rabbit", ParseOptions{Separator: "_"})
+		assert.Equal(t, []string{"Cute", "Rabbit"}, parsed.Components)
+		assert.Nil(t, parsed.Suffix)
+	})
+
+	t.Run("should canonicalize IDs", func(t *testing.T) {
+		assert.Equal(t, "cute-rabbit-042", Canonicalize(" Cute-Rabbit-042 "))
+	})
+}
+
+func TestParseWithSuffixSpec(t *testing.T) {
+	t.Run("should detect suffixes using the generator spec", func(t *testing.T) {
+		parsed := ParseWithOptions("cute-rabbit-ff", ParseOptions{Suffix: SuffixGenerators.Hex})
+		assert.Equal(t, []string{"cute", "rabbit"}, parsed.Components)
+		require.NotNil(t, parsed.Suffix)
+		assert.Equal(t, "ff", *parsed.Suffix)
+	})
+
+	t.Run("should not treat non-matching parts as suffixes", func(t *testing.T) {
+		parsed := ParseWithOptions("cute-rabbit-042", ParseOptions{Suffix: SuffixGenerators.Letter})
+		assert.Equal(t, []string{"cute", "rabbit", "042"}, parsed.Components)
+		assert.Nil(t, parsed.Suffix)
+	})
+}
+
+func TestParseExplicitSuffix(t *testing.T) {
+	t.Run("should treat numeric parts as components without a suffix spec", func(t *testing.T) {
+		parsed := ParseWithOptions("agent-007", ParseOptions{ExplicitSuffix: true})
+		assert.Equal(t, []string{"agent", "007"}, parsed.Components)
+		assert.Nil(t, parsed.Suffix)
+		assert.Equal(t, "-", parsed.Separator)
+	})
+
+	t.Run("should still recognize suffixes matching the spec", func(t *testing.T) {
+		options := ParseOptions{ExplicitSuffix: true, Suffix: SuffixGenerators.Number4}
+		parsed := ParseWithOptions("agent-007-1234", options)
+		assert.Equal(t, []string{"agent", "007"}, parsed.Components)
+		require.NotNil(t, parsed.Suffix)
+		assert.Equal(t, "1234", *parsed.Suffix)
+
+		parsed = ParseWithOptions("agent-007", options)
+		assert.Equal(t, []string{"agent", "007"}, parsed.Components)
+		assert.Nil(t, parsed.Suffix)
+	})
+
+	t.Run("should ignore suffix generators without a spec", func(t *testing.T) {
+		custom := func() *string { s := "x"; return &s }
+		parsed := ParseWithOptions("agent-007", ParseOptions{ExplicitSuffix: true, Suffix: custom})
+		assert.Equal(t, []string{"agent", "007"}, parsed.Components)
+		assert.Nil(t, parsed.Suffix)
+	})
+}
+
+func TestParseChecked(t *testing.T) {
+	t.Run("should parse inputs within the limits", func(t *testing.T) {
+		parsed, err := ParseChecked("cute-rabbit-042", ParseOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"cute", "rabbit"}, parsed.Components)
+		require.NotNil(t, parsed.Suffix)
+		assert.Equal(t, "042", *parsed.Suffix)
+	})
+
+	t.Run("should reject inputs that are too long", func(t *testing.T) {
+		_, err := ParseChecked(strings.Repeat("a", DefaultMaxParseLength+1), ParseOptions{})
+		assert.ErrorIs(t, err, ErrInputTooLong)
+
+		_, err = ParseChecked("cute-rabbit-042", ParseOptions{MaxLength: 10})
+		assert.ErrorIs(t, err, ErrInputTooLong)
+	})
+
+	t.Run("should reject inputs with too many parts", func(t *testing.T) {
+		_, err := ParseChecked(strings.Repeat("-", DefaultMaxParseParts), ParseOptions{})
+		assert.ErrorIs(t, err, ErrTooManyParts)
+
+		_, err = ParseChecked("cute-rabbit-swim-042", ParseOptions{MaxParts: 3})
+		assert.ErrorIs(t, err, ErrTooManyParts)
+	})
+
+	t.Run("should reject invalid separators", func(t *testing.T) {
+		for _, separator := range []string{"a", "1", strings.Repeat("-", MaxSeparatorLength+1)} {
+			_, err := ParseChecked("cute-rabbit", ParseOptions{Separator: separator})
+			assert.ErrorIs(t, err, ErrInvalidSeparator, "separator %q", separator)
+		}
+
+		parsed, err := ParseChecked("cute::rabbit", ParseOptions{Separator: "::"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"cute", "rabbit"}, parsed.Components)
+	})
+}
+
+func TestParseSeparators(t *testing.T) {
+	options := ParseOptions{Separators: []string{"-", "_"}}
+
+	t.Run("should split on whichever separator the ID uses", func(t *testing.T) {
+		parsed := ParseWithOptions("cute_rabbit_042", options)
+		assert.Equal(t, []string{"cute", "rabbit"}, parsed.Components)
+		require.NotNil(t, parsed.Suffix)
+		assert.Equal(t, "042", *parsed.Suffix)
+		assert.Equal(t, "_", parsed.Separator)
+
+		parsed = ParseWithOptions("large-fox-swim", options)
+		assert.Equal(t, []string{"large", "fox", "swim"}, parsed.Components)
+		assert.Equal(t, "-", parsed.Separator)
+	})
+
+	t.Run("should prefer separators in the given order", func(t *testing.T) {
+		parsed := ParseWithOptions("cute_rabbit-042", options)
+		assert.Equal(t, "-", parsed.Separator)
+		assert.Equal(t, []string{"cute_rabbit"}, parsed.Components)
+	})
+
+	t.Run("should not split on separators inside words", func(t *testing.T) {
+		for _, separators := range [][]string{{"-", "_"}, {"_", "-"}} {
+			parsed := ParseWithOptions("cute_guinea-pig_042", ParseOptions{Separators: separators})
+			assert.Equal(t, "_", parsed.Separator, separators)
+			assert.Equal(t, []string{"cute", "guinea-pig"}, parsed.Components, separators)
+			require.NotNil(t, parsed.Suffix)
+			assert.Equal(t, "042", *parsed.Suffix)
+
+			parsed = ParseWithOptions("fair-guinea-pig", ParseOptions{Separators: separators})
+			assert.Equal(t, "-", parsed.Separator, separators)
+		}
+	})
+
+	t.Run("should otherwise pick the most frequent separator", func(t *testing.T) {
+		parsed := ParseWithOptions("xx_yy_zz-ww", options)
+		assert.Equal(t, "_", parsed.Separator)
+	})
+
+	t.Run("should fall back to the first separator", func(t *testing.T) {
+		parsed := ParseWithOptions("cute", options)
+		assert.Equal(t, []string{"cute"}, parsed.Components)
+		assert.Equal(t, "-", parsed.Separator)
+	})
+
+	t.Run("should report the separator from Parse", func(t *testing.T) {
+		assert.Equal(t, "-", Parse("cute-rabbit", "").Separator)
+		assert.Equal(t, "::", Parse("cute::rabbit", "::").Separator)
+	})
+
+	t.Run("should validate every separator in ParseChecked", func(t *testing.T) {
+		_, err := ParseChecked("cute_rabbit", ParseOptions{Separators: []string{"-", "x"}})
+		assert.ErrorIs(t, err, ErrInvalidSeparator)
+
+		parsed, err := ParseChecked("cute_rabbit", options)
+		require.NoError(t, err)
+		assert.Equal(t, "_", parsed.Separator)
+	})
+}
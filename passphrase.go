@@ -0,0 +1,110 @@
+package memorable_ids
+
+import (
+	"crypto/rand"
+	"errors"
+	"math"
+	"math/big"
+	"strings"
+)
+
+// DefaultPassphraseEntropy is the default target entropy in bits for passphrases
+const DefaultPassphraseEntropy = 77
+
+// PassphraseOptions contains configuration for passphrase generation
+type PassphraseOptions struct {
+	// MinEntropyBits is the minimum entropy of the passphrase (default: 77)
+	MinEntropyBits float64
+	// Words is the word list to draw from; duplicates are ignored (default: all dictionary words)
+	Words []string
+	// Separator between words (default: "-")
+	Separator string
+}
+
+// Passphrase represents a generated passphrase and its strength
+type Passphrase struct {
+	// Phrase is the generated passphrase
+	Phrase string
+	// Words is the number of words in the passphrase
+	Words int
+	// EntropyBits is the achieved entropy in bits
+	EntropyBits float64
+}
+
+// GeneratePassphrase creates a diceware-style passphrase reaching the
+// requested entropy, drawing every word uniformly from the word list with
+// crypto/rand. Unlike Generate, words are not tied to grammatical positions.
+//
+// Example:
+//
+//	GeneratePassphrase(PassphraseOptions{})
+//	// Passphrase{Phrase: "cute-sparrow-within-hop-...", Words: 10, EntropyBits: 79.4}
+//
+//	GeneratePassphrase(PassphraseOptions{MinEntropyBits: 40, Separator: " "})
+func GeneratePassphrase(options PassphraseOptions) (Passphrase, error) {
+	if options.MinEntropyBits <= 0 {
+		options.MinEntropyBits = DefaultPassphraseEntropy
+	}
+	if options.Separator == "" {
+		options.Separator = "-"
+	}
+
+	words := options.Words
+	if words == nil {
+		words = allWords(GetDictionary())
+	}
+	words = uniqueWords(words)
+	if len(words) < 2 {
+		return Passphrase{}, errors.New("passphrase word list needs at least 2 distinct words")
+	}
+
+	bitsPerWord := math.Log2(float64(len(words)))
+	count := int(math.Ceil(options.MinEntropyBits / bitsPerWord))
+
+	chosen := make([]string, count)
+	for i := range chosen {
+		index, err := cryptoIntn(len(words))
+		if err != nil {
+			return Passphrase{}, err
+		}
+		chosen[i] = words[index]
+	}
+
+	return Passphrase{
+		Phrase:      strings.Join(chosen, options.Separator),
+		Words:       count,
+		EntropyBits: float64(count) * bitsPerWord,
+	}, nil
+}
+
+// cryptoIntn returns a uniform random integer in [0, n) from crypto/rand
+func cryptoIntn(n int) (int, error) {
+	value, err := rand.Int(rand.Reader, big.NewInt(int64(n)))
+	if err != nil {
+		return 0, err
+	}
+	return int(value.Int64()), nil
+}
+
+// allWords returns every word of the dictionary in component order
+func allWords(dict Dictionary) []string {
+	var words []string
+	for _, class := range wordClasses {
+		words = append(words, dict.Words(class)...)
+	}
+	return words
+}
+
+// uniqueWords returns the non-empty words in order with duplicates removed
+func uniqueWords(words []string) []string {
+	seen := make(map[string]struct{}, len(words))
+	unique := make([]string, 0, len(words))
+	for _, word := range words {
+		if _, ok := seen[word]; ok || word == "" {
+			continue
+		}
+		seen[word] = struct{}{}
+		unique = append(unique, word)
+	}
+	return unique
+}
@@ -0,0 +1,49 @@
+package memorable_ids
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGeneratePassphrase(t *testing.T) {
+	t.Run("should reach the default entropy", func(t *testing.T) {
+		passphrase, err := GeneratePassphrase(PassphraseOptions{})
+		require.NoError(t, err, "GeneratePassphrase should not fail")
+
+		assert.GreaterOrEqual(t, passphrase.EntropyBits, 77.0)
+		bitsPerWord := math.Log2(float64(len(uniqueWords(allWords(GetDictionary())))))
+		assert.Equal(t, int(math.Ceil(77/bitsPerWord)), passphrase.Words)
+	})
+
+	t.Run("should use the smallest word count for the target", func(t *testing.T) {
+		words := make([]string, 0, 1024)
+		for i := 0; i < 1024; i++ {
+			words = append(words, fmt.Sprintf("word%04d", i))
+		}
+
+		passphrase, err := GeneratePassphrase(PassphraseOptions{MinEntropyBits: 50, Words: words, Separator: " "})
+		require.NoError(t, err, "GeneratePassphrase should not fail")
+
+		assert.Equal(t, 5, passphrase.Words, "Expected 5 words of 10 bits each")
+		assert.InDelta(t, 50.0, passphrase.EntropyBits, 1e-9)
+		assert.Len(t, strings.Fields(passphrase.Phrase), 5)
+	})
+
+	t.Run("should ignore duplicate words when computing entropy", func(t *testing.T) {
+		passphrase, err := GeneratePassphrase(PassphraseOptions{MinEntropyBits: 3, Words: []string{"a", "b", "a", "b"}})
+		require.NoError(t, err, "GeneratePassphrase should not fail")
+
+		assert.Equal(t, 3, passphrase.Words)
+		assert.InDelta(t, 3*math.Log2(2), passphrase.EntropyBits, 1e-9)
+	})
+
+	t.Run("should reject tiny word lists", func(t *testing.T) {
+		_, err := GeneratePassphrase(PassphraseOptions{Words: []string{"only", "only"}})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,118 @@
+package memorable_ids
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+)
+
+// MaxPatternWords is the longest word-order template accepted by ParsePattern
+const MaxPatternWords = 8
+
+// ParsePattern parses a word-order template: word class names, as
+// returned by WordClass.String, joined by "-"
+//
+// Example:
+//
+//	ParsePattern("adjective-adjective-noun") // [ClassAdjective, ClassAdjective, ClassNoun], nil
+//	ParsePattern("noun-verb")                // [ClassNoun, ClassVerb], nil
+//	ParsePattern("noun-thing")               // nil, unknown word class "thing" in pattern
+func ParsePattern(pattern string) ([]WordClass, error) {
+	names := strings.Split(pattern, "-")
+	if len(names) > MaxPatternWords {
+		return nil, fmt.Errorf("pattern has %d words, at most %d", len(names), MaxPatternWords)
+	}
+
+	classes := make([]WordClass, len(names))
+	for i, name := range names {
+		class, ok := ParseWordClass(strings.ToLower(strings.TrimSpace(name)))
+		if !ok {
+			return nil, fmt.Errorf("unknown word class %q in pattern", name)
+		}
+		classes[i] = class
+	}
+	return classes, nil
+}
+
+// patternClasses parses pattern, checking that the dictionary has words
+// for each of its classes
+func patternClasses(dict Dictionary, pattern string) ([]WordClass, error) {
+	classes, err := ParsePattern(pattern)
+	if err != nil {
+		return nil, err
+	}
+	if err := requireWords(dict, classes); err != nil {
+		return nil, err
+	}
+	return classes, nil
+}
+
+// requireWords checks that the dictionary has words for each of classes
+func requireWords(dict Dictionary, classes []WordClass) error {
+	for _, class := range classes {
+		if len(dict.Words(class)) == 0 {
+			return fmt.Errorf("%w: no %s words", ErrEmptyWordClass, class)
+		}
+	}
+	return nil
+}
+
+// classes returns the word classes of the IDs generated with the
+// options: the pattern classes, or the first Components positional
+// classes (default: 2)
+func (o GenerateOptions) classes() ([]WordClass, error) {
+	if o.Pattern != "" {
+		return ParsePattern(o.Pattern)
+	}
+	components := o.Components
+	if components == 0 {
+		components = 2
+	}
+	if components < 1 || components > len(wordClasses) {
+		return nil, errors.New("components must be between 1 and 5")
+	}
+	return wordClasses[:components], nil
+}
+
+// appendPattern appends one word per pattern class to dst
+func appendPattern(dst []byte, dict Dictionary, classes []WordClass, separator string, intn func(int) int) []byte {
+	for i, class := range classes {
+		words := dict.Words(class)
+		if i > 0 {
+			dst = append(dst, separator...)
+		}
+		dst = append(dst, words[intn(len(words))]...)
+	}
+	return dst
+}
+
+// patternCombinations returns the number of word combinations of the
+// classes, reporting false if the count overflows uint64
+func patternCombinations(dict Dictionary, classes []WordClass) (uint64, bool) {
+	total := uint64(1)
+	for _, class := range classes {
+		var ok bool
+		if total, ok = mulUint64(total, uint64(len(dict.Words(class)))); !ok {
+			return 0, false
+		}
+	}
+	return total, true
+}
+
+// combinations returns the number of distinct IDs of the options with a
+// suffix of suffixRange values, saturating at math.MaxInt
+func (o GenerateOptions) combinations(suffixRange int) (int, error) {
+	classes, err := o.classes()
+	if err != nil {
+		return 0, err
+	}
+	total, ok := patternCombinations(o.dictionary(), classes)
+	if ok {
+		total, ok = mulUint64(total, uint64(max(suffixRange, 1)))
+	}
+	if !ok || total > math.MaxInt {
+		return math.MaxInt, nil
+	}
+	return int(total), nil
+}
@@ -0,0 +1,180 @@
+package memorable_ids
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePattern(t *testing.T) {
+	t.Run("should parse class names in order", func(t *testing.T) {
+		classes, err := ParsePattern("adjective-adjective-noun")
+		require.NoError(t, err)
+		assert.Equal(t, []WordClass{ClassAdjective, ClassAdjective, ClassNoun}, classes)
+
+		classes, err = ParsePattern(" Noun - VERB ")
+		require.NoError(t, err)
+		assert.Equal(t, []WordClass{ClassNoun, ClassVerb}, classes)
+	})
+
+	t.Run("should reject unknown classes and long patterns", func(t *testing.T) {
+		_, err := ParsePattern("noun-thing")
+		assert.ErrorContains(t, err, `unknown word class "thing"`)
+
+		_, err = ParsePattern("")
+		assert.Error(t, err)
+
+		_, err = ParsePattern(strings.Repeat("noun-", MaxPatternWords) + "noun")
+		assert.Error(t, err)
+	})
+}
+
+func TestGeneratePattern(t *testing.T) {
+	t.Run("should draw one word per pattern class", func(t *testing.T) {
+		dict := GetDictionary()
+		for range 50 {
+			id, err := Generate(GenerateOptions{Pattern: "adjective-adjective-noun", Components: 5})
+			require.NoError(t, err)
+
+			parts, err := splitClassWords(dict, id, "-", []WordClass{ClassAdjective, ClassAdjective, ClassNoun})
+			require.NoError(t, err, id)
+			assert.True(t, dict.Contains(ClassAdjective, parts[0]))
+			assert.True(t, dict.Contains(ClassAdjective, parts[1]))
+			assert.True(t, dict.Contains(ClassNoun, parts[2]))
+		}
+	})
+
+	t.Run("should apply the separator and suffix", func(t *testing.T) {
+		id, err := Generate(GenerateOptions{
+			Pattern:   "noun-verb",
+			Separator: "_",
+			Suffix:    SuffixGenerators.Number,
+		})
+		require.NoError(t, err)
+
+		parts := strings.Split(id, "_")
+		require.Len(t, parts, 3)
+		assert.True(t, GetDictionary().Contains(ClassNoun, parts[0]))
+		assert.True(t, GetDictionary().Contains(ClassVerb, parts[1]))
+		assert.Len(t, parts[2], 3)
+	})
+
+	t.Run("should reject invalid patterns", func(t *testing.T) {
+		_, err := Generate(GenerateOptions{Pattern: "noun-thing"})
+		assert.Error(t, err)
+
+		err = GenerateOptions{Pattern: "noun-thing"}.Validate()
+		assert.ErrorIs(t, err, ErrInvalidOptions)
+	})
+
+	t.Run("should reject classes without words", func(t *testing.T) {
+		dict := NewDictionary(Adjectives, Nouns, Verbs, Adverbs, Prepositions)
+
+		_, err := Generate(GenerateOptions{Pattern: "color-noun", Dictionary: &dict})
+		assert.ErrorIs(t, err, ErrEmptyWordClass)
+
+		err = GenerateOptions{Pattern: "color-noun", Dictionary: &dict}.Validate()
+		assert.ErrorIs(t, err, ErrInvalidOptions)
+		assert.ErrorIs(t, err, ErrEmptyWordClass)
+	})
+
+	t.Run("should account for the pattern in capacity and length", func(t *testing.T) {
+		options := GenerateOptions{Pattern: "noun-noun", Suffix: SuffixGenerators.Letter}
+		capacity, ok := batchCapacity(options)
+		require.True(t, ok)
+		assert.Equal(t, len(Nouns)*len(Nouns)*26, capacity)
+
+		longest := 0
+		for _, noun := range Nouns {
+			longest = max(longest, len(noun))
+		}
+		length, err := MaxIDLength(GenerateOptions{Pattern: "noun-noun"})
+		require.NoError(t, err)
+		assert.Equal(t, 2*longest+1, length)
+	})
+}
+
+func TestPatternConsistency(t *testing.T) {
+	options := GenerateOptions{Pattern: "noun-verb"}
+	space := len(Nouns) * len(Verbs)
+
+	t.Run("should size the space from the pattern", func(t *testing.T) {
+		assert.Equal(t, 2880, space)
+		assert.Equal(t, space, MaxIDsAt(options, 1))
+
+		analysis := NewGenerator(Config{Options: options}).CollisionAnalysis()
+		assert.Equal(t, space, analysis.TotalCombinations)
+
+		comparison, err := CompareConfigs(options)
+		require.NoError(t, err)
+		assert.Equal(t, "noun-verb", comparison.Configs[0].Label)
+		assert.Equal(t, space, comparison.Configs[0].Combinations)
+
+		estimate := EstimateStorage(options, 10)
+		assert.Empty(t, estimate.Warnings)
+		assert.Equal(t, 2, estimate.BinaryLength)
+
+		result, err := Simulate(SimulationOptions{Generate: options, IDs: 10})
+		require.NoError(t, err)
+		assert.Equal(t, space, result.TotalCombinations)
+	})
+
+	t.Run("should enumerate pattern IDs in index order", func(t *testing.T) {
+		id, err := NthID(1, options)
+		require.NoError(t, err)
+		assert.Equal(t, Nouns[1]+"-"+Verbs[0], id)
+
+		id, err = NthID(space-1, options)
+		require.NoError(t, err)
+		assert.Equal(t, Nouns[len(Nouns)-1]+"-"+Verbs[len(Verbs)-1], id)
+
+		_, err = NthID(space, options)
+		assert.Error(t, err)
+
+		gen := NewGenerator(Config{Options: options})
+		id, err = gen.NextSequential()
+		require.NoError(t, err)
+		assert.Equal(t, Nouns[0]+"-"+Verbs[0], id)
+	})
+
+	t.Run("should validate and parse pattern IDs", func(t *testing.T) {
+		assert.NoError(t, Validate("guinea-pig-swim", ValidateOptions{Pattern: "noun-verb"}))
+		assert.ErrorIs(t, Validate("guinea-pig-swim", ValidateOptions{}), ErrInvalidID)
+		err := Validate("rabbit-cute", ValidateOptions{Pattern: "noun-verb"})
+		assert.ErrorContains(t, err, `word 2 "cute" is not a known verb`)
+		assert.Error(t, Validate("rabbit-swim", ValidateOptions{Pattern: "noun-thing"}))
+
+		parsed, err := ParseStrict("guinea-pig-swim-042", ParseOptions{Pattern: "noun-verb"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"guinea-pig", "swim"}, parsed.Components)
+		assert.Equal(t, "042", *parsed.Suffix)
+
+		parsed, err = ParseStrict("rabbit-swim-ff", ParseOptions{Pattern: "noun-verb", DetectSuffix: true})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"rabbit", "swim"}, parsed.Components)
+
+		_, err = ParseStrict("rabbit-swim-run", ParseOptions{Pattern: "noun-verb"})
+		assert.ErrorIs(t, err, ErrInvalidID)
+	})
+
+	t.Run("should count usage in the pattern classes", func(t *testing.T) {
+		usage := &WordUsage{}
+		gen := NewGenerator(Config{Options: GenerateOptions{Pattern: "noun-verb", Suffix: SuffixGenerators.Hex}, Usage: usage})
+		for range 20 {
+			_, err := gen.Generate()
+			require.NoError(t, err)
+		}
+
+		snapshot := usage.Snapshot()
+		assert.Equal(t, 20, snapshot.IDs)
+		require.Len(t, snapshot.Counts, 2)
+		for word := range snapshot.Counts["noun"] {
+			assert.Contains(t, Nouns, word)
+		}
+		for word := range snapshot.Counts["verb"] {
+			assert.Contains(t, Verbs, word)
+		}
+	})
+}
@@ -0,0 +1,38 @@
+package memorable_ids
+
+import (
+	"crypto/sha256"
+	"time"
+)
+
+// Daily returns the memorable ID of the current UTC day for tag. Everyone
+// calling it with the same tag on the same day gets the same ID, for daily
+// puzzle names, standup room codes and rotation markers; different tags
+// get unrelated IDs.
+//
+// Example:
+//
+//	Daily("standup") // "afraid-clam" for every caller on 2026-10-16
+func Daily(tag string) string {
+	return PeriodicID(RotateDaily, tag, SystemClock.Now())
+}
+
+// Weekly returns the memorable ID of the current ISO week (UTC) for tag,
+// like Daily
+func Weekly(tag string) string {
+	return PeriodicID(RotateWeekly, tag, SystemClock.Now())
+}
+
+// PeriodicID returns the memorable ID of the period containing t for tag,
+// an adjective-noun pair derived from a SHA-256 hash of the period bucket
+// (see RotationPeriod.Bucket) and the tag. The derivation only depends on
+// the built-in dictionary, so it is stable across processes and machines.
+//
+// Example:
+//
+//	PeriodicID(RotateDaily, "puzzle", time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC))
+//	// "strong-computer"
+func PeriodicID(period RotationPeriod, tag string, t time.Time) string {
+	hash := sha256.Sum256([]byte(period.String() + "\x00" + period.Bucket(t) + "\x00" + tag))
+	return nameFromHash(GetDictionary(), hash[:], 2, 0, "-")
+}
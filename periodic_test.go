@@ -0,0 +1,38 @@
+package memorable_ids
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPeriodicID(t *testing.T) {
+	morning := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+
+	t.Run("should be stable within a period", func(t *testing.T) {
+		assert.Equal(t, "strong-computer", PeriodicID(RotateDaily, "puzzle", morning))
+		assert.Equal(t, "afraid-clam", PeriodicID(RotateDaily, "standup", morning))
+		assert.Equal(t, PeriodicID(RotateDaily, "puzzle", morning), PeriodicID(RotateDaily, "puzzle", morning.Add(14*time.Hour)))
+		assert.Equal(t, PeriodicID(RotateWeekly, "puzzle", morning), PeriodicID(RotateWeekly, "puzzle", morning.Add(-4*24*time.Hour)))
+	})
+
+	t.Run("should change with the period and tag", func(t *testing.T) {
+		ids := map[string]bool{}
+		for day := range 30 {
+			ids[PeriodicID(RotateDaily, "puzzle", morning.Add(time.Duration(day)*24*time.Hour))] = true
+		}
+		assert.Greater(t, len(ids), 25)
+		assert.NotEqual(t, PeriodicID(RotateDaily, "puzzle", morning), PeriodicID(RotateWeekly, "puzzle", morning))
+	})
+
+	t.Run("should produce valid IDs", func(t *testing.T) {
+		parsed, err := ParseStrict(Daily("standup"), ParseOptions{})
+		require.NoError(t, err)
+		assert.Len(t, parsed.Components, 2)
+		parsed, err = ParseStrict(Weekly("standup"), ParseOptions{})
+		require.NoError(t, err)
+		assert.Len(t, parsed.Components, 2)
+	})
+}
@@ -0,0 +1,179 @@
+package memorable_ids
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+// PGPEvenWords is the two-syllable PGP word list, used for bytes at even
+// positions (0, 2, 4, ...) and indexed by byte value
+var PGPEvenWords = []string{
+	"aardvark", "absurd", "accrue", "acme", "adrift", "adult", "afflict",
+	"ahead", "aimless", "Algol", "allow", "alone", "ammo", "ancient", "apple",
+	"artist", "assume", "Athens", "atlas", "Aztec", "baboon", "backfield",
+	"backward", "banjo", "beaming", "bedlamp", "beehive", "beeswax", "befriend",
+	"Belfast", "berserk", "billiard", "bison", "blackjack", "blockade",
+	"blowtorch", "bluebird", "bombast", "bookshelf", "brackish", "breadline",
+	"breakup", "brickyard", "briefcase", "Burbank", "button", "buzzard",
+	"cement", "chairlift", "chatter", "checkup", "chisel", "choking", "chopper",
+	"Christmas", "clamshell", "classic", "classroom", "cleanup", "clockwork",
+	"cobra", "commence", "concert", "cowbell", "crackdown", "cranky",
+	"crowfoot", "crucial", "crumpled", "crusade", "cubic", "dashboard",
+	"deadbolt", "deckhand", "dogsled", "dragnet", "drainage", "dreadful",
+	"drifter", "dropper", "drumbeat", "drunken", "Dupont", "dwelling", "eating",
+	"edict", "egghead", "eightball", "endorse", "endow", "enlist", "erase",
+	"escape", "exceed", "eyeglass", "eyetooth", "facial", "fallout", "flagpole",
+	"flatfoot", "flytrap", "fracture", "framework", "freedom", "frighten",
+	"gazelle", "Geiger", "glitter", "glucose", "goggles", "goldfish", "gremlin",
+	"guidance", "hamlet", "highchair", "hockey", "indoors", "indulge",
+	"inverse", "involve", "island", "jawbone", "keyboard", "kickoff", "kiwi",
+	"klaxon", "locale", "lockup", "merit", "minnow", "miser", "Mohawk", "mural",
+	"music", "necklace", "Neptune", "newborn", "nightbird", "Oakland", "obtuse",
+	"offload", "optic", "orca", "payday", "peachy", "pheasant", "physique",
+	"playhouse", "Pluto", "preclude", "prefer", "preshrunk", "printer",
+	"prowler", "pupil", "puppy", "python", "quadrant", "quiver", "quota",
+	"ragtime", "ratchet", "rebirth", "reform", "regain", "reindeer", "rematch",
+	"repay", "retouch", "revenge", "reward", "rhythm", "ribcage", "ringbolt",
+	"robust", "rocker", "ruffled", "sailboat", "sawdust", "scallion", "scenic",
+	"scorecard", "Scotland", "seabird", "select", "sentence", "shadow",
+	"shamrock", "showgirl", "skullcap", "skydive", "slingshot", "slowdown",
+	"snapline", "snapshot", "snowcap", "snowslide", "solo", "southward",
+	"soybean", "spaniel", "spearhead", "spellbind", "spheroid", "spigot",
+	"spindle", "spyglass", "stagehand", "stagnate", "stairway", "standard",
+	"stapler", "steamship", "sterling", "stockman", "stopwatch", "stormy",
+	"sugar", "surmount", "suspense", "sweatband", "swelter", "tactics", "talon",
+	"tapeworm", "tempest", "tiger", "tissue", "tonic", "topmost", "tracker",
+	"transit", "trauma", "treadmill", "Trojan", "trouble", "tumor", "tunnel",
+	"tycoon", "uncut", "unearth", "unwind", "uproot", "upset", "upshot",
+	"vapor", "village", "virus", "Vulcan", "waffle", "wallet", "watchword",
+	"wayside", "willow", "woodlark", "Zulu",
+}
+
+// PGPOddWords is the three-syllable PGP word list, used for bytes at odd
+// positions (1, 3, 5, ...) and indexed by byte value
+var PGPOddWords = []string{
+	"adroitness", "adviser", "aftermath", "aggregate", "alkali", "almighty",
+	"amulet", "amusement", "antenna", "applicant", "Apollo", "armistice",
+	"article", "asteroid", "Atlantic", "atmosphere", "autopsy", "Babylon",
+	"backwater", "barbecue", "belowground", "bifocals", "bodyguard",
+	"bookseller", "borderline", "bottomless", "Bradbury", "bravado",
+	"Brazilian", "breakaway", "Burlington", "businessman", "butterfat",
+	"Camelot", "candidate", "cannonball", "Capricorn", "caravan", "caretaker",
+	"celebrate", "cellulose", "certify", "chambermaid", "Cherokee", "Chicago",
+	"clergyman", "coherence", "combustion", "commando", "company", "component",
+	"concurrent", "confidence", "conformist", "congregate", "consensus",
+	"consulting", "corporate", "corrosion", "councilman", "crossover",
+	"crucifix", "cumbersome", "customer", "Dakota", "decadence", "December",
+	"decimal", "designing", "detector", "detergent", "determine", "dictator",
+	"dinosaur", "direction", "disable", "disbelief", "disruptive", "distortion",
+	"document", "embezzle", "enchanting", "enrollment", "enterprise",
+	"equation", "equipment", "escapade", "Eskimo", "everyday", "examine",
+	"existence", "exodus", "fascinate", "filament", "finicky", "forever",
+	"fortitude", "frequency", "gadgetry", "Galveston", "getaway", "glossary",
+	"gossamer", "graduate", "gravity", "guitarist", "hamburger", "Hamilton",
+	"handiwork", "hazardous", "headwaters", "hemisphere", "hesitate",
+	"hideaway", "holiness", "hurricane", "hydraulic", "impartial", "impetus",
+	"inception", "indigo", "inertia", "infancy", "inferno", "informant",
+	"insincere", "insurgent", "integrate", "intention", "inventive", "Istanbul",
+	"Jamaica", "Jupiter", "leprosy", "letterhead", "liberty", "maritime",
+	"matchmaker", "maverick", "Medusa", "megaton", "microscope", "microwave",
+	"midsummer", "millionaire", "miracle", "misnomer", "molasses", "molecule",
+	"Montana", "monument", "mosquito", "narrative", "nebula", "newsletter",
+	"Norwegian", "October", "Ohio", "onlooker", "opulent", "Orlando",
+	"outfielder", "Pacific", "pandemic", "Pandora", "paperweight", "paragon",
+	"paragraph", "paramount", "passenger", "pedigree", "Pegasus", "penetrate",
+	"perceptive", "performance", "pharmacy", "phonetic", "photograph",
+	"pioneer", "pocketful", "politeness", "positive", "potato", "processor",
+	"provincial", "proximate", "puberty", "publisher", "pyramid", "quantity",
+	"racketeer", "rebellion", "recipe", "recover", "repellent", "replica",
+	"reproduce", "resistor", "responsive", "retraction", "retrieval",
+	"retrospect", "revenue", "revival", "revolver", "sandalwood", "sardonic",
+	"Saturday", "savagery", "scavenger", "sensation", "sociable", "souvenir",
+	"specialist", "speculate", "stethoscope", "stupendous", "supportive",
+	"surrender", "suspicious", "sympathy", "tambourine", "telephone",
+	"therapist", "tobacco", "tolerance", "tomorrow", "torpedo", "tradition",
+	"travesty", "trombonist", "truncated", "typewriter", "ultimate",
+	"undaunted", "underfoot", "unicorn", "unify", "universe", "unravel",
+	"upcoming", "vacancy", "vagabond", "vertigo", "Virginia", "visitor",
+	"vocalist", "voyager", "warranty", "Waterloo", "whimsical", "Wichita",
+	"Wilmington", "Wyoming", "yesteryear", "Yucatan",
+}
+
+// pgpIndex maps lowercase PGP words to their byte value, one map per parity
+var pgpIndex = func() [2]map[string]byte {
+	var index [2]map[string]byte
+	for parity, words := range [2][]string{PGPEvenWords, PGPOddWords} {
+		index[parity] = make(map[string]byte, len(words))
+		for value, word := range words {
+			index[parity][strings.ToLower(word)] = byte(value)
+		}
+	}
+	return index
+}()
+
+// EncodePGPWords renders data in the PGP word list form, alternating
+// between the even and odd lists so swapped or dropped words are detected
+// when reading back
+//
+// Example:
+//
+//	EncodePGPWords([]byte{0xE5, 0x82, 0x94, 0xF2}) // "topmost Istanbul Pluto vagabond"
+func EncodePGPWords(data []byte) string {
+	words := make([]string, len(data))
+	for i, b := range data {
+		if i%2 == 0 {
+			words[i] = PGPEvenWords[b]
+		} else {
+			words[i] = PGPOddWords[b]
+		}
+	}
+	return strings.Join(words, " ")
+}
+
+// DecodePGPWords reads back words produced by EncodePGPWords. Words are
+// matched case-insensitively and may be separated by any whitespace; a word
+// from the wrong list for its position is reported as an error.
+func DecodePGPWords(words string) ([]byte, error) {
+	fields := strings.Fields(words)
+	data := make([]byte, len(fields))
+	for i, field := range fields {
+		word := strings.ToLower(field)
+		value, ok := pgpIndex[i%2][word]
+		if ok {
+			data[i] = value
+			continue
+		}
+
+		if _, wrongList := pgpIndex[1-i%2][word]; wrongList {
+			return nil, fmt.Errorf("PGP word %d %q is out of place: a word is missing, duplicated, or swapped", i+1, field)
+		}
+		return nil, fmt.Errorf("PGP word %d %q is not in the PGP word list", i+1, field)
+	}
+	return data, nil
+}
+
+// EncodePGPFingerprint renders a hex fingerprint, ignoring spaces and
+// colons, in the PGP word list form
+//
+// Example:
+//
+//	EncodePGPFingerprint("E582 94F2 E9A2 2748")
+//	// "topmost Istanbul Pluto vagabond treadmill Pacific brackish dictator"
+func EncodePGPFingerprint(fingerprint string) (string, error) {
+	cleaned := strings.NewReplacer(" ", "", ":", "").Replace(fingerprint)
+	data, err := hex.DecodeString(cleaned)
+	if err != nil {
+		return "", fmt.Errorf("invalid fingerprint: %w", err)
+	}
+	return EncodePGPWords(data), nil
+}
+
+// DecodePGPFingerprint reads PGP words back into an uppercase hex fingerprint
+func DecodePGPFingerprint(words string) (string, error) {
+	data, err := DecodePGPWords(words)
+	if err != nil {
+		return "", err
+	}
+	return strings.ToUpper(hex.EncodeToString(data)), nil
+}
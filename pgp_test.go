@@ -0,0 +1,46 @@
+package memorable_ids
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const pgpTestFingerprint = "E582 94F2 E9A2 2748 6E8B 061B 31CC 528F D7FA 3F19"
+
+const pgpTestWords = "topmost Istanbul Pluto vagabond treadmill Pacific brackish dictator goldfish Medusa " +
+	"afflict bravado chatter revolver Dupont midsummer stopwatch whimsical cowbell bottomless"
+
+func TestPGPWords(t *testing.T) {
+	t.Run("should have 256 distinct words per list", func(t *testing.T) {
+		assert.Len(t, PGPEvenWords, 256)
+		assert.Len(t, PGPOddWords, 256)
+		assert.Len(t, pgpIndex[0], 256)
+		assert.Len(t, pgpIndex[1], 256)
+	})
+
+	t.Run("should encode the reference fingerprint", func(t *testing.T) {
+		words, err := EncodePGPFingerprint(pgpTestFingerprint)
+		require.NoError(t, err)
+		assert.Equal(t, pgpTestWords, words)
+	})
+
+	t.Run("should decode words back case-insensitively", func(t *testing.T) {
+		fingerprint, err := DecodePGPFingerprint(strings.ToLower(pgpTestWords))
+		require.NoError(t, err)
+		assert.Equal(t, strings.ReplaceAll(pgpTestFingerprint, " ", ""), fingerprint)
+	})
+
+	t.Run("should detect dropped and unknown words", func(t *testing.T) {
+		_, err := DecodePGPWords("topmost Pluto vagabond")
+		assert.ErrorContains(t, err, `PGP word 2 "Pluto" is out of place`)
+
+		_, err = DecodePGPWords("topmost rabbit")
+		assert.ErrorContains(t, err, "not in the PGP word list")
+
+		_, err = EncodePGPFingerprint("E58")
+		assert.ErrorContains(t, err, "invalid fingerprint")
+	})
+}
@@ -0,0 +1,118 @@
+package memorable_ids
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Phrase renders a hyphenated ID as a small sentence for confirmation
+// screens. The verb is conjugated for the noun and any suffix is appended
+// after a "#". A trailing part that doesn't belong to the word class of
+// its position is treated as the suffix.
+//
+// Example:
+//
+//	Phrase("cute-rabbit-swim-quietly")    // "the cute rabbit swims quietly"
+//	Phrase("cute-rabbit-042")             // "the cute rabbit #042"
+//	Phrase("cute")                        // "the cute one"
+func Phrase(id string) string {
+	components, suffix := splitPhraseID(id)
+	if len(components) == 0 && suffix == "" {
+		return ""
+	}
+
+	words := []string{"the"}
+	for i, component := range components {
+		if i == 2 {
+			component = conjugate(component)
+		}
+		words = append(words, component)
+	}
+	if len(components) == 1 {
+		words = append(words, "one")
+	}
+	if suffix != "" {
+		words = append(words, "#"+suffix)
+	}
+
+	return strings.Join(words, " ")
+}
+
+// FromPhrase converts a phrase produced by Phrase back into a hyphenated ID
+//
+// Example:
+//
+//	FromPhrase("the cute rabbit swims quietly") // "cute-rabbit-swim-quietly", nil
+//	FromPhrase("The cute rabbit #042.")          // "cute-rabbit-042", nil
+func FromPhrase(phrase string) (string, error) {
+	tokens := strings.Fields(strings.ToLower(strings.TrimRight(strings.TrimSpace(phrase), ".!")))
+	if len(tokens) < 2 || tokens[0] != "the" {
+		return "", errors.New("phrase must start with \"the\" followed by at least one word")
+	}
+	tokens = tokens[1:]
+
+	var suffix string
+	if last := tokens[len(tokens)-1]; strings.HasPrefix(last, "#") {
+		suffix = strings.TrimPrefix(last, "#")
+		tokens = tokens[:len(tokens)-1]
+	}
+	if len(tokens) == 2 && tokens[1] == "one" {
+		tokens = tokens[:1]
+	}
+	if len(tokens) == 0 || len(tokens) > len(wordClasses) {
+		return "", fmt.Errorf("phrase must contain between 1 and %d words", len(wordClasses))
+	}
+
+	if len(tokens) >= 3 {
+		verb, ok := unconjugate(tokens[2])
+		if !ok {
+			return "", fmt.Errorf("unknown verb %q", tokens[2])
+		}
+		tokens[2] = verb
+	}
+
+	if suffix != "" {
+		tokens = append(tokens, suffix)
+	}
+	return strings.Join(tokens, "-"), nil
+}
+
+// splitPhraseID splits a hyphenated ID into components and suffix
+func splitPhraseID(id string) ([]string, string) {
+	if id == "" {
+		return nil, ""
+	}
+
+	parts := strings.Split(id, "-")
+	last := len(parts) - 1
+	if last >= len(wordClasses) || isDigits(parts[last]) || !wordClasses[last].Contains(parts[last]) {
+		return parts[:last], parts[last]
+	}
+	return parts, ""
+}
+
+// conjugate returns the third person singular present form of a verb
+func conjugate(verb string) string {
+	switch {
+	case verb == "":
+		return verb
+	case strings.HasSuffix(verb, "y") && len(verb) > 1 && !strings.ContainsRune("aeiou", rune(verb[len(verb)-2])):
+		return verb[:len(verb)-1] + "ies"
+	case strings.HasSuffix(verb, "s"), strings.HasSuffix(verb, "sh"), strings.HasSuffix(verb, "ch"),
+		strings.HasSuffix(verb, "x"), strings.HasSuffix(verb, "z"), strings.HasSuffix(verb, "o"):
+		return verb + "es"
+	default:
+		return verb + "s"
+	}
+}
+
+// unconjugate maps a conjugated verb back to its dictionary form
+func unconjugate(conjugated string) (string, bool) {
+	for _, verb := range Verbs {
+		if conjugate(verb) == conjugated || verb == conjugated {
+			return verb, true
+		}
+	}
+	return "", false
+}
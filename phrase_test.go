@@ -0,0 +1,59 @@
+package memorable_ids
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPhrase(t *testing.T) {
+	t.Run("should render IDs as sentences", func(t *testing.T) {
+		assert.Equal(t, "the cute rabbit swims quietly", Phrase("cute-rabbit-swim-quietly"))
+		assert.Equal(t, "the cute rabbit flies quietly within", Phrase("cute-rabbit-fly-quietly-within"))
+		assert.Equal(t, "the cute rabbit #042", Phrase("cute-rabbit-042"))
+		assert.Equal(t, "the cute rabbit #5f", Phrase("cute-rabbit-5f"))
+		assert.Equal(t, "the cute one", Phrase("cute"))
+		assert.Equal(t, "", Phrase(""))
+	})
+
+	t.Run("should conjugate verbs", func(t *testing.T) {
+		assert.Equal(t, "goes", conjugate("go"))
+		assert.Equal(t, "kisses", conjugate("kiss"))
+		assert.Equal(t, "cries", conjugate("cry"))
+		assert.Equal(t, "plays", conjugate("play"))
+		assert.Equal(t, "swims", conjugate("swim"))
+	})
+
+	t.Run("should parse phrases back into IDs", func(t *testing.T) {
+		id, err := FromPhrase("The cute rabbit swims quietly.")
+		require.NoError(t, err, "FromPhrase should not fail")
+		assert.Equal(t, "cute-rabbit-swim-quietly", id)
+
+		id, err = FromPhrase("the cute one #042")
+		require.NoError(t, err, "FromPhrase should not fail")
+		assert.Equal(t, "cute-042", id)
+	})
+
+	t.Run("should reject malformed phrases", func(t *testing.T) {
+		_, err := FromPhrase("cute rabbit")
+		assert.Error(t, err)
+
+		_, err = FromPhrase("the cute rabbit zooms")
+		assert.ErrorContains(t, err, "unknown verb")
+	})
+
+	t.Run("should round trip generated IDs", func(t *testing.T) {
+		for components := 1; components <= 5; components++ {
+			id, err := Generate(GenerateOptions{Components: components, Suffix: SuffixGenerators.Number})
+			require.NoError(t, err, "Generate should not fail")
+			if len(Parse(id, "-").Components) != components {
+				continue // skip multi-part words such as "guinea-pig"
+			}
+
+			back, err := FromPhrase(Phrase(id))
+			require.NoError(t, err, "FromPhrase should not fail for %q", Phrase(id))
+			assert.Equal(t, id, back)
+		}
+	})
+}
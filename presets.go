@@ -0,0 +1,46 @@
+package memorable_ids
+
+import (
+	"fmt"
+	"math/rand"
+	"slices"
+	"strings"
+)
+
+// PresetColorAnimal is the name of the color-animal preset, e.g. "crimson-otter"
+const PresetColorAnimal = "color-animal"
+
+// presets maps preset names to their generators
+var presets = map[string]func(separator string) string{
+	PresetColorAnimal: func(separator string) string {
+		return Colors[rand.Intn(len(Colors))] + separator + Animals[rand.Intn(len(Animals))]
+	},
+}
+
+// Presets returns the names of the available presets in sorted order
+func Presets() []string {
+	names := make([]string, 0, len(presets))
+	for name := range presets {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// GeneratePreset creates an ID in the format of a named preset, joined
+// with separator (default: "-")
+//
+// Example:
+//
+//	GeneratePreset(PresetColorAnimal, "")  // "crimson-otter"
+//	GeneratePreset(PresetColorAnimal, " ") // "teal heron"
+func GeneratePreset(name, separator string) (string, error) {
+	generate, ok := presets[name]
+	if !ok {
+		return "", fmt.Errorf("unknown preset %q (expected one of %s)", name, strings.Join(Presets(), ", "))
+	}
+	if separator == "" {
+		separator = "-"
+	}
+	return generate(separator), nil
+}
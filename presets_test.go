@@ -0,0 +1,62 @@
+package memorable_ids
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestColors(t *testing.T) {
+	t.Run("should be a distinct word class", func(t *testing.T) {
+		assert.Equal(t, "color", ClassColor.String())
+		assert.True(t, ClassColor.Contains("crimson"))
+		assert.False(t, ClassNoun.Contains("crimson"))
+
+		class, ok := WordClassOf("teal")
+		assert.True(t, ok)
+		assert.Equal(t, ClassColor, class)
+	})
+
+	t.Run("should not overlap the component classes", func(t *testing.T) {
+		for _, color := range Colors {
+			assert.Equal(t, []WordClass{ClassColor}, GetDictionary().WordClassesOf(color), "Expected %q to only be a color", color)
+		}
+	})
+
+	t.Run("should survive filtering and files", func(t *testing.T) {
+		short := GetDictionary().FilterLength(1, 4)
+		assert.True(t, short.Contains(ClassColor, "teal"))
+		assert.False(t, short.Contains(ClassColor, "crimson"))
+
+		_, ok := ParseWordClass("color")
+		assert.True(t, ok)
+	})
+}
+
+func TestGeneratePreset(t *testing.T) {
+	t.Run("should generate color-animal IDs", func(t *testing.T) {
+		for range 50 {
+			id, err := GeneratePreset(PresetColorAnimal, "")
+			require.NoError(t, err)
+
+			color, animal, ok := strings.Cut(id, "-")
+			require.True(t, ok)
+			assert.Contains(t, Colors, color)
+			assert.Contains(t, Animals, animal)
+		}
+	})
+
+	t.Run("should honor the separator", func(t *testing.T) {
+		id, err := GeneratePreset(PresetColorAnimal, " ")
+		require.NoError(t, err)
+		assert.Contains(t, id, " ")
+	})
+
+	t.Run("should reject unknown presets", func(t *testing.T) {
+		_, err := GeneratePreset("shape-planet", "")
+		assert.ErrorContains(t, err, "unknown preset")
+		assert.Contains(t, Presets(), PresetColorAnimal)
+	})
+}
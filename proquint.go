@@ -0,0 +1,79 @@
+package memorable_ids
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+/**
+ * Proquint codec
+ *
+ * Proquints ("PRO-nounceable QUINT-uplets") spell every 16-bit chunk as a
+ * consonant-vowel-consonant-vowel-consonant syllable group, carrying 4, 2,
+ * 4, 2 and 4 bits respectively. Quints are joined with "-".
+ * See https://arxiv.org/html/0901.4016
+ */
+
+const (
+	proquintConsonants = "bdfghjklmnprstvz"
+	proquintVowels     = "aiou"
+)
+
+// EncodeProquint spells data, which must have an even length, as proquints
+//
+// Example:
+//
+//	EncodeProquint([]byte{127, 0, 0, 1})    // "lusab-babad", nil
+//	EncodeProquint([]byte{63, 84, 220, 193}) // "gutih-tugad", nil
+func EncodeProquint(data []byte) (string, error) {
+	if len(data) == 0 || len(data)%2 != 0 {
+		return "", errors.New("proquint data must be a non-empty, even number of bytes")
+	}
+
+	var b strings.Builder
+	b.Grow(len(data)/2*6 - 1)
+	for i := 0; i < len(data); i += 2 {
+		if i > 0 {
+			b.WriteByte('-')
+		}
+		chunk := uint16(data[i])<<8 | uint16(data[i+1])
+		b.WriteByte(proquintConsonants[chunk>>12&0xf])
+		b.WriteByte(proquintVowels[chunk>>10&0x3])
+		b.WriteByte(proquintConsonants[chunk>>6&0xf])
+		b.WriteByte(proquintVowels[chunk>>4&0x3])
+		b.WriteByte(proquintConsonants[chunk&0xf])
+	}
+	return b.String(), nil
+}
+
+// DecodeProquint reads proquints back into bytes
+//
+// Example:
+//
+//	DecodeProquint("lusab-babad") // []byte{127, 0, 0, 1}, nil
+func DecodeProquint(s string) ([]byte, error) {
+	quints := strings.Split(s, "-")
+	data := make([]byte, 0, len(quints)*2)
+
+	for i, quint := range quints {
+		if len(quint) != 5 {
+			return nil, fmt.Errorf("proquint %d %q must have 5 letters", i+1, quint)
+		}
+
+		var chunk uint16
+		for j := 0; j < 5; j++ {
+			alphabet, bits := proquintConsonants, 4
+			if j%2 == 1 {
+				alphabet, bits = proquintVowels, 2
+			}
+			index := strings.IndexByte(alphabet, quint[j])
+			if index < 0 {
+				return nil, fmt.Errorf("proquint %d %q has invalid letter %q", i+1, quint, quint[j])
+			}
+			chunk = chunk<<bits | uint16(index)
+		}
+		data = append(data, byte(chunk>>8), byte(chunk))
+	}
+	return data, nil
+}
@@ -0,0 +1,52 @@
+package memorable_ids
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProquint(t *testing.T) {
+	t.Run("should match the reference IPv4 examples", func(t *testing.T) {
+		cases := map[string][]byte{
+			"lusab-babad": {127, 0, 0, 1},
+			"gutih-tugad": {63, 84, 220, 193},
+			"gutuk-bisog": {63, 118, 7, 35},
+			"mudof-sakat": {140, 98, 193, 141},
+		}
+
+		for expected, data := range cases {
+			encoded, err := EncodeProquint(data)
+			require.NoError(t, err)
+			assert.Equal(t, expected, encoded)
+
+			decoded, err := DecodeProquint(expected)
+			require.NoError(t, err)
+			assert.Equal(t, data, decoded)
+		}
+	})
+
+	t.Run("should round-trip every 16-bit value", func(t *testing.T) {
+		for value := 0; value <= 0xffff; value++ {
+			data := []byte{byte(value >> 8), byte(value)}
+			encoded, err := EncodeProquint(data)
+			require.NoError(t, err)
+
+			decoded, err := DecodeProquint(encoded)
+			require.NoError(t, err)
+			require.Equal(t, data, decoded)
+		}
+	})
+
+	t.Run("should reject malformed input", func(t *testing.T) {
+		_, err := EncodeProquint([]byte{1, 2, 3})
+		assert.Error(t, err)
+
+		_, err = DecodeProquint("lusab-baba")
+		assert.ErrorContains(t, err, "must have 5 letters")
+
+		_, err = DecodeProquint("lusac")
+		assert.ErrorContains(t, err, "invalid letter")
+	})
+}
@@ -0,0 +1,215 @@
+package memorable_ids
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// PseudonymOptions contains configuration for a Pseudonymizer
+type PseudonymOptions struct {
+	// Components is the number of word components (1-5, default: 3)
+	Components int
+	// Digits is the length of the numeric suffix (0-9, default: 3)
+	Digits int
+	// Separator between parts (default: "-")
+	Separator string
+}
+
+// PseudonymEntry is one subject→alias pair of an exported mapping
+type PseudonymEntry struct {
+	Subject string `json:"subject"`
+	Alias   string `json:"alias"`
+}
+
+// Pseudonymizer deterministically replaces subject identifiers (emails,
+// user IDs, ...) with memorable aliases derived from a secret key, and
+// remembers the mapping so authorized analysts can export it and join
+// pseudonymized datasets back. A Pseudonymizer is safe for concurrent use.
+//
+// Example:
+//
+//	p, _ := NewPseudonymizer(secret, PseudonymOptions{})
+//	p.Alias("alice@example.com") // "brave-otter-sing-207"
+//	p.ExportCSV(file)            // subject,alias rows
+type Pseudonymizer struct {
+	key     []byte
+	options PseudonymOptions
+
+	mu       sync.RWMutex
+	aliases  map[string]string
+	subjects map[string]string
+}
+
+// NewPseudonymizer creates a Pseudonymizer using key as the HMAC secret
+func NewPseudonymizer(key []byte, options PseudonymOptions) (*Pseudonymizer, error) {
+	if len(key) == 0 {
+		return nil, errors.New("pseudonymizer key must not be empty")
+	}
+	if options.Components == 0 {
+		options.Components = 3
+	}
+	if options.Digits == 0 {
+		options.Digits = 3
+	}
+	if options.Separator == "" {
+		options.Separator = "-"
+	}
+	if options.Components < 1 || options.Components > 5 {
+		return nil, errors.New("components must be between 1 and 5")
+	}
+	if options.Digits < 0 || options.Digits > 9 {
+		return nil, errors.New("digits must be between 0 and 9")
+	}
+
+	return &Pseudonymizer{
+		key:      slices.Clone(key),
+		options:  options,
+		aliases:  make(map[string]string),
+		subjects: make(map[string]string),
+	}, nil
+}
+
+// Alias returns the alias of subject and records the pair in the mapping.
+// If the derived alias already belongs to another subject (a hash
+// collision), disambiguating digits are appended, e.g. "brave-otter-sing-207-2".
+func (p *Pseudonymizer) Alias(subject string) string {
+	p.mu.RLock()
+	alias, ok := p.aliases[subject]
+	p.mu.RUnlock()
+	if ok {
+		return alias
+	}
+
+	mac := hmac.New(sha256.New, p.key)
+	mac.Write([]byte(subject))
+	alias = nameFromHash(GetDictionary(), mac.Sum(nil), p.options.Components, p.options.Digits, p.options.Separator)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	// Another goroutine may have recorded the subject meanwhile
+	if recorded, ok := p.aliases[subject]; ok {
+		return recorded
+	}
+	candidate := alias
+	for n := 2; ; n++ {
+		if _, taken := p.subjects[candidate]; !taken {
+			break
+		}
+		candidate = fmt.Sprintf("%s%s%d", alias, p.options.Separator, n)
+	}
+
+	p.aliases[subject] = candidate
+	p.subjects[candidate] = subject
+	return candidate
+}
+
+// Subject returns the subject behind an alias, if it is in the mapping
+func (p *Pseudonymizer) Subject(alias string) (string, bool) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	subject, ok := p.subjects[alias]
+	return subject, ok
+}
+
+// Entries returns the recorded mapping sorted by subject
+func (p *Pseudonymizer) Entries() []PseudonymEntry {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	entries := make([]PseudonymEntry, 0, len(p.aliases))
+	for subject, alias := range p.aliases {
+		entries = append(entries, PseudonymEntry{Subject: subject, Alias: alias})
+	}
+	slices.SortFunc(entries, func(a, b PseudonymEntry) int {
+		return strings.Compare(a.Subject, b.Subject)
+	})
+	return entries
+}
+
+// ExportJSON writes the mapping as a JSON array of {"subject", "alias"} objects
+func (p *Pseudonymizer) ExportJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(p.Entries())
+}
+
+// ExportCSV writes the mapping as CSV with a "subject,alias" header
+func (p *Pseudonymizer) ExportCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write([]string{"subject", "alias"}); err != nil {
+		return err
+	}
+	for _, entry := range p.Entries() {
+		if err := writer.Write([]string{entry.Subject, entry.Alias}); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// ImportJSON merges a mapping previously written by ExportJSON
+func (p *Pseudonymizer) ImportJSON(r io.Reader) error {
+	var entries []PseudonymEntry
+	if err := json.NewDecoder(r).Decode(&entries); err != nil {
+		return fmt.Errorf("decode pseudonym mapping: %w", err)
+	}
+	return p.importEntries(entries)
+}
+
+// ImportCSV merges a mapping previously written by ExportCSV
+func (p *Pseudonymizer) ImportCSV(r io.Reader) error {
+	records, err := csv.NewReader(r).ReadAll()
+	if err != nil {
+		return fmt.Errorf("decode pseudonym mapping: %w", err)
+	}
+	if len(records) == 0 || !slices.Equal(records[0], []string{"subject", "alias"}) {
+		return errors.New("decode pseudonym mapping: missing subject,alias header")
+	}
+
+	entries := make([]PseudonymEntry, 0, len(records)-1)
+	for _, record := range records[1:] {
+		entries = append(entries, PseudonymEntry{Subject: record[0], Alias: record[1]})
+	}
+	return p.importEntries(entries)
+}
+
+// importEntries merges entries, rejecting pairs that contradict the
+// mapping or each other
+func (p *Pseudonymizer) importEntries(entries []PseudonymEntry) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	aliases := make(map[string]string, len(entries))
+	subjects := make(map[string]string, len(entries))
+	for _, entry := range entries {
+		if alias, ok := p.aliases[entry.Subject]; ok && alias != entry.Alias {
+			return fmt.Errorf("subject %q is already mapped to %q", entry.Subject, alias)
+		}
+		if subject, ok := p.subjects[entry.Alias]; ok && subject != entry.Subject {
+			return fmt.Errorf("alias %q is already mapped to %q", entry.Alias, subject)
+		}
+		if alias, ok := aliases[entry.Subject]; ok && alias != entry.Alias {
+			return fmt.Errorf("subject %q is mapped to both %q and %q", entry.Subject, alias, entry.Alias)
+		}
+		if subject, ok := subjects[entry.Alias]; ok && subject != entry.Subject {
+			return fmt.Errorf("alias %q is mapped to both %q and %q", entry.Alias, subject, entry.Subject)
+		}
+		aliases[entry.Subject] = entry.Alias
+		subjects[entry.Alias] = entry.Subject
+	}
+	for _, entry := range entries {
+		p.aliases[entry.Subject] = entry.Alias
+		p.subjects[entry.Alias] = entry.Subject
+	}
+	return nil
+}
@@ -0,0 +1,105 @@
+package memorable_ids
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPseudonymizer(t *testing.T) {
+	newPseudonymizer := func(t *testing.T, key string) *Pseudonymizer {
+		p, err := NewPseudonymizer([]byte(key), PseudonymOptions{})
+		require.NoError(t, err, "NewPseudonymizer should not fail")
+		return p
+	}
+
+	t.Run("should derive stable aliases per key", func(t *testing.T) {
+		a := newPseudonymizer(t, "secret")
+		b := newPseudonymizer(t, "secret")
+		c := newPseudonymizer(t, "other")
+
+		alias := a.Alias("alice@example.com")
+		assert.Equal(t, alias, b.Alias("alice@example.com"))
+		assert.NotEqual(t, alias, c.Alias("alice@example.com"))
+		assert.Regexp(t, `-\d{3}$`, alias)
+	})
+
+	t.Run("should round trip the mapping through JSON", func(t *testing.T) {
+		source := newPseudonymizer(t, "secret")
+		source.Alias("alice")
+		source.Alias("bob")
+
+		var buf bytes.Buffer
+		require.NoError(t, source.ExportJSON(&buf))
+
+		target := newPseudonymizer(t, "unrelated")
+		require.NoError(t, target.ImportJSON(&buf))
+
+		subject, ok := target.Subject(source.Alias("bob"))
+		assert.True(t, ok)
+		assert.Equal(t, "bob", subject)
+		assert.Equal(t, source.Entries(), target.Entries())
+	})
+
+	t.Run("should round trip the mapping through CSV", func(t *testing.T) {
+		source := newPseudonymizer(t, "secret")
+		source.Alias("carol, the admin")
+
+		var buf bytes.Buffer
+		require.NoError(t, source.ExportCSV(&buf))
+		assert.True(t, strings.HasPrefix(buf.String(), "subject,alias\n"))
+
+		target := newPseudonymizer(t, "secret")
+		require.NoError(t, target.ImportCSV(&buf))
+		assert.Equal(t, source.Entries(), target.Entries())
+	})
+
+	t.Run("should reject contradicting imports", func(t *testing.T) {
+		p := newPseudonymizer(t, "secret")
+		p.Alias("alice")
+
+		err := p.ImportJSON(strings.NewReader(`[{"subject": "alice", "alias": "cute-rabbit-swim-001"}]`))
+		assert.ErrorContains(t, err, "already mapped")
+
+		err = p.ImportCSV(strings.NewReader("name,pseudonym\n"))
+		assert.ErrorContains(t, err, "header")
+	})
+
+	t.Run("should reject imports contradicting themselves", func(t *testing.T) {
+		p := newPseudonymizer(t, "secret")
+
+		err := p.ImportCSV(strings.NewReader("subject,alias\nbob,cute-rabbit-swim-001\ncarol,cute-rabbit-swim-001\n"))
+		assert.ErrorContains(t, err, "mapped to both")
+
+		err = p.ImportCSV(strings.NewReader("subject,alias\nbob,cute-rabbit-swim-001\nbob,cute-rabbit-swim-002\n"))
+		assert.ErrorContains(t, err, "mapped to both")
+		assert.Empty(t, p.Entries())
+	})
+
+	t.Run("should disambiguate aliases colliding with another subject", func(t *testing.T) {
+		alias := newPseudonymizer(t, "secret").Alias("alice")
+
+		p := newPseudonymizer(t, "secret")
+		require.NoError(t, p.ImportJSON(strings.NewReader(`[{"subject": "mallory", "alias": "`+alias+`"}]`)))
+
+		assert.Equal(t, alias+"-2", p.Alias("alice"))
+		assert.Equal(t, alias+"-2", p.Alias("alice"))
+
+		subject, ok := p.Subject(alias)
+		require.True(t, ok)
+		assert.Equal(t, "mallory", subject)
+		subject, _ = p.Subject(alias + "-2")
+		assert.Equal(t, "alice", subject)
+	})
+
+	t.Run("should reject invalid configuration", func(t *testing.T) {
+		_, err := NewPseudonymizer(nil, PseudonymOptions{})
+		assert.Error(t, err)
+
+		_, err = NewPseudonymizer([]byte("k"), PseudonymOptions{Components: 9})
+		assert.Error(t, err)
+	})
+}
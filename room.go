@@ -0,0 +1,117 @@
+package memorable_ids
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"strings"
+)
+
+// RoomCodeOptions contains configuration for GenerateRoomCode
+type RoomCodeOptions struct {
+	// Available reports whether a code is still free, e.g. by querying the
+	// room service (required)
+	Available func(code string) (bool, error)
+	// Components is the initial number of word components (1-5, default: 2)
+	Components int
+	// AttemptsPerLevel is the number of taken codes tolerated before
+	// escalating to a longer code (default: 3)
+	AttemptsPerLevel int
+	// Separator between parts (default: "-")
+	Separator string
+}
+
+// RoomCode is a generated room or channel code
+type RoomCode struct {
+	// Code is the generated code, e.g. "cute-rabbit-042"
+	Code string
+	// Spoken is the code as read aloud, e.g. "cute rabbit zero four two"
+	Spoken string
+	// Components is the number of word components the code ended up with
+	Components int
+	// Attempts is the number of codes tried, including the returned one
+	Attempts int
+}
+
+// GenerateRoomCode generates a meeting or room code that the Available
+// callback accepts. Codes start with the configured number of words and a
+// 3-digit suffix; whenever AttemptsPerLevel codes in a row are taken, the
+// code grows by one word, and past 5 words the suffix grows to 4 digits.
+// ErrSpaceExhausted is returned when even the longest codes keep colliding.
+//
+// Example:
+//
+//	room, _ := GenerateRoomCode(RoomCodeOptions{Available: rooms.IsFree})
+//	room.Code   // "cute-rabbit-042"
+//	room.Spoken // "cute rabbit zero four two"
+func GenerateRoomCode(options RoomCodeOptions) (RoomCode, error) {
+	if options.Available == nil {
+		return RoomCode{}, errors.New("room code availability callback is required")
+	}
+	if options.Components == 0 {
+		options.Components = 2
+	}
+	if options.Components < 1 || options.Components > 5 {
+		return RoomCode{}, errors.New("components must be between 1 and 5")
+	}
+	if options.AttemptsPerLevel < 1 {
+		options.AttemptsPerLevel = 3
+	}
+
+	generateOptions := GenerateOptions{
+		Components: options.Components,
+		Suffix:     SuffixGenerators.Number,
+		Separator:  options.Separator,
+	}
+
+	attempts := 0
+	wideSuffix := false
+	for {
+		for i := 0; i < options.AttemptsPerLevel; i++ {
+			code, err := generate(GetDictionary(), generateOptions, rand.Intn)
+			if err != nil {
+				return RoomCode{}, err
+			}
+			attempts++
+
+			available, err := options.Available(code)
+			if err != nil {
+				return RoomCode{}, fmt.Errorf("checking room code availability: %w", err)
+			}
+			if available {
+				return RoomCode{
+					Code:       code,
+					Spoken:     speakID(code),
+					Components: generateOptions.Components,
+					Attempts:   attempts,
+				}, nil
+			}
+		}
+
+		// High contention: escalate entropy
+		switch {
+		case generateOptions.Components < 5:
+			generateOptions.Components++
+		case !wideSuffix:
+			generateOptions.Suffix = SuffixGenerators.Number4
+			wideSuffix = true
+		default:
+			return RoomCode{}, fmt.Errorf("no available room code after %d attempts: %w", attempts, ErrSpaceExhausted)
+		}
+	}
+}
+
+// speakID renders an ID as read aloud: words as they are, digits by name
+func speakID(id string) string {
+	var spoken []string
+	for _, part := range splitWords(id) {
+		if !isDigits(part) {
+			spoken = append(spoken, part)
+			continue
+		}
+		for _, r := range part {
+			spoken = append(spoken, digitNames[r-'0'])
+		}
+	}
+	return strings.Join(spoken, " ")
+}
@@ -0,0 +1,56 @@
+package memorable_ids
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateRoomCode(t *testing.T) {
+	t.Run("should return the first available code", func(t *testing.T) {
+		room, err := GenerateRoomCode(RoomCodeOptions{Available: func(string) (bool, error) { return true, nil }})
+		require.NoError(t, err)
+
+		parts := strings.Split(room.Code, "-")
+		assert.Regexp(t, `^\d{3}$`, parts[len(parts)-1])
+		assert.Equal(t, 2, room.Components)
+		assert.Equal(t, 1, room.Attempts)
+		assert.True(t, strings.HasPrefix(room.Spoken, parts[0]+" "))
+		assert.NotContains(t, room.Spoken, "-")
+	})
+
+	t.Run("should escalate entropy under contention", func(t *testing.T) {
+		calls := 0
+		room, err := GenerateRoomCode(RoomCodeOptions{
+			AttemptsPerLevel: 2,
+			Available: func(string) (bool, error) {
+				calls++
+				return calls > 4, nil
+			},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 4, room.Components)
+		assert.Equal(t, 5, room.Attempts)
+	})
+
+	t.Run("should give up when every code is taken", func(t *testing.T) {
+		_, err := GenerateRoomCode(RoomCodeOptions{Available: func(string) (bool, error) { return false, nil }})
+		assert.ErrorIs(t, err, ErrSpaceExhausted)
+	})
+
+	t.Run("should propagate callback errors", func(t *testing.T) {
+		failure := errors.New("room service down")
+		_, err := GenerateRoomCode(RoomCodeOptions{Available: func(string) (bool, error) { return false, failure }})
+		assert.ErrorIs(t, err, failure)
+
+		_, err = GenerateRoomCode(RoomCodeOptions{})
+		assert.Error(t, err)
+	})
+
+	t.Run("should speak digits by name", func(t *testing.T) {
+		assert.Equal(t, "cute rabbit zero four two", speakID("cute-rabbit-042"))
+	})
+}
@@ -0,0 +1,134 @@
+package memorable_ids
+
+import (
+	"fmt"
+	"log/slog"
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// RotationPeriod is the length of the time buckets of a RotatingNamespace
+type RotationPeriod int
+
+const (
+	// RotateDaily starts a new bucket at midnight UTC
+	RotateDaily RotationPeriod = iota
+	// RotateWeekly starts a new bucket on Monday at midnight UTC (ISO weeks)
+	RotateWeekly
+)
+
+// Bucket returns the name of the bucket containing t
+//
+// Example:
+//
+//	RotateDaily.Bucket(time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC))  // "20261016"
+//	RotateWeekly.Bucket(time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)) // "2026w42"
+func (p RotationPeriod) Bucket(t time.Time) string {
+	t = t.UTC()
+	if p == RotateWeekly {
+		year, week := t.ISOWeek()
+		return fmt.Sprintf("%04dw%02d", year, week)
+	}
+	return t.Format("20060102")
+}
+
+// String returns the period name
+func (p RotationPeriod) String() string {
+	if p == RotateWeekly {
+		return "weekly"
+	}
+	return "daily"
+}
+
+// RotatingNamespace scopes uniqueness to a rotating time bucket, so
+// short-lived resources can reuse the name space once their window has
+// passed. Each bucket gets a fresh store, and the store of the previous
+// bucket is released. Set EncodeBucket to append the bucket to every ID,
+// which keeps IDs from different windows distinct. The zero value issues
+// IDs unique per UTC day and is safe for concurrent use.
+//
+// Example:
+//
+//	previews := &RotatingNamespace{Period: RotateWeekly, EncodeBucket: true}
+//	previews.Generate() // "cute-rabbit-2026w42", unique within the week
+type RotatingNamespace struct {
+	// Options are the generation options of every ID
+	Options GenerateOptions
+	// Dictionary overrides the built-in dictionary (default: nil)
+	Dictionary *Dictionary
+	// Period is the bucket length (default: RotateDaily)
+	Period RotationPeriod
+	// EncodeBucket appends the bucket name to IDs after the options
+	// separator (default: false)
+	EncodeBucket bool
+	// NewStore creates the uniqueness store of a bucket (default: in-memory store)
+	NewStore func(bucket string) Store
+	// Clock decides the current bucket (default: SystemClock)
+	Clock Clock
+	// MaxAttempts is the number of re-rolls before giving up on a unique ID (default: 100)
+	MaxAttempts int
+	// Logger receives retries, rotations and store errors, with a "bucket"
+	// attribute (default: nil, no logging)
+	Logger *slog.Logger
+
+	mu     sync.Mutex
+	bucket string
+	store  Store
+}
+
+// Bucket returns the name of the current bucket
+func (n *RotatingNamespace) Bucket() string {
+	return n.Period.Bucket(clockOr(n.Clock).Now())
+}
+
+// Generate creates an ID that is unique within the current bucket
+func (n *RotatingNamespace) Generate() (string, error) {
+	bucket, store := n.current()
+
+	dict := GetDictionary()
+	if n.Dictionary != nil {
+		dict = *n.Dictionary
+	}
+	separator := n.Options.Separator
+	if separator == "" {
+		separator = "-"
+	}
+
+	logger := loggerOr(n.Logger).With(slog.String("bucket", bucket))
+	id, err := reserveUnique(logger, store, n.MaxAttempts, func() (string, error) {
+		id, err := generate(dict, n.Options, rand.Intn)
+		if err != nil || !n.EncodeBucket {
+			return id, err
+		}
+		return id + separator + bucket, nil
+	})
+	if err != nil {
+		return "", fmt.Errorf("bucket %q: %w", bucket, err)
+	}
+	return id, nil
+}
+
+// current returns the current bucket and its store, rotating the store
+// when the bucket has changed
+func (n *RotatingNamespace) current() (string, Store) {
+	bucket := n.Bucket()
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if n.store == nil || n.bucket != bucket {
+		if n.store != nil {
+			loggerOr(n.Logger).Info("memorable ID bucket rotated",
+				slog.String("from", n.bucket),
+				slog.String("bucket", bucket))
+		}
+		n.bucket = bucket
+		if n.NewStore != nil {
+			n.store = n.NewStore(bucket)
+		} else {
+			n.store = NewMemoryStore()
+		}
+	}
+	return n.bucket, n.store
+}
@@ -0,0 +1,74 @@
+package memorable_ids
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRotationPeriod(t *testing.T) {
+	t.Run("should name daily and weekly buckets", func(t *testing.T) {
+		friday := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+		assert.Equal(t, "20261016", RotateDaily.Bucket(friday))
+		assert.Equal(t, "2026w42", RotateWeekly.Bucket(friday))
+	})
+
+	t.Run("should bucket in UTC", func(t *testing.T) {
+		tokyo := time.FixedZone("JST", 9*60*60)
+		assert.Equal(t, "20261015", RotateDaily.Bucket(time.Date(2026, 10, 16, 8, 0, 0, 0, tokyo)))
+	})
+
+	t.Run("should use ISO weeks across year boundaries", func(t *testing.T) {
+		assert.Equal(t, "2026w53", RotateWeekly.Bucket(time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)))
+	})
+}
+
+func TestRotatingNamespace(t *testing.T) {
+	now := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+	clock := ClockFunc(func() time.Time { return now })
+
+	t.Run("should reset uniqueness when the bucket rotates", func(t *testing.T) {
+		buckets := []string{}
+		namespace := &RotatingNamespace{
+			Options:     GenerateOptions{Components: 1},
+			Clock:       clock,
+			MaxAttempts: 100000,
+			NewStore: func(bucket string) Store {
+				buckets = append(buckets, bucket)
+				return NewMemoryStore()
+			},
+		}
+
+		seen := make(map[string]bool)
+		for range len(Adjectives) {
+			id, err := namespace.Generate()
+			require.NoError(t, err)
+			assert.False(t, seen[id])
+			seen[id] = true
+		}
+		_, err := namespace.Generate()
+		assert.ErrorIs(t, err, ErrSpaceExhausted)
+
+		now = now.Add(24 * time.Hour)
+		_, err = namespace.Generate()
+		require.NoError(t, err)
+		assert.Equal(t, []string{"20261016", "20261017"}, buckets)
+	})
+
+	t.Run("should encode the bucket in IDs", func(t *testing.T) {
+		namespace := &RotatingNamespace{
+			Options:      GenerateOptions{Separator: "_"},
+			Period:       RotateWeekly,
+			EncodeBucket: true,
+			Clock:        clock,
+		}
+
+		id, err := namespace.Generate()
+		require.NoError(t, err)
+		assert.True(t, strings.HasSuffix(id, "_"+namespace.Bucket()), id)
+		assert.Len(t, strings.Split(id, "_"), 3)
+	})
+}
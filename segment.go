@@ -0,0 +1,192 @@
+package memorable_ids
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// ErrNoSegmentation is returned when a separator-less ID can't be split into dictionary words
+var ErrNoSegmentation = errors.New("no dictionary segmentation")
+
+// SegmentOptions contains configuration for Segment and SegmentAll
+type SegmentOptions struct {
+	// Dictionary the ID was generated from (default: built-in dictionary)
+	Dictionary *Dictionary
+	// Components is the expected number of words; 0 accepts 1 to 5
+	// (default: 0)
+	Components int
+	// Suffix is the generator the IDs were created with; when it has a
+	// spec, a trailing suffix must match it, otherwise trailing digits are
+	// taken as the suffix (default: nil)
+	Suffix SuffixGenerator
+	// SuffixProvider is the provider the IDs were created with, used like
+	// Suffix; it overrides Suffix (default: nil)
+	SuffixProvider SuffixProvider
+	// CaseInsensitive accepts IDs with any casing (default: false)
+	CaseInsensitive bool
+}
+
+// Segment splits an ID whose separators were stripped, such as
+// "cuterabbit042", into its components by backtracking over the positional
+// word classes. When several splits are possible, the one taking the
+// longest words first is returned. The ParsedID has an empty Separator.
+//
+// Example:
+//
+//	Segment("cuterabbit042", SegmentOptions{})
+//	// ParsedID{Components: ["cute", "rabbit"], Suffix: "042"}, nil
+//	Segment("largefoxswim", SegmentOptions{})
+//	// ParsedID{Components: ["large", "fox", "swim"], Suffix: nil}, nil
+func Segment(id string, options SegmentOptions) (ParsedID, error) {
+	var first ParsedID
+	found := false
+	segment(id, options, func(parsed ParsedID) bool {
+		first, found = parsed, true
+		return false
+	})
+	if !found {
+		return ParsedID{}, fmt.Errorf("%w: %q", ErrNoSegmentation, id)
+	}
+	return first, nil
+}
+
+// SegmentAll returns every way of splitting a separator-less ID into
+// components, in the order Segment prefers them, for callers resolving
+// ambiguous inputs against other data such as a set of issued IDs
+func SegmentAll(id string, options SegmentOptions) []ParsedID {
+	var all []ParsedID
+	segment(id, options, func(parsed ParsedID) bool {
+		all = append(all, parsed)
+		return true
+	})
+	return all
+}
+
+// segment calls yield with each segmentation of id until it returns false
+func segment(id string, options SegmentOptions, yield func(ParsedID) bool) {
+	if options.CaseInsensitive {
+		id = Canonicalize(id)
+	}
+	dict := GetDictionary()
+	if options.Dictionary != nil {
+		dict = *options.Dictionary
+	}
+	minComponents, maxComponents := 1, len(wordClasses)
+	if options.Components > 0 {
+		minComponents, maxComponents = options.Components, options.Components
+	}
+
+	joined := joinedWords(dict)
+	spec, hasSpec := suffixSpecOf(options.SuffixProvider, options.Suffix)
+	for _, split := range suffixSplits(id, spec, hasSpec) {
+		s := segmenter{
+			dict:          dict,
+			joined:        joined,
+			minComponents: minComponents,
+			maxComponents: maxComponents,
+			suffix:        split.suffix,
+			yield:         yield,
+		}
+		if hasSpec && split.suffix != nil {
+			s.suffixKind = suffixKindOf(spec.Name)
+		} else if split.suffix != nil {
+			s.suffixKind = DetectSuffixKind(*split.suffix)
+		}
+		if !s.search(split.words, nil) {
+			return
+		}
+	}
+}
+
+// suffixSplit is a candidate division of an ID into words and a suffix
+type suffixSplit struct {
+	words  string
+	suffix *string
+}
+
+// suffixSplits returns the candidate word/suffix divisions of id, those
+// with a suffix first; suffixes must match spec when hasSpec is set
+func suffixSplits(id string, spec SuffixSpec, hasSpec bool) []suffixSplit {
+	var splits []suffixSplit
+	if hasSpec {
+		// Suffix lengths are in characters; cuts[n] is the start of the last n
+		cuts := []int{len(id)}
+		for cut := len(id); cut > 0 && len(cuts) <= spec.MaxLength; {
+			_, size := utf8.DecodeLastRuneInString(id[:cut])
+			cut -= size
+			cuts = append(cuts, cut)
+		}
+		for length := len(cuts) - 1; length >= max(spec.MinLength, 1); length-- {
+			cut := cuts[length]
+			suffix := id[cut:]
+			if cut > 0 && spec.Matches(suffix) {
+				splits = append(splits, suffixSplit{words: id[:cut], suffix: &suffix})
+			}
+		}
+	} else if words := strings.TrimRight(id, "0123456789"); words != id && words != "" {
+		suffix := id[len(words):]
+		return []suffixSplit{{words: words, suffix: &suffix}}
+	}
+	return append(splits, suffixSplit{words: id})
+}
+
+// joinedWord is a dictionary word containing the separator "-", such as
+// "guinea-pig", with the separator stripped like in the segmented ID
+type joinedWord struct {
+	stripped, word string
+}
+
+// joinedWords returns the words containing "-" of each positional class
+func joinedWords(dict Dictionary) [][]joinedWord {
+	joined := make([][]joinedWord, len(wordClasses))
+	for i, class := range wordClasses {
+		for _, word := range dict.Words(class) {
+			if strings.Contains(word, "-") {
+				joined[i] = append(joined[i], joinedWord{stripped: strings.ReplaceAll(word, "-", ""), word: word})
+			}
+		}
+	}
+	return joined
+}
+
+// segmenter backtracks over the positional word classes
+type segmenter struct {
+	dict          Dictionary
+	joined        [][]joinedWord
+	minComponents int
+	maxComponents int
+	suffix        *string
+	suffixKind    SuffixKind
+	yield         func(ParsedID) bool
+}
+
+// search extends words with the next component read from rest, reporting
+// false once yield asked to stop
+func (s *segmenter) search(rest string, words []string) bool {
+	if rest == "" {
+		if len(words) < s.minComponents {
+			return true
+		}
+		return s.yield(ParsedID{Components: append([]string(nil), words...), Suffix: s.suffix, SuffixKind: s.suffixKind})
+	}
+	position := len(words)
+	if position >= s.maxComponents {
+		return true
+	}
+
+	for _, length := range s.dict.trieFor(wordClasses[position]).prefixLengths(rest) {
+		if !s.search(rest[length:], append(words, rest[:length])) {
+			return false
+		}
+	}
+	for _, joined := range s.joined[position] {
+		if strings.HasPrefix(rest, joined.stripped) {
+			if !s.search(rest[len(joined.stripped):], append(words, joined.word)) {
+				return false
+			}
+		}
+	}
+	return true
+}
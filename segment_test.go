@@ -0,0 +1,77 @@
+package memorable_ids
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSegment(t *testing.T) {
+	t.Run("should split concatenated words and a numeric suffix", func(t *testing.T) {
+		parsed, err := Segment("cuterabbit042", SegmentOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"cute", "rabbit"}, parsed.Components)
+		require.NotNil(t, parsed.Suffix)
+		assert.Equal(t, "042", *parsed.Suffix)
+		assert.Empty(t, parsed.Separator)
+	})
+
+	t.Run("should round-trip generated IDs with separators stripped", func(t *testing.T) {
+		for range 200 {
+			id, err := Generate(GenerateOptions{Components: 3, Suffix: SuffixGenerators.Number})
+			require.NoError(t, err)
+
+			segmentations := SegmentAll(strings.ReplaceAll(id, "-", ""), SegmentOptions{Components: 3})
+			joined := make([]string, len(segmentations))
+			for i, parsed := range segmentations {
+				joined[i] = strings.Join(parsed.Components, "-") + "-" + *parsed.Suffix
+			}
+			assert.Contains(t, joined, id)
+		}
+	})
+
+	t.Run("should use the suffix spec", func(t *testing.T) {
+		parsed, err := Segment("largefoxff", SegmentOptions{Suffix: SuffixGenerators.Hex})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"large", "fox"}, parsed.Components)
+		assert.Equal(t, "ff", *parsed.Suffix)
+
+		emoji := SuffixSpec{Name: "emoji", Range: 2, Charset: "🦊🐇", MinLength: 1, MaxLength: 1}
+		provider := NewSuffixProvider(func() *string { return nil }, emoji)
+		parsed, err = Segment("largefox🦊", SegmentOptions{SuffixProvider: provider})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"large", "fox"}, parsed.Components)
+		assert.Equal(t, "🦊", *parsed.Suffix)
+	})
+
+	t.Run("should accept any casing when asked", func(t *testing.T) {
+		parsed, err := Segment("CuteRabbit", SegmentOptions{CaseInsensitive: true})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"cute", "rabbit"}, parsed.Components)
+		assert.Nil(t, parsed.Suffix)
+	})
+
+	t.Run("should backtrack over dead-end prefixes", func(t *testing.T) {
+		dict := NewDictionary([]string{"red", "redo"}, []string{"owl", "dowl"}, nil, nil, nil)
+
+		parsed, err := Segment("redowl", SegmentOptions{Dictionary: &dict})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"red", "owl"}, parsed.Components)
+	})
+
+	t.Run("should return every split of ambiguous IDs, longest words first", func(t *testing.T) {
+		dict := NewDictionary([]string{"red", "redo"}, []string{"owl", "wl"}, nil, nil, nil)
+
+		all := SegmentAll("redowl", SegmentOptions{Dictionary: &dict})
+		require.Len(t, all, 2)
+		assert.Equal(t, []string{"redo", "wl"}, all[0].Components)
+		assert.Equal(t, []string{"red", "owl"}, all[1].Components)
+	})
+
+	t.Run("should fail when no split exists", func(t *testing.T) {
+		_, err := Segment("cutexyzzy", SegmentOptions{})
+		assert.ErrorIs(t, err, ErrNoSegmentation)
+	})
+}
@@ -0,0 +1,124 @@
+package memorable_ids
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// SeparatorConflicts returns the words of the component classes that
+// contain separator, which makes IDs using them ambiguous to split
+//
+// Example:
+//
+//	GetDictionary().SeparatorConflicts("-") // ["guinea-pig"]
+//	GetDictionary().SeparatorConflicts("a") // ["dapper", "large", ...]
+func (d Dictionary) SeparatorConflicts(separator string) []string {
+	var conflicts []string
+	if separator == "" {
+		return conflicts
+	}
+	for _, class := range wordClasses {
+		for _, word := range d.Words(class) {
+			if strings.Contains(word, separator) {
+				conflicts = append(conflicts, word)
+			}
+		}
+	}
+	return conflicts
+}
+
+// ambiguousConflicts returns the separator conflicts that splitting an ID
+// back into words can't resolve: words with an empty part, and words whose
+// leading parts form another word of the same class. Other conflicts, such
+// as "guinea-pig", are recovered by splitClassWords.
+func (d Dictionary) ambiguousConflicts(separator string, classes []WordClass) []string {
+	var ambiguous []string
+	if separator == "" {
+		return ambiguous
+	}
+	for _, class := range uniqueClasses(classes) {
+		for _, word := range d.Words(class) {
+			parts := strings.Split(word, separator)
+			if len(parts) == 1 {
+				continue
+			}
+			for i := 1; i <= len(parts); i++ {
+				if parts[i-1] == "" || i < len(parts) && d.Contains(class, strings.Join(parts[:i], separator)) {
+					ambiguous = append(ambiguous, word)
+					break
+				}
+			}
+		}
+	}
+	return ambiguous
+}
+
+// uniqueClasses returns classes without repetitions, in order
+func uniqueClasses(classes []WordClass) []WordClass {
+	var unique []WordClass
+	for _, class := range classes {
+		if !slices.Contains(unique, class) {
+			unique = append(unique, class)
+		}
+	}
+	return unique
+}
+
+// SelfTest checks that the package is fit to serve IDs, for use in
+// startup and readiness probes of naming services. It validates the active
+// dictionary (no empty or duplicate words, no words containing the
+// default separator that make IDs ambiguous to split, class sizes
+// unchanged since startup), exercises every
+// built-in suffix generator against its spec, and round-trips
+// Generate→Parse for every component count. All problems are reported.
+//
+// Example:
+//
+//	if err := SelfTest(); err != nil {
+//	  log.Fatalf("naming service unhealthy: %v", err)
+//	}
+func SelfTest() error {
+	var problems []error
+
+	dict := GetDictionary()
+	if err := dict.Validate(); err != nil {
+		problems = append(problems, err)
+	}
+	if conflicts := dict.ambiguousConflicts("-", wordClasses); len(conflicts) > 0 {
+		problems = append(problems, fmt.Errorf("words containing the separator %q are ambiguous: %v", "-", conflicts))
+	}
+	if dict.Stats != builtinStats {
+		problems = append(problems, fmt.Errorf("dictionary sizes changed since startup: %+v, expected %+v", dict.Stats, builtinStats))
+	}
+
+	for _, info := range builtinSuffixes {
+		suffix := info.generator()
+		switch {
+		case suffix == nil:
+			problems = append(problems, fmt.Errorf("suffix %s returned nil", info.name))
+		case !info.spec().Matches(*suffix):
+			problems = append(problems, fmt.Errorf("suffix %s returned %q, outside its spec", info.name, *suffix))
+		}
+	}
+
+	for components := 1; components <= 5; components++ {
+		options := GenerateOptions{Components: components, Suffix: SuffixGenerators.Number}
+		id, err := Generate(options)
+		if err != nil {
+			problems = append(problems, fmt.Errorf("generate %d components: %w", components, err))
+			continue
+		}
+		parsed := Parse(id, "-")
+		_, err = splitClassWords(dict, strings.Join(parsed.Components, "-"), "-", wordClasses[:components])
+		if err != nil || parsed.Suffix == nil {
+			problems = append(problems, fmt.Errorf("%q did not parse back into %d components and a suffix", id, components))
+		}
+	}
+
+	if err := errors.Join(problems...); err != nil {
+		return fmt.Errorf("self test failed: %w", err)
+	}
+	return nil
+}
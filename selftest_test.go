@@ -0,0 +1,47 @@
+package memorable_ids
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSelfTest(t *testing.T) {
+	t.Run("should pass with the built-in dictionary", func(t *testing.T) {
+		assert.NoError(t, SelfTest())
+	})
+
+	t.Run("should report words containing the separator", func(t *testing.T) {
+		original := Nouns
+		t.Cleanup(func() { Nouns = original })
+
+		Nouns = append([]string{"pig-pen"}, original[1:]...)
+		err := SelfTest()
+		assert.ErrorContains(t, err, "pig-pen")
+	})
+
+	t.Run("should report changed dictionary sizes", func(t *testing.T) {
+		original := Verbs
+		t.Cleanup(func() { Verbs = original })
+
+		Verbs = original[:10]
+		assert.ErrorContains(t, SelfTest(), "dictionary sizes changed")
+	})
+}
+
+func TestSeparatorConflicts(t *testing.T) {
+	t.Run("should find words containing the separator", func(t *testing.T) {
+		dict := NewDictionary([]string{"cute"}, []string{"guinea-pig", "fox"}, []string{"run"}, []string{"fast"}, []string{"in"})
+		assert.Equal(t, []string{"guinea-pig"}, dict.SeparatorConflicts("-"))
+		assert.Equal(t, []string{"guinea-pig"}, GetDictionary().SeparatorConflicts("-"))
+	})
+
+	t.Run("should only treat conflicts as ambiguous when they can't be split back", func(t *testing.T) {
+		assert.Empty(t, GetDictionary().ambiguousConflicts("-", wordClasses))
+
+		dict := NewDictionary([]string{"cute"}, []string{"pig", "pig-pen", "guinea-pig"}, []string{"run"}, []string{"fast"}, []string{"in"})
+		assert.Equal(t, []string{"pig-pen"}, dict.ambiguousConflicts("-", wordClasses))
+		assert.Equal(t, []string{"cute"}, dict.ambiguousConflicts("e", wordClasses))
+		assert.Empty(t, dict.ambiguousConflicts("e", []WordClass{ClassNoun}))
+	})
+}
@@ -0,0 +1,83 @@
+package memorable_ids
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// sequence claims gap-free 1-based numbers under a key prefix of a store,
+// which arbitrates between concurrent and restarted claimants. Claimants
+// only probe upwards from a number whose predecessors are all claimed, so
+// a new sequence resumes after the highest claimed number when the store
+// is listable, instead of probing every number from 1.
+type sequence struct {
+	store  Store
+	prefix string
+
+	mu sync.Mutex
+	// next is the lowest number that may be unclaimed, 0 until resumed
+	next int
+}
+
+// claim claims the lowest unclaimed number
+func (s *sequence) claim() (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.next == 0 {
+		if err := s.resume(); err != nil {
+			return 0, err
+		}
+	}
+	for ; ; s.next++ {
+		claimed, err := s.store.Reserve(s.prefix + strconv.Itoa(s.next))
+		if err != nil {
+			return 0, err
+		}
+		if claimed {
+			s.next++
+			return s.next - 1, nil
+		}
+	}
+}
+
+// resume starts the sequence after the highest claimed number in the store
+func (s *sequence) resume() error {
+	s.next = 1
+	keys, ok, err := listPrefix(s.store, s.prefix)
+	if err != nil || !ok {
+		return err
+	}
+	for _, key := range keys {
+		if n, err := strconv.Atoi(strings.TrimPrefix(key, s.prefix)); err == nil && n >= s.next {
+			s.next = n + 1
+		}
+	}
+	return nil
+}
+
+// listPrefix returns the reserved IDs of store starting with prefix, in
+// sorted order, reporting false if the store can't list its IDs. Stores
+// implementing PrefixLister only list the prefix.
+func listPrefix(store Store, prefix string) ([]string, bool, error) {
+	if lister, ok := store.(PrefixLister); ok {
+		ids, err := lister.ListPrefix(prefix)
+		return ids, true, err
+	}
+	lister, ok := store.(Lister)
+	if !ok {
+		return nil, false, nil
+	}
+	ids, err := lister.List()
+	if err != nil {
+		return nil, true, err
+	}
+	start, _ := slices.BinarySearch(ids, prefix)
+	end := start
+	for end < len(ids) && strings.HasPrefix(ids[end], prefix) {
+		end++
+	}
+	return ids[start:end], true, nil
+}
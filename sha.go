@@ -0,0 +1,40 @@
+package memorable_ids
+
+import (
+	"crypto/sha256"
+	"errors"
+	"strings"
+)
+
+// shaNamePrefix is the number of leading hex digits NameForSHA derives names from,
+// matching git's default abbreviation length
+const shaNamePrefix = 7
+
+// NameForSHA deterministically derives a memorable build or release name
+// from a commit hash: words word components (1-5, default: 2) followed by
+// a 3-digit number. Only the first 7 hex digits are used, so abbreviated
+// and full hashes of the same commit produce the same name.
+//
+// Example:
+//
+//	NameForSHA("c555172", 2)                                  // "close-rook-737", nil
+//	NameForSHA("c555172e2b6c2d7c1d0a3f8e8b7a6c5d4e3f2a1b", 2) // "close-rook-737", nil
+func NameForSHA(sha string, words int) (string, error) {
+	if words == 0 {
+		words = 2
+	}
+	if words < 1 || words > 5 {
+		return "", errors.New("words must be between 1 and 5")
+	}
+
+	sha = strings.ToLower(strings.TrimSpace(sha))
+	if len(sha) < shaNamePrefix {
+		return "", errors.New("commit hash must have at least 7 hex digits")
+	}
+	if strings.Trim(sha, "0123456789abcdef") != "" {
+		return "", errors.New("commit hash must be hexadecimal")
+	}
+
+	hash := sha256.Sum256([]byte(sha[:shaNamePrefix]))
+	return nameFromHash(GetDictionary(), hash[:], words, 3, "-"), nil
+}
@@ -0,0 +1,45 @@
+package memorable_ids
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNameForSHA(t *testing.T) {
+	t.Run("should derive the same name from short and full hashes", func(t *testing.T) {
+		short, err := NameForSHA("c555172", 2)
+		require.NoError(t, err, "NameForSHA should not fail")
+		full, err := NameForSHA("C555172E2B6C2D7C1D0A3F8E8B7A6C5D4E3F2A1B", 2)
+		require.NoError(t, err, "NameForSHA should not fail")
+
+		assert.Equal(t, "close-rook-737", short)
+		assert.Equal(t, short, full)
+	})
+
+	t.Run("should use the requested number of words", func(t *testing.T) {
+		name, err := NameForSHA("deadbeef", 3)
+		require.NoError(t, err, "NameForSHA should not fail")
+
+		assert.Regexp(t, regexp.MustCompile(`^[a-z]+-[a-z-]+-[a-z]+-\d{3}$`), name)
+	})
+
+	t.Run("should differ between commits", func(t *testing.T) {
+		a, _ := NameForSHA("1234567", 3)
+		b, _ := NameForSHA("1234568", 3)
+
+		assert.NotEqual(t, a, b)
+	})
+
+	t.Run("should reject invalid hashes", func(t *testing.T) {
+		for _, sha := range []string{"", "abc", "xyz1234", "123456g"} {
+			_, err := NameForSHA(sha, 2)
+			assert.Error(t, err, "Expected error for %q", sha)
+		}
+
+		_, err := NameForSHA("1234567", 6)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,117 @@
+package memorable_ids
+
+import (
+	"log/slog"
+	"math/rand"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// ShardedStore is a Store that routes every ID to the store of its shard,
+// so uniqueness is only checked within a shard. Because the shard is
+// derived from the ID itself, equal IDs always meet in the same shard and
+// global uniqueness holds while each shard can live on a different node.
+// ShardedStore is safe for concurrent use if its shard stores are.
+//
+// Example:
+//
+//	store := &ShardedStore{ShardOf: ShardByFirstComponent("-")}
+//	store.Reserve("cute-rabbit") // checked only against the "cute" shard
+//
+//	id, _ := store.GenerateInShard("eu1", GenerateOptions{}) // "eu1-large-fox"
+type ShardedStore struct {
+	// ShardOf maps an ID to its shard key (default: first component split on "-")
+	ShardOf func(id string) string
+	// NewStore creates the store of a shard (default: in-memory store)
+	NewStore func(shard string) Store
+	// MaxAttempts is the number of re-rolls before giving up on a unique ID (default: 100)
+	MaxAttempts int
+	// Logger receives retries and store errors, with a "shard" attribute
+	// (default: nil, no logging)
+	Logger *slog.Logger
+
+	mu     sync.Mutex
+	shards map[string]Store
+}
+
+// ShardByFirstComponent returns a shard function keyed on the first component of an ID
+//
+// Example:
+//
+//	ShardByFirstComponent("-")("cute-rabbit-042") // "cute"
+func ShardByFirstComponent(separator string) func(id string) string {
+	return func(id string) string {
+		shard, _, _ := strings.Cut(id, separator)
+		return shard
+	}
+}
+
+// Reserve implements Store by reserving id in its shard's store
+func (s *ShardedStore) Reserve(id string) (bool, error) {
+	return s.Shard(s.shardOf(id)).Reserve(id)
+}
+
+// Shard returns the store of a shard, creating it on first use
+func (s *ShardedStore) Shard(shard string) Store {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if store, exists := s.shards[shard]; exists {
+		return store
+	}
+	if s.shards == nil {
+		s.shards = make(map[string]Store)
+	}
+
+	var store Store
+	if s.NewStore != nil {
+		store = s.NewStore(shard)
+	} else {
+		store = NewMemoryStore()
+	}
+	s.shards[shard] = store
+	return store
+}
+
+// Shards returns the keys of all shards created so far in sorted order
+func (s *ShardedStore) Shards() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	shards := make([]string, 0, len(s.shards))
+	for shard := range s.shards {
+		shards = append(shards, shard)
+	}
+	slices.Sort(shards)
+	return shards
+}
+
+// GenerateInShard creates an ID prefixed with the shard token and reserves
+// it in that shard's store only, so a node owning a shard can issue IDs
+// without consulting any other shard. With the default ShardOf, prefixed
+// IDs passed to Reserve land in the same shard.
+func (s *ShardedStore) GenerateInShard(shard string, options GenerateOptions) (string, error) {
+	separator := options.Separator
+	if separator == "" {
+		separator = "-"
+	}
+
+	store := s.Shard(shard)
+	return reserveUnique(loggerOr(s.Logger).With(slog.String("shard", shard)), store, s.MaxAttempts, func() (string, error) {
+		id, err := generate(GetDictionary(), options, rand.Intn)
+		if err != nil {
+			return "", err
+		}
+		return shard + separator + id, nil
+	})
+}
+
+// shardOf applies ShardOf or the default first-component shard function
+func (s *ShardedStore) shardOf(id string) string {
+	if s.ShardOf != nil {
+		return s.ShardOf(id)
+	}
+	shard, _, _ := strings.Cut(id, "-")
+	return shard
+}
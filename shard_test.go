@@ -0,0 +1,55 @@
+package memorable_ids
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShardedStore(t *testing.T) {
+	t.Run("should route IDs to the shard of their first component", func(t *testing.T) {
+		store := &ShardedStore{}
+
+		for _, id := range []string{"cute-rabbit", "cute-fox", "large-fox"} {
+			reserved, err := store.Reserve(id)
+			require.NoError(t, err)
+			assert.True(t, reserved)
+		}
+
+		reserved, err := store.Reserve("cute-rabbit")
+		require.NoError(t, err)
+		assert.False(t, reserved, "Expected duplicate to be rejected within its shard")
+
+		assert.Equal(t, []string{"cute", "large"}, store.Shards())
+		assert.Equal(t, 2, store.Shard("cute").(*MemoryStore).Len())
+	})
+
+	t.Run("should honor custom shard functions", func(t *testing.T) {
+		store := &ShardedStore{ShardOf: ShardByFirstComponent("_")}
+
+		_, err := store.Reserve("eu1_cute_rabbit")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"eu1"}, store.Shards())
+	})
+
+	t.Run("should generate prefixed IDs within a shard", func(t *testing.T) {
+		store := &ShardedStore{}
+		seen := make(map[string]bool)
+
+		for range 100 {
+			id, err := store.GenerateInShard("eu1", GenerateOptions{Components: 3})
+			require.NoError(t, err)
+			assert.True(t, strings.HasPrefix(id, "eu1-"), "Expected shard prefix in %s", id)
+			assert.False(t, seen[id], "Expected unique ID within the shard")
+			seen[id] = true
+		}
+
+		assert.Equal(t, []string{"eu1"}, store.Shards())
+
+		reserved, err := store.Reserve("eu1-" + "cute-rabbit-run")
+		require.NoError(t, err)
+		assert.Equal(t, !seen["eu1-cute-rabbit-run"], reserved)
+	})
+}
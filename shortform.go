@@ -0,0 +1,83 @@
+package memorable_ids
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ShortForm derives a compact badge from a hyphenated ID: the first letter
+// of every component followed by the numeric suffix, if any
+//
+// Example:
+//
+//	ShortForm("cute-rabbit-042") // "cr042"
+//	ShortForm("large-fox-swim")  // "lfs"
+func ShortForm(id string) string {
+	parsed := Parse(id, "-")
+
+	var short strings.Builder
+	for _, component := range parsed.Components {
+		if component != "" {
+			short.WriteString(strings.ToLower(component[:1]))
+		}
+	}
+	if parsed.Suffix != nil {
+		short.WriteString(*parsed.Suffix)
+	}
+	return short.String()
+}
+
+// Expand returns every full ID whose short form is short, using the
+// positional word classes (adjective, noun, verb, ...). The number of
+// candidates grows quickly with the number of components.
+//
+// Example:
+//
+//	Expand("cr042")
+//	// ["cute-rabbit-042", "cute-robin-042", "cute-rook-042", "cute-rat-042", ...]
+func Expand(short string) ([]string, error) {
+	short = strings.ToLower(short)
+	initials := strings.TrimRight(short, "0123456789")
+	suffix := short[len(initials):]
+
+	if initials == "" {
+		return nil, errors.New("short form must start with at least one initial")
+	}
+	if len(initials) > len(wordClasses) {
+		return nil, fmt.Errorf("short form has more than %d initials", len(wordClasses))
+	}
+
+	dict := GetDictionary()
+	candidates := []string{""}
+	for i, initial := range initials {
+		var matches []string
+		for _, word := range dict.Words(wordClasses[i]) {
+			if strings.HasPrefix(word, string(initial)) {
+				matches = append(matches, word)
+			}
+		}
+		if len(matches) == 0 {
+			return nil, fmt.Errorf("no %s starts with %q", wordClasses[i], initial)
+		}
+
+		next := make([]string, 0, len(candidates)*len(matches))
+		for _, prefix := range candidates {
+			for _, word := range matches {
+				if prefix == "" {
+					next = append(next, word)
+				} else {
+					next = append(next, prefix+"-"+word)
+				}
+			}
+		}
+		candidates = next
+	}
+
+	if suffix != "" {
+		for i := range candidates {
+			candidates[i] += "-" + suffix
+		}
+	}
+	return candidates, nil
+}
@@ -0,0 +1,51 @@
+package memorable_ids
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShortForm(t *testing.T) {
+	t.Run("should abbreviate components and keep the suffix", func(t *testing.T) {
+		assert.Equal(t, "cr042", ShortForm("cute-rabbit-042"))
+		assert.Equal(t, "lfs", ShortForm("large-fox-swim"))
+		assert.Equal(t, "c", ShortForm("Cute"))
+	})
+
+	t.Run("should expand to candidates containing the original", func(t *testing.T) {
+		candidates, err := Expand("cr042")
+		require.NoError(t, err, "Expand should not fail")
+
+		assert.Contains(t, candidates, "cute-rabbit-042")
+		for _, candidate := range candidates {
+			assert.Equal(t, "cr042", ShortForm(candidate))
+		}
+	})
+
+	t.Run("should round trip generated IDs", func(t *testing.T) {
+		for i := 0; i < 20; i++ {
+			id, err := Generate(GenerateOptions{Components: 3, Suffix: SuffixGenerators.Number})
+			require.NoError(t, err, "Generate should not fail")
+			if len(Parse(id, "-").Components) != 3 {
+				continue // skip multi-part words such as "guinea-pig"
+			}
+
+			candidates, err := Expand(ShortForm(id))
+			require.NoError(t, err, "Expand should not fail")
+			assert.Contains(t, candidates, id)
+		}
+	})
+
+	t.Run("should reject invalid short forms", func(t *testing.T) {
+		_, err := Expand("042")
+		assert.Error(t, err)
+
+		_, err = Expand("abcdef")
+		assert.Error(t, err)
+
+		_, err = Expand("cq")
+		assert.ErrorContains(t, err, "no noun starts with")
+	})
+}
@@ -0,0 +1,126 @@
+package memorable_ids
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Weights of the signals combined by Similarity
+const (
+	similarityWordWeight     = 0.4
+	similarityEditWeight     = 0.3
+	similarityPhoneticWeight = 0.3
+)
+
+// Similarity scores how alike two IDs are, from 0 (unrelated) to 1 (identical).
+// It combines word overlap, character edit distance, and phonetic (Soundex)
+// distance, so "cute-rabbit" is close to "cute-rabbet" and "rabbit-cute".
+// Parts are split on any non-alphanumeric character and compared
+// case-insensitively.
+//
+// Example:
+//
+//	Similarity("cute-rabbit", "cute-rabbit")  // 1
+//	Similarity("cute-rabbit", "cute-rabbet")  // ~0.70
+//	Similarity("cute-rabbit", "large-fox")    // ~0.03
+func Similarity(a, b string) float64 {
+	wordsA, wordsB := splitWords(a), splitWords(b)
+	if len(wordsA) == 0 && len(wordsB) == 0 {
+		return 1
+	}
+
+	wordScore := jaccard(wordsA, wordsB)
+
+	joinedA, joinedB := strings.Join(wordsA, ""), strings.Join(wordsB, "")
+	editScore := 1.0
+	if longest := max(utf8.RuneCountInString(joinedA), utf8.RuneCountInString(joinedB)); longest > 0 {
+		editScore = 1 - float64(levenshtein(joinedA, joinedB))/float64(longest)
+	}
+
+	phoneticScore := jaccard(soundexAll(wordsA), soundexAll(wordsB))
+
+	return similarityWordWeight*wordScore +
+		similarityEditWeight*editScore +
+		similarityPhoneticWeight*phoneticScore
+}
+
+// splitWords lowercases an ID and splits it on non-alphanumeric characters
+func splitWords(id string) []string {
+	return strings.FieldsFunc(strings.ToLower(id), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// jaccard returns the Jaccard index of two word sets
+func jaccard(a, b []string) float64 {
+	if len(a) == 0 && len(b) == 0 {
+		return 1
+	}
+
+	set := make(map[string]bool, len(a))
+	for _, word := range a {
+		set[word] = false
+	}
+
+	union := len(set)
+	intersection := 0
+	for _, word := range b {
+		seen, ok := set[word]
+		switch {
+		case !ok:
+			set[word] = true
+			union++
+		case !seen:
+			set[word] = true
+			intersection++
+		}
+	}
+
+	return float64(intersection) / float64(union)
+}
+
+// soundexAll returns the Soundex code of every word
+func soundexAll(words []string) []string {
+	codes := make([]string, len(words))
+	for i, word := range words {
+		codes[i] = soundex(word)
+	}
+	return codes
+}
+
+// soundexCodes maps letters to their Soundex digit ('0' for ignored letters)
+var soundexCodes = [26]byte{
+	'0', '1', '2', '3', '0', '1', '2', '0', '0', '2', '2', '4', '5',
+	'5', '0', '1', '2', '6', '2', '3', '0', '1', '0', '2', '0', '2',
+}
+
+// soundex returns the American Soundex code of a word (e.g. "rabbit" → "R130").
+// Words that don't start with a letter (such as numeric suffixes) are returned unchanged.
+func soundex(word string) string {
+	if word == "" || word[0] < 'a' || word[0] > 'z' {
+		return word
+	}
+
+	code := []byte{word[0] - 'a' + 'A'}
+	last := soundexCodes[word[0]-'a']
+	for i := 1; i < len(word) && len(code) < 4; i++ {
+		c := word[i]
+		if c < 'a' || c > 'z' {
+			continue
+		}
+		digit := soundexCodes[c-'a']
+		if digit != '0' && digit != last {
+			code = append(code, digit)
+		}
+		// 'h' and 'w' don't separate letters with the same code
+		if c != 'h' && c != 'w' {
+			last = digit
+		}
+	}
+
+	for len(code) < 4 {
+		code = append(code, '0')
+	}
+	return string(code)
+}
@@ -0,0 +1,47 @@
+package memorable_ids
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSimilarity(t *testing.T) {
+	t.Run("should score identical IDs as 1", func(t *testing.T) {
+		assert.Equal(t, 1.0, Similarity("cute-rabbit-042", "cute-rabbit-042"))
+		assert.Equal(t, 1.0, Similarity("Cute-Rabbit", "cute_rabbit"), "Expected case and separator to be ignored")
+	})
+
+	t.Run("should rank near misses above unrelated IDs", func(t *testing.T) {
+		typo := Similarity("cute-rabbit", "cute-rabbet")
+		reordered := Similarity("cute-rabbit", "rabbit-cute")
+		unrelated := Similarity("cute-rabbit", "large-fox")
+
+		assert.Greater(t, typo, 0.6)
+		assert.Greater(t, reordered, 0.6)
+		assert.Less(t, unrelated, 0.2)
+	})
+
+	t.Run("should be symmetric", func(t *testing.T) {
+		assert.InDelta(t, Similarity("cute-rabbit-042", "cute-robin"), Similarity("cute-robin", "cute-rabbit-042"), 1e-9)
+	})
+
+	t.Run("should stay within bounds", func(t *testing.T) {
+		for _, pair := range [][2]string{{"", "fox"}, {"a", "b"}, {"123", "456"}} {
+			score := Similarity(pair[0], pair[1])
+			assert.GreaterOrEqual(t, score, 0.0)
+			assert.LessOrEqual(t, score, 1.0)
+		}
+	})
+}
+
+func TestSoundex(t *testing.T) {
+	t.Run("should produce standard codes", func(t *testing.T) {
+		assert.Equal(t, "R163", soundex("robert"))
+		assert.Equal(t, "R163", soundex("rupert"))
+		assert.Equal(t, "A261", soundex("ashcraft"))
+		assert.Equal(t, "T522", soundex("tymczak"))
+		assert.Equal(t, "R130", soundex("rabbit"))
+		assert.Equal(t, "042", soundex("042"))
+	})
+}
@@ -0,0 +1,100 @@
+package memorable_ids
+
+import "errors"
+
+// SimulationOptions contains configuration for collision simulations
+type SimulationOptions struct {
+	// Generate are the options used for every generated ID
+	Generate GenerateOptions
+	// SuffixRange is the number of distinct suffix values
+	// (default: derived from the suffix generator, otherwise 1)
+	SuffixRange int
+	// IDs is the number of IDs generated per trial
+	IDs int
+	// Trials is the number of independent trials (default: 1)
+	Trials int
+}
+
+// SimulationResult contains observed collision statistics next to the analytical prediction
+type SimulationResult struct {
+	// IDs is the number of IDs generated per trial
+	IDs int
+	// Trials is the number of trials run
+	Trials int
+	// TotalCombinations is the size of the ID space
+	TotalCombinations int
+	// TrialsWithCollision is the number of trials with at least one duplicate
+	TrialsWithCollision int
+	// TotalCollisions is the number of duplicate IDs across all trials
+	TotalCollisions int
+	// ObservedProbability is the share of trials with at least one duplicate
+	ObservedProbability float64
+	// PredictedProbability is the Birthday Paradox approximation for the same setup
+	PredictedProbability float64
+	// MeanCollisions is the average number of duplicates per trial
+	MeanCollisions float64
+}
+
+// Simulate runs real generations and reports how often collisions occurred,
+// alongside the probability predicted by CalculateCollisionProbability
+//
+// Example:
+//
+//	Simulate(SimulationOptions{
+//	  Generate: GenerateOptions{Components: 2},
+//	  IDs:      100,
+//	  Trials:   50,
+//	})
+//	// SimulationResult{ObservedProbability: 0.6, PredictedProbability: 0.55, ...}
+func Simulate(options SimulationOptions) (SimulationResult, error) {
+	if options.IDs < 1 {
+		return SimulationResult{}, errors.New("simulation needs at least 1 ID per trial")
+	}
+	if options.Trials < 1 {
+		options.Trials = 1
+	}
+	if options.SuffixRange < 1 {
+		options.SuffixRange = 1
+		if suffixRange, err := options.Generate.suffixRange(); err == nil {
+			options.SuffixRange = suffixRange
+		}
+	}
+
+	total, err := options.Generate.combinations(options.SuffixRange)
+	if err != nil {
+		return SimulationResult{}, err
+	}
+
+	result := SimulationResult{
+		IDs:                  options.IDs,
+		Trials:               options.Trials,
+		TotalCombinations:    total,
+		PredictedProbability: CalculateCollisionProbability(total, options.IDs),
+	}
+
+	seen := make(map[string]struct{}, options.IDs)
+	for trial := 0; trial < options.Trials; trial++ {
+		clear(seen)
+		collisions := 0
+		for i := 0; i < options.IDs; i++ {
+			id, err := Generate(options.Generate)
+			if err != nil {
+				return SimulationResult{}, err
+			}
+			if _, ok := seen[id]; ok {
+				collisions++
+				continue
+			}
+			seen[id] = struct{}{}
+		}
+
+		result.TotalCollisions += collisions
+		if collisions > 0 {
+			result.TrialsWithCollision++
+		}
+	}
+
+	result.ObservedProbability = float64(result.TrialsWithCollision) / float64(result.Trials)
+	result.MeanCollisions = float64(result.TotalCollisions) / float64(result.Trials)
+	return result, nil
+}
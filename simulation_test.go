@@ -0,0 +1,46 @@
+package memorable_ids
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSimulate(t *testing.T) {
+	t.Run("should observe collisions close to the prediction", func(t *testing.T) {
+		result, err := Simulate(SimulationOptions{
+			Generate: GenerateOptions{Components: 1},
+			IDs:      30,
+			Trials:   200,
+		})
+		require.NoError(t, err, "Simulate should not fail")
+
+		assert.Equal(t, len(Adjectives), result.TotalCombinations)
+		assert.InDelta(t, result.PredictedProbability, result.ObservedProbability, 0.2, "Observed probability should be near the prediction")
+		assert.Greater(t, result.MeanCollisions, 0.0, "Expected some collisions in a tiny space")
+	})
+
+	t.Run("should report no collisions when the space is large", func(t *testing.T) {
+		result, err := Simulate(SimulationOptions{
+			Generate:    GenerateOptions{Components: 5, Suffix: SuffixGenerators.Number4},
+			SuffixRange: 10000,
+			IDs:         10,
+			Trials:      5,
+		})
+		require.NoError(t, err, "Simulate should not fail")
+
+		assert.Equal(t, 0, result.TrialsWithCollision)
+		assert.Equal(t, 0.0, result.ObservedProbability)
+	})
+
+	t.Run("should reject empty trials", func(t *testing.T) {
+		_, err := Simulate(SimulationOptions{})
+		assert.Error(t, err)
+	})
+
+	t.Run("should propagate generation errors", func(t *testing.T) {
+		_, err := Simulate(SimulationOptions{Generate: GenerateOptions{Components: 9}, IDs: 1})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,47 @@
+package memorable_ids
+
+// RandomSource picks the random word indexes of a Generator. *rand.Rand
+// implements it, so tests can inject a seeded source or a mock.
+type RandomSource interface {
+	// Intn returns a number in [0, n)
+	Intn(n int) int
+}
+
+// SuffixSource produces the suffixes of a Generator; ok is false when an
+// ID gets no suffix
+type SuffixSource interface {
+	Suffix() (suffix string, ok bool)
+}
+
+// Suffix implements SuffixSource, so suffix generators can be used as sources
+//
+// Example:
+//
+//	SuffixGenerator(SuffixGenerators.Hex).Suffix() // "a3", true
+func (g SuffixGenerator) Suffix() (string, bool) {
+	if g == nil {
+		return "", false
+	}
+	suffix := g()
+	if suffix == nil {
+		return "", false
+	}
+	return *suffix, true
+}
+
+// suffixGeneratorOf adapts a SuffixSource to the SuffixGenerator used by generate
+func suffixGeneratorOf(source SuffixSource) SuffixGenerator {
+	switch source := source.(type) {
+	case SuffixGenerator:
+		return source
+	case SuffixFunc:
+		return source.Generator()
+	}
+	return func() *string {
+		suffix, ok := source.Suffix()
+		if !ok {
+			return nil
+		}
+		return &suffix
+	}
+}
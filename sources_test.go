@@ -0,0 +1,171 @@
+package memorable_ids
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// sequenceSource is a RandomSource returning fixed indexes in turn
+type sequenceSource struct {
+	indexes []int
+	next    int
+}
+
+func (s *sequenceSource) Intn(n int) int {
+	index := s.indexes[s.next%len(s.indexes)] % n
+	s.next++
+	return index
+}
+
+// fixedSuffix is a SuffixSource always returning the same suffix
+type fixedSuffix string
+
+func (f fixedSuffix) Suffix() (string, bool) { return string(f), true }
+
+func TestGeneratorSources(t *testing.T) {
+	t.Run("should draw words from the random source", func(t *testing.T) {
+		// Words are drawn as one index, the first word varying fastest
+		index := 0 + 1*len(Adjectives) + 2*len(Adjectives)*len(Nouns)
+		gen := NewGenerator(Config{
+			Options: GenerateOptions{Components: 3},
+			Random:  &sequenceSource{indexes: []int{index}},
+		})
+
+		id, err := gen.Generate()
+		require.NoError(t, err)
+		assert.Equal(t, Adjectives[0]+"-"+Nouns[1]+"-"+Verbs[2], id)
+	})
+
+	t.Run("should reproduce IDs from a seeded source", func(t *testing.T) {
+		first := NewGenerator(Config{Random: rand.New(rand.NewSource(42))})
+		second := NewGenerator(Config{Random: rand.New(rand.NewSource(42))})
+
+		for range 10 {
+			a, err := first.Generate()
+			require.NoError(t, err)
+			b, err := second.Generate()
+			require.NoError(t, err)
+			assert.Equal(t, a, b)
+		}
+	})
+
+	t.Run("should draw built-in suffixes from a seeded source", func(t *testing.T) {
+		options := GenerateOptions{Suffix: SuffixGenerators.Number}
+		first := NewGenerator(Config{Options: options, Random: rand.New(rand.NewSource(42))})
+		second := NewGenerator(Config{Options: options, Random: rand.New(rand.NewSource(42))})
+
+		var firstIDs, secondIDs []string
+		for range 20 {
+			a, err := first.Generate()
+			require.NoError(t, err)
+			firstIDs = append(firstIDs, a)
+			b, err := second.Generate()
+			require.NoError(t, err)
+			secondIDs = append(secondIDs, b)
+		}
+		assert.Equal(t, firstIDs, secondIDs)
+	})
+
+	t.Run("should take suffixes from the suffix source", func(t *testing.T) {
+		gen := NewGenerator(Config{
+			Options: GenerateOptions{Suffix: SuffixGenerators.Hex},
+			Random:  &sequenceSource{indexes: []int{0}},
+			Suffix:  fixedSuffix("zz"),
+		})
+
+		id, err := gen.Generate()
+		require.NoError(t, err)
+		assert.Equal(t, Adjectives[0]+"-"+Nouns[0]+"-zz", id)
+		assert.Equal(t, 1, gen.config.SuffixRange, "unknown sources don't multiply the range")
+	})
+
+	t.Run("should accept suffix generators as sources", func(t *testing.T) {
+		gen := NewGenerator(Config{Suffix: SuffixGenerator(SuffixGenerators.Hex)})
+		assert.Equal(t, 256, gen.config.SuffixRange)
+
+		id, err := gen.Generate()
+		require.NoError(t, err)
+		assert.Regexp(t, `-[0-9a-f]{2}$`, id)
+	})
+
+	t.Run("should adapt suffix generators", func(t *testing.T) {
+		suffix, ok := SuffixGenerator(func() *string { return nil }).Suffix()
+		assert.False(t, ok)
+		assert.Empty(t, suffix)
+
+		_, ok = SuffixGenerator(nil).Suffix()
+		assert.False(t, ok)
+	})
+}
+
+func TestGeneratorDictionary(t *testing.T) {
+	space := NewDictionary([]string{"stellar", "cosmic"}, []string{"comet", "nebula", "quasar"}, []string{"orbit"}, []string{"swiftly"}, []string{"beyond"})
+
+	t.Run("should draw words from its own dictionary", func(t *testing.T) {
+		gen := NewGenerator(Config{Options: GenerateOptions{Components: 3}, Dictionary: &space})
+		require.NoError(t, gen.Err())
+
+		for range 20 {
+			id, err := gen.Generate()
+			require.NoError(t, err)
+			parsed := Parse(id, "-")
+			require.Len(t, parsed.Components, 3)
+			assert.Contains(t, space.Adjectives, parsed.Components[0])
+			assert.Contains(t, space.Nouns, parsed.Components[1])
+			assert.Equal(t, "orbit", parsed.Components[2])
+		}
+	})
+
+	t.Run("should coexist with generators using the built-in dictionary", func(t *testing.T) {
+		custom := NewGenerator(Config{Dictionary: &space, Random: &sequenceSource{indexes: []int{4}}})
+		builtin := NewGenerator(Config{Random: &sequenceSource{indexes: []int{0}}})
+
+		id, err := custom.Generate()
+		require.NoError(t, err)
+		assert.Equal(t, "stellar-quasar", id)
+
+		id, err = builtin.Generate()
+		require.NoError(t, err)
+		assert.Equal(t, Adjectives[0]+"-"+Nouns[0], id)
+	})
+
+	t.Run("should number and analyse its own space", func(t *testing.T) {
+		gen := NewGenerator(Config{Dictionary: &space})
+		for range 6 {
+			_, err := gen.NextSequential()
+			require.NoError(t, err)
+		}
+		_, err := gen.NextSequential()
+		assert.ErrorIs(t, err, ErrSpaceExhausted)
+		assert.Equal(t, 6, gen.CollisionAnalysis().TotalCombinations)
+	})
+
+	t.Run("should reject invalid dictionaries upfront", func(t *testing.T) {
+		empty := NewDictionary([]string{"stellar"}, nil, nil, nil, nil)
+		gen := NewGenerator(Config{Dictionary: &empty})
+		assert.ErrorIs(t, gen.Err(), ErrEmptyWordClass)
+
+		_, err := gen.Generate()
+		assert.ErrorIs(t, err, ErrEmptyWordClass)
+	})
+
+	t.Run("should only require the classes it draws from", func(t *testing.T) {
+		pairs := NewDictionary([]string{"stellar", "cosmic"}, []string{"comet", "nebula"}, nil, nil, nil)
+		gen := NewGenerator(Config{Dictionary: &pairs})
+		require.NoError(t, gen.Err())
+		id, err := gen.Generate()
+		require.NoError(t, err)
+		assert.Regexp(t, `^(stellar|cosmic)-(comet|nebula)$`, id)
+
+		assert.ErrorIs(t, NewGenerator(Config{Options: GenerateOptions{Components: 3}, Dictionary: &pairs}).Err(), ErrEmptyWordClass)
+
+		actions := NewDictionary(nil, []string{"comet"}, []string{"orbit", "drift"}, nil, nil)
+		gen = NewGenerator(Config{Options: GenerateOptions{Pattern: "noun-verb"}, Dictionary: &actions})
+		require.NoError(t, gen.Err())
+		_, err = gen.Generate()
+		require.NoError(t, err)
+	})
+}
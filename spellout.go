@@ -0,0 +1,61 @@
+package memorable_ids
+
+import (
+	"strings"
+	"unicode"
+)
+
+// digitNames are the spoken names of the decimal digits
+var digitNames = [10]string{"zero", "one", "two", "three", "four", "five", "six", "seven", "eight", "nine"}
+
+// SpellOut renders an ID as a phonetic read-back for support scripts and
+// IVR systems. Words are followed by their letters, numeric parts are read
+// digit by digit. Parts are split on any non-alphanumeric character.
+//
+// Example:
+//
+//	SpellOut("cute-rabbit-042")
+//	// "cute — C-U-T-E, rabbit — R-A-B-B-I-T, zero four two"
+//
+//	SpellOut("warm_duck_5f")
+//	// "warm — W-A-R-M, duck — D-U-C-K, 5f — five-F"
+func SpellOut(id string) string {
+	parts := splitWords(id)
+	spoken := make([]string, len(parts))
+
+	for i, part := range parts {
+		if isDigits(part) {
+			digits := make([]string, 0, len(part))
+			for _, r := range part {
+				digits = append(digits, digitNames[r-'0'])
+			}
+			spoken[i] = strings.Join(digits, " ")
+			continue
+		}
+
+		characters := make([]string, 0, len(part))
+		for _, r := range part {
+			if r >= '0' && r <= '9' {
+				characters = append(characters, digitNames[r-'0'])
+			} else {
+				characters = append(characters, string(unicode.ToUpper(r)))
+			}
+		}
+		spoken[i] = part + " — " + strings.Join(characters, "-")
+	}
+
+	return strings.Join(spoken, ", ")
+}
+
+// isDigits reports whether s is a non-empty string of ASCII digits
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
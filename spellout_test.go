@@ -0,0 +1,25 @@
+package memorable_ids
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSpellOut(t *testing.T) {
+	t.Run("should spell words and read digits", func(t *testing.T) {
+		assert.Equal(t, "cute — C-U-T-E, rabbit — R-A-B-B-I-T, zero four two", SpellOut("cute-rabbit-042"))
+	})
+
+	t.Run("should handle mixed suffixes and other separators", func(t *testing.T) {
+		assert.Equal(t, "warm — W-A-R-M, duck — D-U-C-K, 5f — five-F", SpellOut("warm_duck_5f"))
+	})
+
+	t.Run("should normalize case", func(t *testing.T) {
+		assert.Equal(t, "fox — F-O-X", SpellOut("FOX"))
+	})
+
+	t.Run("should return empty string for empty input", func(t *testing.T) {
+		assert.Equal(t, "", SpellOut(""))
+	})
+}
@@ -0,0 +1,89 @@
+package memorable_ids
+
+import "math"
+
+// StorageEstimate is the expected storage footprint of n memorable IDs
+type StorageEstimate struct {
+	// IDs is the number of IDs the estimate is for
+	IDs int
+	// AvgLength is the expected ID length in bytes, averaged over all
+	// equally likely word combinations
+	AvgLength float64
+	// MaxLength is the longest ID length in bytes
+	MaxLength int
+	// TotalBytes is the expected size of all IDs as text (AvgLength × IDs)
+	TotalBytes int64
+	// MaxTotalBytes bounds the size of all IDs as text (MaxLength × IDs)
+	MaxTotalBytes int64
+	// BinaryLength is the size in bytes of one ID encoded as its index in
+	// the combination space, suffix included
+	BinaryLength int
+	// BinaryTotalBytes is the size of all IDs in the binary encoding
+	BinaryTotalBytes int64
+	// Warnings lists caveats about the accuracy of the estimate
+	Warnings []string
+}
+
+// unknownSuffixStorageWarning is reported for suffix generators without a spec
+const unknownSuffixStorageWarning = "suffix generator has no spec (see SuffixProvider): suffix bytes are not included"
+
+// EstimateStorage returns the expected storage needed for n IDs generated
+// with options, for planning database columns and index sizes without
+// sampling. Lengths are in bytes, as stored, rather than characters.
+// Invalid options return an empty estimate with a warning.
+//
+// Example:
+//
+//	EstimateStorage(GenerateOptions{Suffix: SuffixGenerators.Number}, 1_000_000)
+//	// StorageEstimate{AvgLength: 15.28, MaxLength: 26, TotalBytes: 15282088, BinaryLength: 3, ...}
+func EstimateStorage(options GenerateOptions, n int) StorageEstimate {
+	estimate := StorageEstimate{IDs: max(n, 0)}
+	if err := options.Validate(); err != nil {
+		estimate.Warnings = append(estimate.Warnings, err.Error())
+		return estimate
+	}
+	classes, err := options.classes()
+	if err != nil {
+		estimate.Warnings = append(estimate.Warnings, err.Error())
+		return estimate
+	}
+	separator := options.Separator
+	if separator == "" {
+		separator = "-"
+	}
+
+	dict := options.dictionary()
+	avg := float64((len(classes) - 1) * len(separator))
+	longest := (len(classes) - 1) * len(separator)
+	bits := 0.0
+	for _, class := range classes {
+		words := dict.Words(class)
+		total, classLongest := 0, 0
+		for _, word := range words {
+			total += len(word)
+			classLongest = max(classLongest, len(word))
+		}
+		avg += float64(total) / float64(len(words))
+		longest += classLongest
+		bits += math.Log2(float64(len(words)))
+	}
+
+	if options.hasSuffix() {
+		if info, ok := options.suffixInfo(); ok {
+			shortest, suffixLongest := info.lengths()
+			avg += float64(len(separator)) + float64(shortest+suffixLongest)/2
+			longest += len(separator) + suffixLongest
+			bits += math.Log2(float64(max(info.rangeSize, 1)))
+		} else {
+			estimate.Warnings = append(estimate.Warnings, unknownSuffixStorageWarning)
+		}
+	}
+
+	estimate.AvgLength = avg
+	estimate.MaxLength = longest
+	estimate.TotalBytes = int64(math.Round(avg * float64(estimate.IDs)))
+	estimate.MaxTotalBytes = int64(longest) * int64(estimate.IDs)
+	estimate.BinaryLength = max(1, int(math.Ceil(bits/8)))
+	estimate.BinaryTotalBytes = int64(estimate.BinaryLength) * int64(estimate.IDs)
+	return estimate
+}
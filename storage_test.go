@@ -0,0 +1,53 @@
+package memorable_ids
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEstimateStorage(t *testing.T) {
+	t.Run("should match sampled ID lengths", func(t *testing.T) {
+		options := GenerateOptions{Components: 3, Suffix: SuffixGenerators.Number}
+		estimate := EstimateStorage(options, 20000)
+
+		total := 0
+		for range estimate.IDs {
+			id, err := Generate(options)
+			require.NoError(t, err)
+			total += len(id)
+			assert.LessOrEqual(t, len(id), estimate.MaxLength)
+		}
+		assert.InDelta(t, float64(total), float64(estimate.TotalBytes), float64(estimate.TotalBytes)*0.02)
+	})
+
+	t.Run("should agree with MaxIDLength for the built-in dictionary", func(t *testing.T) {
+		for components := 1; components <= 5; components++ {
+			options := GenerateOptions{Components: components, Suffix: SuffixGenerators.Hex}
+			maxLength, err := MaxIDLength(options)
+			require.NoError(t, err)
+			assert.Equal(t, maxLength, EstimateStorage(options, 1).MaxLength)
+		}
+	})
+
+	t.Run("should size the binary encoding from the combination space", func(t *testing.T) {
+		estimate := EstimateStorage(GenerateOptions{}, 1000)
+		assert.Equal(t, 2, estimate.BinaryLength)
+		assert.Equal(t, int64(2000), estimate.BinaryTotalBytes)
+		assert.Equal(t, 3, EstimateStorage(GenerateOptions{Suffix: SuffixGenerators.Number}, 1).BinaryLength)
+	})
+
+	t.Run("should warn about suffixes without a spec", func(t *testing.T) {
+		custom := func() *string { suffix := "x"; return &suffix }
+		estimate := EstimateStorage(GenerateOptions{Suffix: custom}, 10)
+		assert.Equal(t, []string{unknownSuffixStorageWarning}, estimate.Warnings)
+		assert.Equal(t, EstimateStorage(GenerateOptions{}, 10).MaxLength, estimate.MaxLength)
+	})
+
+	t.Run("should warn about invalid options", func(t *testing.T) {
+		estimate := EstimateStorage(GenerateOptions{Components: 9}, 10)
+		assert.Zero(t, estimate.TotalBytes)
+		assert.Len(t, estimate.Warnings, 1)
+	})
+}
@@ -0,0 +1,151 @@
+package memorable_ids
+
+import (
+	"errors"
+	"log/slog"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// ErrSpaceExhausted is returned when no unused ID could be found within the retry budget
+var ErrSpaceExhausted = errors.New("could not find an unused ID")
+
+// Store records issued IDs so uniqueness can be enforced across calls,
+// processes, or machines depending on the implementation
+type Store interface {
+	// Reserve atomically records id as issued, reporting false if it was already taken
+	Reserve(id string) (bool, error)
+}
+
+// UniquenessStore is the store consulted by UniqueGenerator. Any Store
+// works, so uniqueness can be backed by Redis, SQL or a Bloom filter
+// instead of process memory; see StoreFunc to adapt a plain function.
+type UniquenessStore = Store
+
+// StoreFunc adapts a function to the Store interface, e.g. an INSERT
+// reporting whether a unique-constraint violation occurred
+//
+// Example:
+//
+//	store := StoreFunc(func(id string) (bool, error) {
+//	  _, err := db.Exec("INSERT INTO issued_ids (id) VALUES ($1)", id)
+//	  if isUniqueViolation(err) {
+//	    return false, nil
+//	  }
+//	  return err == nil, err
+//	})
+type StoreFunc func(id string) (bool, error)
+
+// Reserve implements Store
+func (f StoreFunc) Reserve(id string) (bool, error) {
+	return f(id)
+}
+
+// Lister is implemented by stores that can enumerate their reserved IDs
+type Lister interface {
+	// List returns every reserved ID in sorted order
+	List() ([]string, error)
+}
+
+// PrefixLister is implemented by stores that can enumerate the reserved
+// IDs under a key prefix without listing the whole store, which History
+// and Codenames use to scope their queries to their own keys
+type PrefixLister interface {
+	Lister
+	// ListPrefix returns the reserved IDs starting with prefix in sorted order
+	ListPrefix(prefix string) ([]string, error)
+}
+
+// MemoryStore is an in-process Store backed by a map. The zero value is
+// ready to use and safe for concurrent use.
+type MemoryStore struct {
+	mu  sync.Mutex
+	ids map[string]struct{}
+}
+
+// NewMemoryStore creates an empty in-memory store
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{ids: make(map[string]struct{})}
+}
+
+// Reserve implements Store
+func (s *MemoryStore) Reserve(id string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.ids == nil {
+		s.ids = make(map[string]struct{})
+	}
+	if _, taken := s.ids[id]; taken {
+		return false, nil
+	}
+	s.ids[id] = struct{}{}
+	return true, nil
+}
+
+// Contains reports whether id has been reserved
+func (s *MemoryStore) Contains(id string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, taken := s.ids[id]
+	return taken
+}
+
+// Len returns the number of reserved IDs
+func (s *MemoryStore) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.ids)
+}
+
+// List implements Lister
+func (s *MemoryStore) List() ([]string, error) {
+	return s.ListPrefix("")
+}
+
+// ListPrefix implements PrefixLister
+func (s *MemoryStore) ListPrefix(prefix string) ([]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return sortedWithPrefix(s.ids, prefix), nil
+}
+
+// sortedWithPrefix returns the IDs of a set starting with prefix, sorted
+func sortedWithPrefix(set map[string]struct{}, prefix string) []string {
+	ids := make([]string, 0, len(set))
+	for id := range set {
+		if strings.HasPrefix(id, prefix) {
+			ids = append(ids, id)
+		}
+	}
+	slices.Sort(ids)
+	return ids
+}
+
+// reserveUnique draws IDs from next until store accepts one, trying at most attempts times
+// and logging retries and failures to logger
+func reserveUnique(logger *slog.Logger, store Store, attempts int, next func() (string, error)) (string, error) {
+	logger = loggerOr(logger)
+	if attempts < 1 {
+		attempts = 100
+	}
+	for i := 0; i < attempts; i++ {
+		id, err := next()
+		if err != nil {
+			logger.Warn("memorable ID generation failed", slog.Any("error", err))
+			return "", err
+		}
+		reserved, err := store.Reserve(id)
+		if err != nil {
+			logger.Error("memorable ID store reserve failed", slog.String("id", id), slog.Any("error", err))
+			return "", err
+		}
+		if reserved {
+			return id, nil
+		}
+		logger.Debug("memorable ID collision, retrying", slog.String("id", id), slog.Int("attempt", i+1))
+	}
+	logger.Warn("memorable ID space exhausted", slog.Int("attempts", attempts))
+	return "", ErrSpaceExhausted
+}
@@ -0,0 +1,74 @@
+package memorable_ids
+
+import (
+	"context"
+	"iter"
+)
+
+// GenerateStream returns an unbounded stream of memorable IDs, for load
+// tests and fixtures that pull as many IDs as they need. The channel is
+// closed once ctx is done, or right away if the options are invalid; check
+// options.Validate first to tell the cases apart. IDs are drawn from a
+// stream-local source like GenerateBatch.
+//
+// Example:
+//
+//	ctx, cancel := context.WithCancel(ctx)
+//	defer cancel()
+//	for id := range GenerateStream(ctx, GenerateOptions{Suffix: SuffixGenerators.Number}) {
+//	  if !send(id) {
+//	    break
+//	  }
+//	}
+func GenerateStream(ctx context.Context, options GenerateOptions) <-chan string {
+	ids := make(chan string)
+	go func() {
+		defer close(ids)
+		for id, err := range GenerateSeq(options) {
+			if err != nil {
+				return
+			}
+			select {
+			case ids <- id:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return ids
+}
+
+// GenerateSeq returns an unbounded iterator over memorable IDs. It stops
+// when the loop breaks, or after yielding the error if the options are
+// invalid or generation fails. IDs are drawn from an iteration-local
+// source like GenerateBatch.
+//
+// Example:
+//
+//	for id, err := range GenerateSeq(GenerateOptions{Components: 3}) {
+//	  if err != nil || len(seen) == 1000 {
+//	    break
+//	  }
+//	  seen[id] = true
+//	}
+func GenerateSeq(options GenerateOptions) iter.Seq2[string, error] {
+	return func(yield func(string, error) bool) {
+		if err := options.Validate(); err != nil {
+			yield("", err)
+			return
+		}
+		next := localAppender(options)
+		buf := make([]byte, 0, 64)
+		for {
+			var err error
+			buf, err = next(buf[:0])
+			if err != nil {
+				yield("", err)
+				return
+			}
+			if !yield(string(buf), nil) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package memorable_ids
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateStream(t *testing.T) {
+	t.Run("should stream IDs until the context is cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		ids := GenerateStream(ctx, GenerateOptions{Components: 3, Suffix: SuffixGenerators.Hex})
+
+		for range 100 {
+			id := <-ids
+			assert.Regexp(t, `^[a-z]+-[a-z]+(-pig)?-[a-z]+-[0-9a-f]{2}$`, id)
+		}
+		cancel()
+
+		select {
+		case <-drain(ids):
+		case <-time.After(time.Second):
+			t.Fatal("Expected the stream to close after cancellation")
+		}
+	})
+
+	t.Run("should close right away for invalid options", func(t *testing.T) {
+		_, open := <-GenerateStream(context.Background(), GenerateOptions{Components: 6})
+		assert.False(t, open)
+
+		_, open = <-GenerateStream(context.Background(), GenerateOptions{Separator: " "})
+		assert.False(t, open)
+	})
+}
+
+func TestGenerateSeq(t *testing.T) {
+	t.Run("should yield IDs until the loop breaks", func(t *testing.T) {
+		count := 0
+		for id, err := range GenerateSeq(GenerateOptions{Separator: "_"}) {
+			require.NoError(t, err)
+			assert.Len(t, strings.Split(id, "_"), 2)
+			if count++; count == 50 {
+				break
+			}
+		}
+		assert.Equal(t, 50, count)
+	})
+
+	t.Run("should yield the error and stop", func(t *testing.T) {
+		calls := 0
+		for _, err := range GenerateSeq(GenerateOptions{Components: 6}) {
+			calls++
+			assert.Error(t, err)
+		}
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("should validate the options before generating", func(t *testing.T) {
+		calls := 0
+		for id, err := range GenerateSeq(GenerateOptions{Separator: " "}) {
+			calls++
+			assert.Empty(t, id)
+			assert.ErrorIs(t, err, ErrInvalidOptions)
+		}
+		assert.Equal(t, 1, calls)
+	})
+}
+
+// drain reads ids until the channel is closed and then closes the returned channel
+func drain(ids <-chan string) <-chan struct{} {
+	done := make(chan struct{})
+	go func() {
+		for range ids {
+		}
+		close(done)
+	}()
+	return done
+}
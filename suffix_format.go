@@ -0,0 +1,96 @@
+package memorable_ids
+
+import (
+	"math/rand"
+	"reflect"
+	"strings"
+)
+
+/**
+ * Suffix formatting
+ *
+ * Suffixes are on the hot path of bulk generation, so the built-in ones
+ * avoid fmt: 3-digit numbers, hex bytes and letters are substrings of
+ * precomputed tables and never allocate, and other widths are formatted
+ * by hand into a small buffer. appendGenerate recognizes the built-in
+ * generators and appends their digits directly, skipping the *string
+ * allocation of the SuffixGenerator signature.
+ */
+
+// Lookup tables holding every 3-digit number, 2-digit hex value and letter
+var (
+	numberTable = func() string {
+		var table strings.Builder
+		for i := range 1000 {
+			table.Write(appendPadded(nil, i, 3))
+		}
+		return table.String()
+	}()
+	hexTable = func() string {
+		const digits = "0123456789abcdef"
+		var table strings.Builder
+		for i := range 256 {
+			table.WriteByte(digits[i>>4])
+			table.WriteByte(digits[i&0xf])
+		}
+		return table.String()
+	}()
+	letterTable = "abcdefghijklmnopqrstuvwxyz"
+)
+
+// appendPadded appends the decimal form of a non-negative value to dst,
+// left-padded with zeros to width digits
+func appendPadded(dst []byte, value, width int) []byte {
+	var buf [20]byte
+	i := len(buf)
+	for value >= 10 {
+		i--
+		buf[i] = byte('0' + value%10)
+		value /= 10
+	}
+	i--
+	buf[i] = byte('0' + value)
+	for len(buf)-i < width && i > 0 {
+		i--
+		buf[i] = '0'
+	}
+	for range width - len(buf) {
+		dst = append(dst, '0')
+	}
+	return append(dst, buf[i:]...)
+}
+
+// formatPadded returns the decimal form of a non-negative value,
+// left-padded with zeros to width digits
+func formatPadded(value, width int) string {
+	var buf [24]byte
+	return string(appendPadded(buf[:0], value, width))
+}
+
+// The number, hex and letter helpers format a drawn value
+
+func numberString(value int) string { return numberTable[value*3 : value*3+3] }
+func hexString(value int) string    { return hexTable[value*2 : value*2+2] }
+func letterString(value int) string { return letterTable[value : value+1] }
+
+// suffixAppenders append the built-in random suffixes without allocating,
+// keyed by the code pointer of their SuffixGenerator
+var suffixAppenders = map[uintptr]func(dst []byte) []byte{
+	reflect.ValueOf(SuffixGenerators.Number).Pointer():  func(dst []byte) []byte { return append(dst, numberString(rand.Intn(1000))...) },
+	reflect.ValueOf(SuffixGenerators.Number4).Pointer(): func(dst []byte) []byte { return appendPadded(dst, rand.Intn(10000), 4) },
+	reflect.ValueOf(SuffixGenerators.Hex).Pointer():     func(dst []byte) []byte { return append(dst, hexString(rand.Intn(256))...) },
+	reflect.ValueOf(SuffixGenerators.Letter).Pointer():  func(dst []byte) []byte { return append(dst, letterString(rand.Intn(26))...) },
+}
+
+// appendSuffix appends the separator and a suffix drawn from generator to
+// dst, if the generator returns one
+func appendSuffix(dst []byte, generator SuffixGenerator, separator string) []byte {
+	if appender, ok := suffixAppenders[reflect.ValueOf(generator).Pointer()]; ok {
+		return appender(append(dst, separator...))
+	}
+	if suffix := generator(); suffix != nil {
+		dst = append(dst, separator...)
+		dst = append(dst, *suffix...)
+	}
+	return dst
+}
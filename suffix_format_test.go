@@ -0,0 +1,72 @@
+package memorable_ids
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSuffixFormatting(t *testing.T) {
+	t.Run("should match fmt zero padding", func(t *testing.T) {
+		for _, width := range []int{0, 1, 3, 4, 6, 25} {
+			for _, value := range []int{0, 7, 42, 999, 1000, 9999, 123456, 1<<62 + 5} {
+				assert.Equal(t, fmt.Sprintf("%0*d", width, value), formatPadded(value, width))
+			}
+		}
+	})
+
+	t.Run("should format every table value", func(t *testing.T) {
+		for value := range 1000 {
+			assert.Equal(t, fmt.Sprintf("%03d", value), numberString(value))
+		}
+		for value := range 256 {
+			assert.Equal(t, fmt.Sprintf("%02x", value), hexString(value))
+		}
+		for value := range 26 {
+			assert.Equal(t, string(rune('a'+value)), letterString(value))
+		}
+	})
+
+	t.Run("should append built-in suffixes matching their specs", func(t *testing.T) {
+		for _, info := range builtinSuffixes {
+			if info.timeDerived {
+				continue
+			}
+			spec := info.spec()
+			for range 100 {
+				id := string(appendSuffix([]byte("cute-rabbit"), info.generator, "-"))
+				parsed := ParseWithOptions(id, ParseOptions{Suffix: info.generator})
+				if assert.NotNil(t, parsed.Suffix, id) {
+					assert.True(t, spec.Matches(*parsed.Suffix), id)
+				}
+			}
+		}
+	})
+
+	t.Run("should append custom suffixes", func(t *testing.T) {
+		custom := func() *string { suffix := "x1"; return &suffix }
+		none := func() *string { return nil }
+		assert.Equal(t, "cute_x1", string(appendSuffix([]byte("cute"), custom, "_")))
+		assert.Equal(t, "cute", string(appendSuffix([]byte("cute"), none, "_")))
+	})
+}
+
+func BenchmarkSuffixGenerators(b *testing.B) {
+	for _, info := range builtinSuffixes {
+		b.Run(info.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				info.generator()
+			}
+		})
+	}
+}
+
+func BenchmarkAppendSuffix(b *testing.B) {
+	buf := make([]byte, 0, 64)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		buf = appendSuffix(buf[:0], SuffixGenerators.Number, "-")
+	}
+}
@@ -0,0 +1,119 @@
+package memorable_ids
+
+import (
+	"math/rand"
+	"reflect"
+)
+
+// SuffixFunc is the v2 suffix generator signature: it returns the suffix
+// and whether the ID gets one, avoiding the nil-pointer-prone *string of
+// SuffixGenerator. SuffixFunc implements SuffixSource, so it can be used as
+// Config.Suffix directly; use Generator for GenerateOptions.Suffix.
+type SuffixFunc func() (suffix string, ok bool)
+
+// Suffix implements SuffixSource
+func (f SuffixFunc) Suffix() (string, bool) {
+	if f == nil {
+		return "", false
+	}
+	return f()
+}
+
+// Generator adapts f to the v1 SuffixGenerator form. The built-in
+// functions of Suffixes map to their SuffixGenerators counterparts, so
+// their metadata is available to DescribeSuffix and the analysis functions.
+//
+// Example:
+//
+//	Generate(GenerateOptions{Suffix: Suffixes.Hex.Generator()}) // "cute-rabbit-a3"
+func (f SuffixFunc) Generator() SuffixGenerator {
+	if f == nil {
+		return nil
+	}
+	pointer := reflect.ValueOf(f).Pointer()
+	for _, pair := range builtinSuffixPairs() {
+		if reflect.ValueOf(pair.v2).Pointer() == pointer {
+			return pair.v1
+		}
+	}
+	return func() *string {
+		suffix, ok := f()
+		if !ok {
+			return nil
+		}
+		return &suffix
+	}
+}
+
+// SuffixFuncOf adapts a v1 SuffixGenerator to a SuffixFunc; built-in
+// generators map to their Suffixes counterparts
+//
+// Example:
+//
+//	SuffixFuncOf(SuffixGenerators.Letter)() // "q", true
+func SuffixFuncOf(generator SuffixGenerator) SuffixFunc {
+	if generator == nil {
+		return nil
+	}
+	pointer := reflect.ValueOf(generator).Pointer()
+	for _, pair := range builtinSuffixPairs() {
+		if reflect.ValueOf(pair.v1).Pointer() == pointer {
+			return pair.v2
+		}
+	}
+	return generator.Suffix
+}
+
+// SuffixFuncCollection contains the predefined v2 suffix functions
+type SuffixFuncCollection struct {
+	// Number generates random 3-digit number (000-999)
+	Number SuffixFunc
+	// Number4 generates random 4-digit number (0000-9999)
+	Number4 SuffixFunc
+	// Hex generates random 2-digit hex (00-ff)
+	Hex SuffixFunc
+	// Timestamp generates last 4 digits of the current Unix milliseconds
+	Timestamp SuffixFunc
+	// Letter generates random lowercase letter (a-z)
+	Letter SuffixFunc
+}
+
+// Suffixes contains the predefined v2 suffix functions; SuffixGenerators
+// holds the same generators in the v1 form
+var Suffixes = SuffixFuncCollection{
+	Number:    numberSuffix,
+	Number4:   number4Suffix,
+	Hex:       hexSuffix,
+	Timestamp: timestampSuffix,
+	Letter:    letterSuffix,
+}
+
+// suffixPair links a built-in v2 suffix function to its v1 generator
+type suffixPair struct {
+	v2 SuffixFunc
+	v1 SuffixGenerator
+}
+
+// builtinSuffixPairs lists the built-in suffixes in both forms
+func builtinSuffixPairs() []suffixPair {
+	return []suffixPair{
+		{numberSuffix, SuffixGenerators.Number},
+		{number4Suffix, SuffixGenerators.Number4},
+		{hexSuffix, SuffixGenerators.Hex},
+		{timestampSuffix, SuffixGenerators.Timestamp},
+		{letterSuffix, SuffixGenerators.Letter},
+	}
+}
+
+func numberSuffix() (string, bool)    { return drawNumber(rand.Intn), true }
+func number4Suffix() (string, bool)   { return drawNumber4(rand.Intn), true }
+func hexSuffix() (string, bool)       { return drawHex(rand.Intn), true }
+func timestampSuffix() (string, bool) { return clockSuffix(SystemClock), true }
+func letterSuffix() (string, bool)    { return drawLetter(rand.Intn), true }
+
+// The draw functions format the built-in random suffixes from a source of indexes
+
+func drawNumber(intn func(int) int) string  { return numberString(intn(1000)) }
+func drawNumber4(intn func(int) int) string { return formatPadded(intn(10000), 4) }
+func drawHex(intn func(int) int) string     { return hexString(intn(256)) }
+func drawLetter(intn func(int) int) string  { return letterString(intn(26)) }
@@ -0,0 +1,70 @@
+package memorable_ids
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSuffixFunc(t *testing.T) {
+	t.Run("should generate the built-in suffixes", func(t *testing.T) {
+		patterns := map[string]SuffixFunc{
+			`^\d{3}$`:       Suffixes.Number,
+			`^\d{4}$`:       Suffixes.Number4,
+			`^[0-9a-f]{2}$`: Suffixes.Hex,
+			`^[a-z]$`:       Suffixes.Letter,
+		}
+		for pattern, suffixFunc := range patterns {
+			suffix, ok := suffixFunc()
+			require.True(t, ok)
+			assert.Regexp(t, pattern, suffix)
+		}
+
+		suffix, ok := Suffixes.Timestamp()
+		require.True(t, ok)
+		assert.Len(t, suffix, 4)
+	})
+
+	t.Run("should map built-ins between both forms", func(t *testing.T) {
+		spec, ok := DescribeSuffix(Suffixes.Hex.Generator())
+		require.True(t, ok)
+		assert.Equal(t, "hex", spec.Name)
+
+		suffixRange, err := SuffixRange(Suffixes.Number4.Generator())
+		require.NoError(t, err)
+		assert.Equal(t, 10000, suffixRange)
+
+		suffix, ok := SuffixFuncOf(SuffixGenerators.Letter)()
+		require.True(t, ok)
+		assert.Regexp(t, `^[a-z]$`, suffix)
+	})
+
+	t.Run("should adapt custom functions", func(t *testing.T) {
+		none := SuffixFunc(func() (string, bool) { return "", false })
+		assert.Nil(t, none.Generator()())
+
+		fixed := SuffixFunc(func() (string, bool) { return "x", true })
+		assert.Equal(t, "x", *fixed.Generator()())
+
+		legacy := SuffixFuncOf(func() *string { return nil })
+		_, ok := legacy()
+		assert.False(t, ok)
+
+		assert.Nil(t, SuffixFunc(nil).Generator())
+		assert.Nil(t, SuffixFuncOf(nil))
+	})
+
+	t.Run("should be accepted by generators", func(t *testing.T) {
+		gen := NewGenerator(Config{Suffix: Suffixes.Hex})
+		assert.Equal(t, 256, gen.config.SuffixRange)
+
+		id, err := gen.Generate()
+		require.NoError(t, err)
+		assert.Regexp(t, `-[0-9a-f]{2}$`, id)
+
+		id, err = Generate(GenerateOptions{Suffix: Suffixes.Number.Generator()})
+		require.NoError(t, err)
+		assert.Regexp(t, `-\d{3}$`, id)
+	})
+}
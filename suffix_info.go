@@ -0,0 +1,294 @@
+package memorable_ids
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"unicode/utf8"
+)
+
+// ErrUnknownSuffixRange is returned when the range of a custom suffix generator can't be determined
+var ErrUnknownSuffixRange = errors.New("unknown suffix range")
+
+// suffixInfo describes the output of a built-in or registered suffix generator
+type suffixInfo struct {
+	name      string
+	generator SuffixGenerator
+	// length is the longest suffix; minLength the shortest when it differs (0: same as length)
+	length    int
+	minLength int
+	rangeSize int
+	charset   string
+	// timeDerived marks suffixes derived from the clock rather than drawn
+	// uniformly, which don't multiply the ID space for IDs issued in bursts
+	timeDerived bool
+}
+
+// timeDerivedSuffixWarning is reported by analyses of time-derived suffixes
+const timeDerivedSuffixWarning = "suffix is time-derived, not random: IDs generated within the same millisecond share it, so it adds no collision protection for bursts and is counted as a 1x multiplier"
+
+// entropyRange returns the number of suffix values to use in collision math
+func (info suffixInfo) entropyRange() int {
+	if info.timeDerived {
+		return 1
+	}
+	return info.rangeSize
+}
+
+// lengths returns the shortest and longest suffix length
+func (info suffixInfo) lengths() (int, int) {
+	if info.minLength > 0 {
+		return info.minLength, info.length
+	}
+	return info.length, info.length
+}
+
+// builtinSuffixes describes the generators in SuffixGenerators
+var builtinSuffixes = []suffixInfo{
+	{name: "letter", generator: SuffixGenerators.Letter, length: 1, rangeSize: 26, charset: "abcdefghijklmnopqrstuvwxyz"},
+	{name: "hex", generator: SuffixGenerators.Hex, length: 2, rangeSize: 256, charset: "0123456789abcdef"},
+	{name: "number", generator: SuffixGenerators.Number, length: 3, rangeSize: 1000, charset: "0123456789"},
+	{name: "number4", generator: SuffixGenerators.Number4, length: 4, rangeSize: 10000, charset: "0123456789"},
+	{name: "timestamp", generator: SuffixGenerators.Timestamp, length: 4, rangeSize: 10000, charset: "0123456789", timeDerived: true},
+}
+
+// lookupSuffixInfo returns the description of a built-in suffix generator
+func lookupSuffixInfo(generator SuffixGenerator) (suffixInfo, bool) {
+	if generator == nil {
+		return suffixInfo{}, false
+	}
+
+	pointer := reflect.ValueOf(generator).Pointer()
+	for _, info := range builtinSuffixes {
+		if reflect.ValueOf(info.generator).Pointer() == pointer {
+			return info, true
+		}
+	}
+	return suffixInfo{}, false
+}
+
+// suffixInfoOf returns the description of provider, or of the built-in
+// generator when provider is nil
+func suffixInfoOf(provider SuffixProvider, generator SuffixGenerator) (suffixInfo, bool) {
+	if provider == nil {
+		return lookupSuffixInfo(generator)
+	}
+	spec := provider.Spec()
+	return suffixInfo{
+		name:        spec.Name,
+		length:      spec.MaxLength,
+		minLength:   spec.MinLength,
+		rangeSize:   spec.Range,
+		charset:     spec.Charset,
+		timeDerived: spec.TimeDerived,
+	}, true
+}
+
+// suffixSpecOf returns the spec of provider, or of the built-in generator
+// when provider is nil
+func suffixSpecOf(provider SuffixProvider, generator SuffixGenerator) (SuffixSpec, bool) {
+	if provider != nil {
+		return provider.Spec(), true
+	}
+	return DescribeSuffix(generator)
+}
+
+// suffixInfo returns the description of the options' suffix, if known
+func (o GenerateOptions) suffixInfo() (suffixInfo, bool) {
+	return suffixInfoOf(o.SuffixProvider, o.Suffix)
+}
+
+// hasSuffix reports whether the options append a suffix
+func (o GenerateOptions) hasSuffix() bool {
+	return o.SuffixProvider != nil || o.Suffix != nil
+}
+
+// suffixRange returns the number of distinct suffix values of the options
+// for collision math, like SuffixRange
+func (o GenerateOptions) suffixRange() (int, error) {
+	if !o.hasSuffix() {
+		return 1, nil
+	}
+	info, ok := o.suffixInfo()
+	if !ok {
+		return 0, ErrUnknownSuffixRange
+	}
+	return info.entropyRange(), nil
+}
+
+// appendSuffix appends the separator and the options' suffix to dst,
+// failing if the suffix provider does
+func (o GenerateOptions) appendSuffix(dst []byte) ([]byte, error) {
+	if o.SuffixProvider != nil {
+		suffix, err := o.SuffixProvider.Next()
+		if err != nil {
+			return dst, fmt.Errorf("suffix: %w", err)
+		}
+		dst = append(dst, o.Separator...)
+		return append(dst, suffix...), nil
+	}
+	if o.Suffix != nil {
+		dst = appendSuffix(dst, o.Suffix, o.Separator)
+	}
+	return dst, nil
+}
+
+// SuffixRange returns the number of distinct values a suffix generator
+// contributes to collision math: 1 for nil and time-derived generators,
+// the range size for the built-in generators, and ErrUnknownSuffixRange
+// otherwise; a SuffixProvider reports its range in its Spec
+//
+// Example:
+//
+//	SuffixRange(SuffixGenerators.Hex)    // 256, nil
+//	SuffixRange(SuffixGenerators.Letter) // 26, nil
+//	SuffixRange(nil)                     // 1, nil
+func SuffixRange(generator SuffixGenerator) (int, error) {
+	if generator == nil {
+		return 1, nil
+	}
+	info, ok := lookupSuffixInfo(generator)
+	if !ok {
+		return 0, ErrUnknownSuffixRange
+	}
+	return info.entropyRange(), nil
+}
+
+// SuffixSpec describes the output of a suffix generator, so analysis,
+// parsing and configuration code can reason about suffixes instead of
+// guessing
+type SuffixSpec struct {
+	// Name identifies the suffix family, e.g. "number" or "hex"
+	Name string
+	// Range is the number of distinct values the generator produces
+	Range int
+	// Charset lists the characters suffixes are made of
+	Charset string
+	// MinLength and MaxLength bound the suffix length in characters
+	MinLength int
+	MaxLength int
+	// TimeDerived marks suffixes derived from the clock rather than drawn
+	// at random; they count as a 1x multiplier in collision math
+	TimeDerived bool
+}
+
+// FixedLength returns the suffix length if every suffix has the same length, otherwise 0
+func (s SuffixSpec) FixedLength() int {
+	if s.MinLength == s.MaxLength {
+		return s.MaxLength
+	}
+	return 0
+}
+
+// Matches reports whether suffix could have been produced by a generator of this spec
+//
+// Example:
+//
+//	spec, _ := DescribeSuffix(SuffixGenerators.Hex)
+//	spec.Matches("ff")  // true
+//	spec.Matches("042") // false
+func (s SuffixSpec) Matches(suffix string) bool {
+	if length := utf8.RuneCountInString(suffix); length < s.MinLength || length > s.MaxLength {
+		return false
+	}
+	for _, r := range suffix {
+		if !strings.ContainsRune(s.Charset, r) {
+			return false
+		}
+	}
+	return true
+}
+
+// spec converts the internal description to a SuffixSpec
+func (info suffixInfo) spec() SuffixSpec {
+	minLength, maxLength := info.lengths()
+	return SuffixSpec{
+		Name:        info.name,
+		Range:       info.rangeSize,
+		Charset:     info.charset,
+		MinLength:   minLength,
+		MaxLength:   maxLength,
+		TimeDerived: info.timeDerived,
+	}
+}
+
+// DescribeSuffix returns the spec of a built-in suffix generator; a
+// SuffixProvider describes itself with Spec
+//
+// Example:
+//
+//	DescribeSuffix(SuffixGenerators.Number)
+//	// SuffixSpec{Name: "number", Range: 1000, Charset: "0123456789", MinLength: 3, MaxLength: 3}, true
+func DescribeSuffix(generator SuffixGenerator) (SuffixSpec, bool) {
+	info, ok := lookupSuffixInfo(generator)
+	if !ok {
+		return SuffixSpec{}, false
+	}
+	return info.spec(), true
+}
+
+// SuffixProvider produces suffixes that carry their spec, so DescribeSuffix
+// style reasoning works for suffixes other than the built-in generators:
+// MaxIDLength, parsing, validation and the analysis functions use the
+// spec, and generation fails with the error of Next instead of issuing an
+// ID without a suffix. NumberSuffix, CyclingSuffix, CounterSuffix and
+// TimestampSuffix are providers; NewSuffixProvider wraps a custom generator.
+type SuffixProvider interface {
+	// Next returns the next suffix, or the error that kept it from being produced
+	Next() (string, error)
+	// Spec describes the suffixes
+	Spec() SuffixSpec
+}
+
+// ErrNoSuffix is returned by providers created with NewSuffixProvider
+// when their generator returns no suffix
+var ErrNoSuffix = errors.New("suffix generator returned no suffix")
+
+// NewSuffixProvider attaches a spec to a custom suffix generator so that
+// MaxIDLength, parsing and the analysis functions can reason about it
+//
+// Example:
+//
+//	emoji := NewSuffixProvider(func() *string { ... }, SuffixSpec{
+//	  Name: "emoji", Range: 64, Charset: emojiSet, MinLength: 1, MaxLength: 1,
+//	})
+//	Generate(GenerateOptions{SuffixProvider: emoji})
+func NewSuffixProvider(generator SuffixGenerator, spec SuffixSpec) SuffixProvider {
+	return specifiedSuffix{generator: generator, spec: spec}
+}
+
+// specifiedSuffix is a suffix generator with a spec, see NewSuffixProvider
+type specifiedSuffix struct {
+	generator SuffixGenerator
+	spec      SuffixSpec
+}
+
+// Next implements SuffixProvider
+func (s specifiedSuffix) Next() (string, error) {
+	suffix := s.generator()
+	if suffix == nil {
+		return "", ErrNoSuffix
+	}
+	return *suffix, nil
+}
+
+// Spec implements SuffixProvider
+func (s specifiedSuffix) Spec() SuffixSpec {
+	return s.spec
+}
+
+// SuffixByName returns the built-in suffix generator with the given spec
+// name, for restoring serialized configurations
+//
+// Example:
+//
+//	SuffixByName("hex") // SuffixGenerators.Hex, true
+func SuffixByName(name string) (SuffixGenerator, bool) {
+	for _, info := range builtinSuffixes {
+		if info.name == name {
+			return info.generator, true
+		}
+	}
+	return nil, false
+}
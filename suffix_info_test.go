@@ -0,0 +1,160 @@
+package memorable_ids
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSuffixRange(t *testing.T) {
+	t.Run("should know the built-in generators", func(t *testing.T) {
+		cases := map[int]SuffixGenerator{
+			1:     nil,
+			26:    SuffixGenerators.Letter,
+			256:   SuffixGenerators.Hex,
+			1000:  SuffixGenerators.Number,
+			10000: SuffixGenerators.Number4,
+		}
+		for expected, generator := range cases {
+			suffixRange, err := SuffixRange(generator)
+			require.NoError(t, err)
+			assert.Equal(t, expected, suffixRange)
+		}
+
+		suffixRange, err := SuffixRange(SuffixGenerators.Timestamp)
+		require.NoError(t, err)
+		assert.Equal(t, 1, suffixRange, "Expected time-derived suffixes to count as 1x")
+	})
+
+	t.Run("should take the range of suffix providers from their spec", func(t *testing.T) {
+		small := NumberSuffix{Min: 1, Max: 49}
+		large := NumberSuffix{Min: 100, Max: 999, Width: 3}
+		cycle, err := NewCyclingSuffix(2, 1)
+		require.NoError(t, err)
+
+		for expected, provider := range map[int]SuffixProvider{49: small, 900: large, 100: cycle, 1: TimestampSuffix(nil)} {
+			suffixRange, err := GenerateOptions{SuffixProvider: provider}.suffixRange()
+			require.NoError(t, err)
+			assert.Equal(t, expected, suffixRange)
+		}
+
+		maxLength, err := MaxIDLength(GenerateOptions{SuffixProvider: small})
+		require.NoError(t, err)
+		minLength, err := MinIDLength(GenerateOptions{SuffixProvider: small})
+		require.NoError(t, err)
+		base, _ := MaxIDLength(GenerateOptions{})
+		baseMin, _ := MinIDLength(GenerateOptions{})
+		assert.Equal(t, base+3, maxLength)
+		assert.Equal(t, baseMin+2, minLength)
+	})
+
+	t.Run("should reject unknown generators", func(t *testing.T) {
+		_, err := SuffixRange(func() *string { return nil })
+		assert.ErrorIs(t, err, ErrUnknownSuffixRange)
+	})
+}
+
+func TestGetCollisionAnalysisForSuffix(t *testing.T) {
+	t.Run("should derive the multiplier from the generator", func(t *testing.T) {
+		analysis, err := GetCollisionAnalysisForSuffix(2, SuffixGenerators.Hex, AnalysisOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, GetCollisionAnalysis(2, 256).TotalCombinations, analysis.TotalCombinations)
+		assert.Empty(t, analysis.Warnings)
+	})
+
+	t.Run("should warn about time-derived suffixes", func(t *testing.T) {
+		analysis, err := GetCollisionAnalysisForSuffix(2, SuffixGenerators.Timestamp, AnalysisOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, CalculateCombinations(2, 1), analysis.TotalCombinations)
+		assert.Contains(t, analysis.Warnings, timeDerivedSuffixWarning)
+
+		again := GetCollisionAnalysis(2, 1)
+		assert.Empty(t, again.Warnings, "Expected the warning not to leak into the cache")
+	})
+
+	t.Run("should reject generators of unknown range", func(t *testing.T) {
+		_, err := GetCollisionAnalysisForSuffix(2, func() *string { return nil }, AnalysisOptions{})
+		assert.ErrorIs(t, err, ErrUnknownSuffixRange)
+	})
+}
+
+func TestDescribeSuffix(t *testing.T) {
+	t.Run("should describe built-in generators", func(t *testing.T) {
+		spec, ok := DescribeSuffix(SuffixGenerators.Number)
+		require.True(t, ok)
+		assert.Equal(t, SuffixSpec{Name: "number", Range: 1000, Charset: "0123456789", MinLength: 3, MaxLength: 3}, spec)
+		assert.Equal(t, 3, spec.FixedLength())
+
+		spec, ok = DescribeSuffix(SuffixGenerators.Timestamp)
+		require.True(t, ok)
+		assert.True(t, spec.TimeDerived)
+	})
+
+	t.Run("should describe variable-length generators", func(t *testing.T) {
+		spec := NumberSuffix{Min: 1, Max: 500}.Spec()
+		assert.Equal(t, 0, spec.FixedLength())
+		assert.Equal(t, 500, spec.Range)
+	})
+
+	t.Run("should attach specs to custom generators", func(t *testing.T) {
+		vowel := NewSuffixProvider(func() *string {
+			suffix := "a"
+			return &suffix
+		}, SuffixSpec{Name: "vowel", Range: 5, Charset: "aeiou", MinLength: 1, MaxLength: 1})
+		assert.Equal(t, "vowel", vowel.Spec().Name)
+
+		options := GenerateOptions{SuffixProvider: vowel}
+		suffixRange, err := options.suffixRange()
+		require.NoError(t, err)
+		assert.Equal(t, 5, suffixRange)
+
+		id, err := Generate(options)
+		require.NoError(t, err)
+		assert.Regexp(t, `-a$`, id)
+		parsed := ParseWithOptions(id, ParseOptions{SuffixProvider: vowel})
+		require.NotNil(t, parsed.Suffix)
+		assert.Equal(t, "a", *parsed.Suffix)
+
+		none := NewSuffixProvider(func() *string { return nil }, SuffixSpec{Name: "none"})
+		_, err = Generate(GenerateOptions{SuffixProvider: none})
+		assert.ErrorIs(t, err, ErrNoSuffix)
+	})
+
+	t.Run("should not describe unknown generators", func(t *testing.T) {
+		_, ok := DescribeSuffix(func() *string { return nil })
+		assert.False(t, ok)
+		_, ok = DescribeSuffix(nil)
+		assert.False(t, ok)
+	})
+
+	t.Run("should match suffixes against the spec", func(t *testing.T) {
+		spec, _ := DescribeSuffix(SuffixGenerators.Hex)
+		assert.True(t, spec.Matches("ff"))
+		assert.False(t, spec.Matches("042"))
+		assert.False(t, spec.Matches("zz"))
+	})
+
+	t.Run("should count suffix lengths in characters", func(t *testing.T) {
+		spec := SuffixSpec{Name: "emoji", Range: 3, Charset: "🦊🐇🦡", MinLength: 1, MaxLength: 1}
+		assert.True(t, spec.Matches("🦊"))
+		assert.False(t, spec.Matches("🦊🐇"))
+		assert.False(t, spec.Matches(""))
+	})
+}
+
+func TestSuffixByName(t *testing.T) {
+	t.Run("should round-trip built-in names", func(t *testing.T) {
+		for _, info := range builtinSuffixes {
+			generator, ok := SuffixByName(info.name)
+			require.True(t, ok)
+			spec, _ := DescribeSuffix(generator)
+			assert.Equal(t, info.name, spec.Name)
+		}
+	})
+
+	t.Run("should reject unknown names", func(t *testing.T) {
+		_, ok := SuffixByName("emoji")
+		assert.False(t, ok)
+	})
+}
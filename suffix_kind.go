@@ -0,0 +1,88 @@
+package memorable_ids
+
+// SuffixKind identifies the suffix family of a parsed ID
+type SuffixKind int
+
+const (
+	// SuffixNone means the ID has no suffix
+	SuffixNone SuffixKind = iota
+	// SuffixNumber is a 3-digit number, see SuffixGenerators.Number
+	SuffixNumber
+	// SuffixNumber4 is a 4-digit number, see SuffixGenerators.Number4
+	SuffixNumber4
+	// SuffixHex is a 2-digit hex number, see SuffixGenerators.Hex
+	SuffixHex
+	// SuffixLetter is a single letter, see SuffixGenerators.Letter
+	SuffixLetter
+	// SuffixTimestamp is a 4-digit timestamp, see SuffixGenerators.Timestamp;
+	// only reported when parsing with its spec, as it looks like SuffixNumber4
+	SuffixTimestamp
+	// SuffixCustom is any other suffix
+	SuffixCustom
+)
+
+// suffixKindNames are the names of the suffix kinds, matching the spec
+// names of the built-in generators
+var suffixKindNames = [...]string{
+	SuffixNone:      "none",
+	SuffixNumber:    "number",
+	SuffixNumber4:   "number4",
+	SuffixHex:       "hex",
+	SuffixLetter:    "letter",
+	SuffixTimestamp: "timestamp",
+	SuffixCustom:    "custom",
+}
+
+// String returns the name of the kind, e.g. "hex"
+func (k SuffixKind) String() string {
+	if k < SuffixNone || k > SuffixCustom {
+		return "unknown"
+	}
+	return suffixKindNames[k]
+}
+
+// DetectSuffixKind returns the built-in suffix family a suffix looks like.
+// Two-digit numbers are valid hex and reported as SuffixHex.
+//
+// Example:
+//
+//	DetectSuffixKind("042")  // SuffixNumber
+//	DetectSuffixKind("1234") // SuffixNumber4
+//	DetectSuffixKind("ff")   // SuffixHex
+//	DetectSuffixKind("z")    // SuffixLetter
+//	DetectSuffixKind("")     // SuffixNone
+func DetectSuffixKind(suffix string) SuffixKind {
+	switch {
+	case suffix == "":
+		return SuffixNone
+	case len(suffix) == 3 && isDigits(suffix):
+		return SuffixNumber
+	case len(suffix) == 4 && isDigits(suffix):
+		return SuffixNumber4
+	case len(suffix) == 2 && isHex(suffix):
+		return SuffixHex
+	case len(suffix) == 1 && suffix[0] >= 'a' && suffix[0] <= 'z':
+		return SuffixLetter
+	}
+	return SuffixCustom
+}
+
+// suffixKindOf returns the kind of a suffix spec name
+func suffixKindOf(name string) SuffixKind {
+	for kind, kindName := range suffixKindNames {
+		if kindName == name && SuffixKind(kind) != SuffixNone && SuffixKind(kind) != SuffixCustom {
+			return SuffixKind(kind)
+		}
+	}
+	return SuffixCustom
+}
+
+// isHex reports whether s consists of lowercase hex digits only
+func isHex(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if !(s[i] >= '0' && s[i] <= '9' || s[i] >= 'a' && s[i] <= 'f') {
+			return false
+		}
+	}
+	return s != ""
+}
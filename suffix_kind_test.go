@@ -0,0 +1,88 @@
+package memorable_ids
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSuffixKind(t *testing.T) {
+	t.Run("should detect the built-in families", func(t *testing.T) {
+		assert.Equal(t, SuffixNumber, DetectSuffixKind("042"))
+		assert.Equal(t, SuffixNumber4, DetectSuffixKind("1234"))
+		assert.Equal(t, SuffixHex, DetectSuffixKind("ff"))
+		assert.Equal(t, SuffixHex, DetectSuffixKind("42"))
+		assert.Equal(t, SuffixLetter, DetectSuffixKind("z"))
+		assert.Equal(t, SuffixCustom, DetectSuffixKind("12345"))
+		assert.Equal(t, SuffixCustom, DetectSuffixKind("FF"))
+		assert.Equal(t, SuffixNone, DetectSuffixKind(""))
+	})
+
+	t.Run("should name the kinds like the suffix specs", func(t *testing.T) {
+		for _, info := range builtinSuffixes {
+			assert.Equal(t, info.name, suffixKindOf(info.name).String())
+		}
+		assert.Equal(t, SuffixCustom, suffixKindOf("emoji"))
+		assert.Equal(t, "unknown", SuffixKind(42).String())
+	})
+
+	t.Run("should be reported by Parse", func(t *testing.T) {
+		assert.Equal(t, SuffixNumber, Parse("cute-rabbit-042", "-").SuffixKind)
+		assert.Equal(t, SuffixNumber4, Parse("cute-rabbit-1234", "-").SuffixKind)
+		assert.Equal(t, SuffixNone, Parse("cute-rabbit", "-").SuffixKind)
+
+		var parsed ParsedID
+		ParseInto("cute-rabbit-1234", "-", &parsed)
+		assert.Equal(t, SuffixNumber4, parsed.SuffixKind)
+		ParseInto("cute-rabbit", "-", &parsed)
+		assert.Equal(t, SuffixNone, parsed.SuffixKind)
+	})
+
+	t.Run("should take the kind from the suffix spec", func(t *testing.T) {
+		parsed := ParseWithOptions("cute-rabbit-ff", ParseOptions{Suffix: SuffixGenerators.Hex})
+		assert.Equal(t, SuffixHex, parsed.SuffixKind)
+
+		parsed = ParseWithOptions("cute-rabbit-1234", ParseOptions{Suffix: SuffixGenerators.Timestamp})
+		assert.Equal(t, SuffixTimestamp, parsed.SuffixKind)
+	})
+
+	t.Run("should detect hex and letter suffixes on request", func(t *testing.T) {
+		options := ParseOptions{DetectSuffix: true}
+
+		parsed := ParseWithOptions("cute-rabbit-ff", options)
+		assert.Equal(t, []string{"cute", "rabbit"}, parsed.Components)
+		require.NotNil(t, parsed.Suffix)
+		assert.Equal(t, "ff", *parsed.Suffix)
+		assert.Equal(t, SuffixHex, parsed.SuffixKind)
+
+		parsed = ParseWithOptions("cute-z", options)
+		assert.Equal(t, []string{"cute"}, parsed.Components)
+		assert.Equal(t, SuffixLetter, parsed.SuffixKind)
+
+		parsed = ParseWithOptions("cute-rabbit-042", options)
+		assert.Equal(t, SuffixNumber, parsed.SuffixKind)
+
+		// Without DetectSuffix, only digits are suffixes
+		parsed = ParseWithOptions("cute-rabbit-ff", ParseOptions{})
+		assert.Equal(t, []string{"cute", "rabbit", "ff"}, parsed.Components)
+		assert.Equal(t, SuffixNone, parsed.SuffixKind)
+	})
+
+	t.Run("should keep words of the expected class as components", func(t *testing.T) {
+		original := Verbs
+		Verbs = append([]string{"be"}, original...)
+		defer func() { Verbs = original }()
+
+		parsed := ParseWithOptions("cute-rabbit-be", ParseOptions{DetectSuffix: true})
+		assert.Equal(t, []string{"cute", "rabbit", "be"}, parsed.Components)
+		assert.Nil(t, parsed.Suffix)
+
+		parsed = ParseWithOptions("cute-be", ParseOptions{DetectSuffix: true})
+		assert.Equal(t, []string{"cute"}, parsed.Components, "Expected a hex suffix in the noun position")
+		assert.Equal(t, SuffixHex, parsed.SuffixKind)
+
+		parsed = ParseWithOptions("f", ParseOptions{DetectSuffix: true})
+		assert.Equal(t, []string{"f"}, parsed.Components, "Expected a single part never to be a suffix")
+	})
+}
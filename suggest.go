@@ -0,0 +1,127 @@
+package memorable_ids
+
+import (
+	"slices"
+	"strings"
+)
+
+// suggestSeparators are the separators Suggest recognizes in mistyped IDs
+var suggestSeparators = []string{"-", "_", " ", "."}
+
+// suffixConfusables maps letters commonly typed for digits in numeric suffixes
+var suffixConfusables = map[rune]rune{'o': '0', 'i': '1', 'l': '1', 'z': '2', 's': '5', 'b': '8'}
+
+// suggestion is a candidate ID or ID prefix with its total edit cost
+type suggestion struct {
+	words []string
+	cost  int
+}
+
+// Suggest returns up to n valid IDs the user most likely meant when typing
+// invalid, for "did you mean" prompts on share code forms. Each word is
+// matched against its positional word class by edit distance; a numeric
+// suffix is kept, split off a word it was typed into, or repaired when
+// letters were typed for digits ("O42" → "042"). Inputs without any
+// separator are segmented first (see Segment). Suggestions are ordered by
+// total edit distance and use the separator found in the input. An input
+// that is already valid comes first.
+//
+// Example:
+//
+//	Suggest("cuet-rabit-042", 3) // ["cute-rabbit-042", "quiet-rabbit-042", "cute-rat-042"]
+//	Suggest("large_fox_O42", 1)  // ["large_fox_042"]
+func Suggest(invalid string, n int) []string {
+	if n < 1 {
+		return nil
+	}
+	id := Canonicalize(invalid)
+	separator := ParseOptions{Separators: suggestSeparators}.separatorFor(id)
+
+	parts := strings.Split(id, separator)
+	suffix, parts := suggestSuffix(parts)
+	if len(parts) == 1 {
+		// Separators left out entirely
+		if parsed, err := Segment(parts[0], SegmentOptions{}); err == nil {
+			parts = parsed.Components
+		}
+	}
+	if len(parts) == 0 || len(parts) > len(wordClasses) {
+		return nil
+	}
+
+	dict := GetDictionary()
+	best := []suggestion{{}}
+	for i, part := range parts {
+		candidates := wordCandidates(dict, wordClasses[i], part, n)
+		if len(candidates) == 0 {
+			return nil
+		}
+		best = combineSuggestions(best, candidates, n)
+	}
+
+	ids := make([]string, len(best))
+	for i, candidate := range best {
+		words := candidate.words
+		if suffix != "" {
+			words = append(words, suffix)
+		}
+		ids[i] = strings.Join(words, separator)
+	}
+	return ids
+}
+
+// suggestSuffix detects the numeric suffix of the parts of a mistyped ID,
+// returning it and the word parts
+func suggestSuffix(parts []string) (string, []string) {
+	last := parts[len(parts)-1]
+	if isDigits(last) {
+		return last, parts[:len(parts)-1]
+	}
+
+	// Letters typed for digits, in a part that has at least one digit
+	if len(parts) > 1 && strings.ContainsAny(last, "0123456789") {
+		repaired := []rune(last)
+		for i, r := range repaired {
+			if digit, ok := suffixConfusables[r]; ok {
+				repaired[i] = digit
+			}
+		}
+		if isDigits(string(repaired)) {
+			return string(repaired), parts[:len(parts)-1]
+		}
+	}
+
+	// Digits typed into the last word without a separator
+	if word := strings.TrimRight(last, "0123456789"); word != last && word != "" {
+		return last[len(word):], append(parts[:len(parts)-1:len(parts)-1], word)
+	}
+	return "", parts
+}
+
+// wordCandidates returns up to n words of class closest to typed, within
+// an edit distance of half its length
+func wordCandidates(dict Dictionary, class WordClass, typed string, n int) []suggestion {
+	matches := dict.trieFor(class).within(dict.Words(class), typed, max(1, (len(typed)+1)/2))
+	candidates := make([]suggestion, 0, min(n, len(matches)))
+	for _, match := range matches[:min(n, len(matches))] {
+		candidates = append(candidates, suggestion{words: []string{match.word}, cost: match.distance})
+	}
+	return candidates
+}
+
+// combineSuggestions extends every prefix with every candidate word and
+// keeps the n cheapest results. Keeping the n best prefixes is exact, as
+// costs are summed independently per word.
+func combineSuggestions(prefixes, candidates []suggestion, n int) []suggestion {
+	combined := make([]suggestion, 0, len(prefixes)*len(candidates))
+	for _, prefix := range prefixes {
+		for _, candidate := range candidates {
+			combined = append(combined, suggestion{
+				words: append(slices.Clip(prefix.words), candidate.words...),
+				cost:  prefix.cost + candidate.cost,
+			})
+		}
+	}
+	slices.SortStableFunc(combined, func(a, b suggestion) int { return a.cost - b.cost })
+	return combined[:min(n, len(combined))]
+}
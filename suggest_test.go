@@ -0,0 +1,38 @@
+package memorable_ids
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSuggest(t *testing.T) {
+	t.Run("should rank corrections by edit distance", func(t *testing.T) {
+		suggestions := Suggest("cuet-rabit-042", 3)
+		assert.Equal(t, []string{"cute-rabbit-042", "quiet-rabbit-042", "cute-rat-042"}, suggestions)
+	})
+
+	t.Run("should put valid input first", func(t *testing.T) {
+		suggestions := Suggest("Large-Fox-Swim", 2)
+		assert.Equal(t, "large-fox-swim", suggestions[0])
+		assert.Len(t, suggestions, 2)
+	})
+
+	t.Run("should repair letters typed for suffix digits", func(t *testing.T) {
+		assert.Equal(t, []string{"large_fox_042"}, Suggest("large_fox_O42", 1))
+	})
+
+	t.Run("should split suffixes typed into the last word", func(t *testing.T) {
+		assert.Equal(t, []string{"cute-rabbit-042"}, Suggest("cute-rabbit042", 1))
+	})
+
+	t.Run("should segment input without separators", func(t *testing.T) {
+		assert.Equal(t, []string{"cute-rabbit-042"}, Suggest("cuterabbit042", 1))
+	})
+
+	t.Run("should give up on input unlike any ID", func(t *testing.T) {
+		assert.Empty(t, Suggest("zzzz-qqqq", 3))
+		assert.Empty(t, Suggest("cute-rabbit", 0))
+		assert.Empty(t, Suggest("a-b-c-d-e-f", 3))
+	})
+}
@@ -0,0 +1,26 @@
+package memorable_ids
+
+import (
+	"crypto/sha256"
+	"slices"
+	"strings"
+)
+
+// TeamName deterministically derives a shared three-word name from a set
+// of member identities. Members are sorted and deduplicated first, so the
+// same pair or group always gets the same room or channel name regardless
+// of argument order.
+//
+// Example:
+//
+//	TeamName("alice", "bob") // "windy-goose-knit"
+//	TeamName("bob", "alice") // "windy-goose-knit"
+func TeamName(memberIDs ...string) string {
+	members := slices.Clone(memberIDs)
+	slices.Sort(members)
+	members = slices.Compact(members)
+
+	// NUL cannot appear in typical identities, so joining is unambiguous
+	hash := sha256.Sum256([]byte(strings.Join(members, "\x00")))
+	return nameFromHash(GetDictionary(), hash[:], 3, 0, "-")
+}
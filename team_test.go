@@ -0,0 +1,35 @@
+package memorable_ids
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTeamName(t *testing.T) {
+	t.Run("should be stable for the same member set", func(t *testing.T) {
+		assert.Equal(t, "windy-goose-knit", TeamName("alice", "bob"))
+		assert.Equal(t, TeamName("alice", "bob"), TeamName("bob", "alice"))
+		assert.Equal(t, TeamName("alice", "bob"), TeamName("bob", "alice", "bob"))
+	})
+
+	t.Run("should differ between groups", func(t *testing.T) {
+		assert.NotEqual(t, TeamName("alice", "bob"), TeamName("alice", "bob", "carol"))
+		assert.NotEqual(t, TeamName("ab", "c"), TeamName("a", "bc"), "Expected member boundaries to matter")
+	})
+
+	t.Run("should not modify the arguments", func(t *testing.T) {
+		members := []string{"carol", "alice", "bob"}
+		TeamName(members...)
+		assert.Equal(t, []string{"carol", "alice", "bob"}, members)
+	})
+
+	t.Run("should use three dictionary words", func(t *testing.T) {
+		name := TeamName("alice", "bob", "carol")
+		words, err := splitClassWords(GetDictionary(), name, "-", wordClasses[:3])
+		assert.NoError(t, err)
+		assert.Len(t, words, 3)
+		assert.False(t, strings.HasSuffix(name, "-"))
+	})
+}
@@ -0,0 +1,71 @@
+package memorable_ids
+
+import (
+	"fmt"
+	"math/rand"
+	"slices"
+	"sync"
+)
+
+/**
+ * Theme registry
+ *
+ * Themed dictionaries live in optional subpackages (themes/fantasy,
+ * themes/scifi) that register themselves on import, so the vocabulary is
+ * only compiled into binaries that ask for it:
+ *
+ *	import _ "github.com/riipandi/memorable-ids/themes/fantasy"
+ *
+ *	GenerateThemed("fantasy", GenerateOptions{Components: 3}) // "ancient-dragon-soar"
+ */
+
+var (
+	themesMu sync.RWMutex
+	themes   = make(map[string]Dictionary)
+)
+
+// RegisterTheme makes a themed dictionary available under name. It is
+// meant to be called from the init function of a theme package and panics
+// if the name is already taken or the dictionary is invalid.
+func RegisterTheme(name string, dict Dictionary) {
+	themesMu.Lock()
+	defer themesMu.Unlock()
+
+	if _, exists := themes[name]; exists {
+		panic(fmt.Sprintf("memorable_ids: theme %q registered twice", name))
+	}
+	if err := dict.Validate(); err != nil {
+		panic(fmt.Sprintf("memorable_ids: theme %q: %v", name, err))
+	}
+	themes[name] = dict
+}
+
+// Theme returns the dictionary registered under name
+func Theme(name string) (Dictionary, bool) {
+	themesMu.RLock()
+	defer themesMu.RUnlock()
+	dict, ok := themes[name]
+	return dict, ok
+}
+
+// Themes returns the names of the registered themes in sorted order
+func Themes() []string {
+	themesMu.RLock()
+	defer themesMu.RUnlock()
+
+	names := make([]string, 0, len(themes))
+	for name := range themes {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// GenerateThemed creates an ID like Generate, drawing words from a registered theme
+func GenerateThemed(theme string, options GenerateOptions) (string, error) {
+	dict, ok := Theme(theme)
+	if !ok {
+		return "", fmt.Errorf("unknown theme %q (is its package imported?)", theme)
+	}
+	return generate(dict, options, rand.Intn)
+}
@@ -0,0 +1,42 @@
+package memorable_ids
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestThemeRegistry(t *testing.T) {
+	t.Run("should register and generate from themes", func(t *testing.T) {
+		RegisterTheme("test-solo", NewDictionary([]string{"solo"}, []string{"theme"}, []string{"run"}, []string{"fast"}, []string{"in"}))
+		t.Cleanup(func() {
+			themesMu.Lock()
+			delete(themes, "test-solo")
+			themesMu.Unlock()
+		})
+
+		id, err := GenerateThemed("test-solo", GenerateOptions{Components: 3})
+		require.NoError(t, err)
+		assert.Equal(t, "solo-theme-run", id)
+		assert.Contains(t, Themes(), "test-solo")
+	})
+
+	t.Run("should panic on duplicate or invalid themes", func(t *testing.T) {
+		assert.Panics(t, func() { RegisterTheme("test-empty", NewDictionary(nil, nil, nil, nil, nil)) })
+
+		dict := NewDictionary([]string{"a"}, []string{"b"}, []string{"c"}, []string{"d"}, []string{"e"})
+		RegisterTheme("test-dup", dict)
+		t.Cleanup(func() {
+			themesMu.Lock()
+			delete(themes, "test-dup")
+			themesMu.Unlock()
+		})
+		assert.Panics(t, func() { RegisterTheme("test-dup", dict) })
+	})
+
+	t.Run("should reject unknown themes", func(t *testing.T) {
+		_, err := GenerateThemed("steampunk", GenerateOptions{})
+		assert.ErrorContains(t, err, `unknown theme "steampunk"`)
+	})
+}
@@ -0,0 +1,46 @@
+// Package fantasy registers the "fantasy" theme: creatures, artifacts and
+// quests for games and hackathon tooling. Import it for its side effect:
+//
+//	import _ "github.com/riipandi/memorable-ids/themes/fantasy"
+//
+//	memorable.GenerateThemed("fantasy", memorable.GenerateOptions{Components: 3}) // "ancient-dragon-soar"
+package fantasy
+
+import memorable "github.com/riipandi/memorable-ids"
+
+// Name is the name the theme is registered under
+const Name = "fantasy"
+
+// Adjectives contains fantasy adjectives
+var Adjectives = []string{
+	"ancient", "arcane", "blessed", "cursed", "dwarven", "elven", "enchanted",
+	"eldritch", "fabled", "fey", "gilded", "grim", "hallowed", "heroic",
+	"hidden", "legendary", "lost", "mystic", "noble", "runic", "sacred",
+	"shadowy", "silver", "spectral", "stormborn", "sylvan", "valiant",
+	"wandering", "wild", "wise",
+}
+
+// Nouns contains fantasy creatures and artifacts
+var Nouns = []string{
+	"basilisk", "centaur", "chimera", "dragon", "dryad", "gargoyle", "goblin",
+	"golem", "griffin", "hydra", "kraken", "manticore", "minotaur", "phoenix",
+	"pixie", "sphinx", "troll", "unicorn", "wraith", "wyvern", "amulet",
+	"chalice", "crown", "grimoire", "lantern", "orb", "rune", "scepter",
+	"scroll", "shield", "staff", "sword", "talisman", "tome", "wand",
+}
+
+// Verbs contains fantasy verbs - present tense
+var Verbs = []string{
+	"banish", "bewitch", "brew", "conjure", "enchant", "forge", "guard",
+	"hunt", "invoke", "quest", "roam", "scry", "slay", "soar", "summon",
+	"vanquish", "wander", "ward",
+}
+
+// Dictionary returns the fantasy dictionary, using the built-in adverbs and prepositions
+func Dictionary() memorable.Dictionary {
+	return memorable.NewDictionary(Adjectives, Nouns, Verbs, memorable.Adverbs, memorable.Prepositions)
+}
+
+func init() {
+	memorable.RegisterTheme(Name, Dictionary())
+}
@@ -0,0 +1,46 @@
+// Package scifi registers the "scifi" theme: technology, starships and
+// planets for games and hackathon tooling. Import it for its side effect:
+//
+//	import _ "github.com/riipandi/memorable-ids/themes/scifi"
+//
+//	memorable.GenerateThemed("scifi", memorable.GenerateOptions{Components: 3}) // "quantum-nebula-warp"
+package scifi
+
+import memorable "github.com/riipandi/memorable-ids"
+
+// Name is the name the theme is registered under
+const Name = "scifi"
+
+// Adjectives contains sci-fi adjectives
+var Adjectives = []string{
+	"alien", "atomic", "binary", "bionic", "cosmic", "cyber", "galactic",
+	"holographic", "hyper", "ionic", "lunar", "magnetic", "neural", "nuclear",
+	"orbital", "photonic", "plasma", "quantum", "robotic", "solar", "sonic",
+	"stellar", "synthetic", "temporal", "warped", "zero",
+}
+
+// Nouns contains sci-fi technology, vessels and celestial bodies
+var Nouns = []string{
+	"android", "asteroid", "beacon", "comet", "cruiser", "cyborg", "drone",
+	"freighter", "galaxy", "hologram", "laser", "meteor", "module", "moon",
+	"nebula", "probe", "pulsar", "quasar", "reactor", "robot", "rocket",
+	"rover", "satellite", "shuttle", "starship", "station", "supernova",
+	"thruster", "wormhole", "mars", "jupiter", "saturn", "neptune", "titan",
+	"europa", "vega",
+}
+
+// Verbs contains sci-fi verbs - present tense
+var Verbs = []string{
+	"beam", "cloak", "compute", "decode", "dock", "drift", "eject", "hack",
+	"ignite", "launch", "orbit", "scan", "teleport", "terraform", "transmit",
+	"upload", "warp",
+}
+
+// Dictionary returns the sci-fi dictionary, using the built-in adverbs and prepositions
+func Dictionary() memorable.Dictionary {
+	return memorable.NewDictionary(Adjectives, Nouns, Verbs, memorable.Adverbs, memorable.Prepositions)
+}
+
+func init() {
+	memorable.RegisterTheme(Name, Dictionary())
+}
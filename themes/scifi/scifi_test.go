@@ -0,0 +1,39 @@
+package scifi
+
+import (
+	"strings"
+	"testing"
+
+	memorable "github.com/riipandi/memorable-ids"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTheme(t *testing.T) {
+	t.Run("should register a valid dictionary", func(t *testing.T) {
+		dict, ok := memorable.Theme(Name)
+		require.True(t, ok, "Expected theme to be registered on import")
+		assert.NoError(t, dict.Validate())
+		assert.Contains(t, memorable.Themes(), Name)
+	})
+
+	t.Run("should only contain lowercase single-token words", func(t *testing.T) {
+		for _, words := range [][]string{Adjectives, Nouns, Verbs} {
+			for _, word := range words {
+				assert.Equal(t, strings.ToLower(word), word)
+				assert.NotContains(t, word, "-", "Expected %q not to contain the default separator", word)
+			}
+		}
+	})
+
+	t.Run("should generate themed IDs", func(t *testing.T) {
+		id, err := memorable.GenerateThemed(Name, memorable.GenerateOptions{Components: 3})
+		require.NoError(t, err)
+
+		parts := strings.Split(id, "-")
+		require.Len(t, parts, 3)
+		assert.Contains(t, Adjectives, parts[0])
+		assert.Contains(t, Nouns, parts[1])
+		assert.Contains(t, Verbs, parts[2])
+	})
+}
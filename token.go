@@ -0,0 +1,91 @@
+package memorable_ids
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+const (
+	// MaxTokenPayload is the largest payload EncodeToken accepts, in bytes
+	MaxTokenPayload = 64
+	// tokenTagWords is the number of trailing words holding the HMAC tag, enough
+	// for at least 64 bits wherever the tag starts in the class cycle
+	tokenTagWords = 12
+)
+
+// ErrInvalidToken is returned by DecodeToken for malformed or tampered tokens
+var ErrInvalidToken = errors.New("invalid token")
+
+// EncodeToken wraps a small payload, such as a pagination cursor or a
+// short-lived grant, into a memorable word string. The payload words are
+// followed by 12 HMAC-SHA256 tag words, so DecodeToken rejects tokens that
+// were edited or forged without the key. The tag carries at least 64 bits
+// whichever classes its words fall on, so forging one by guessing is
+// impractical. The payload is authenticated, not encrypted.
+//
+// Example:
+//
+//	token, _ := EncodeToken([]byte("page=2"), []byte("secret"))
+//	// "fresh-heron-talk-fairly-with-tidy-newt-talk-patiently-in-cute-door-sleep-freely-through-funny-guinea-pig-flounder-patiently-of-bad-spider-fly"
+//
+//	DecodeToken(token, []byte("secret")) // []byte("page=2"), nil
+func EncodeToken(payload []byte, key []byte) (string, error) {
+	if len(key) == 0 {
+		return "", errors.New("token key must not be empty")
+	}
+	if len(payload) > MaxTokenPayload {
+		return "", fmt.Errorf("token payload exceeds %d bytes", MaxTokenPayload)
+	}
+
+	dict := GetDictionary()
+
+	// A leading 0x01 byte preserves leading zero bytes of the payload
+	framed := append([]byte{1}, payload...)
+	words := encodeRadix(dict, new(big.Int).SetBytes(framed), radixClasses(dict, len(framed)*8))
+	words = append(words, tokenTag(dict, payload, key, len(words))...)
+	return strings.Join(words, "-"), nil
+}
+
+// DecodeToken verifies the tag of a token created by EncodeToken and returns its payload
+func DecodeToken(token string, key []byte) ([]byte, error) {
+	if len(key) == 0 {
+		return nil, errors.New("token key must not be empty")
+	}
+
+	dict := GetDictionary()
+	words, err := splitCycleWords(dict, strings.ToLower(strings.TrimSpace(token)), "-")
+	if err != nil || len(words) <= tokenTagWords {
+		return nil, ErrInvalidToken
+	}
+
+	payloadWords, tagWords := words[:len(words)-tokenTagWords], words[len(words)-tokenTagWords:]
+	value, err := decodeRadix(dict, payloadWords, cycleClasses(0, len(payloadWords)))
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+
+	framed := value.Bytes()
+	if len(framed) == 0 || framed[0] != 1 || len(framed)-1 > MaxTokenPayload {
+		return nil, ErrInvalidToken
+	}
+	payload := framed[1:]
+
+	expected := strings.Join(tokenTag(dict, payload, key, len(payloadWords)), "-")
+	if subtle.ConstantTimeCompare([]byte(expected), []byte(strings.Join(tagWords, "-"))) != 1 {
+		return nil, ErrInvalidToken
+	}
+	return payload, nil
+}
+
+// tokenTag returns the HMAC tag words, continuing the class cycle after offset payload words
+func tokenTag(dict Dictionary, payload, key []byte, offset int) []string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write(payload)
+	sum := mac.Sum(nil)
+	return encodeRadix(dict, new(big.Int).SetBytes(sum), cycleClasses(offset, tokenTagWords))
+}
@@ -0,0 +1,72 @@
+package memorable_ids
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToken(t *testing.T) {
+	key := []byte("secret")
+
+	t.Run("should round-trip payloads", func(t *testing.T) {
+		for _, payload := range [][]byte{{}, {0}, {0, 0, 7}, []byte("page=2"), []byte(strings.Repeat("x", MaxTokenPayload))} {
+			token, err := EncodeToken(payload, key)
+			require.NoError(t, err)
+
+			decoded, err := DecodeToken(token, key)
+			require.NoError(t, err, "DecodeToken(%q)", token)
+			assert.Equal(t, payload, decoded)
+		}
+	})
+
+	t.Run("should match the documented example", func(t *testing.T) {
+		token, err := EncodeToken([]byte("page=2"), key)
+		require.NoError(t, err)
+		assert.Equal(t, "fresh-heron-talk-fairly-with-tidy-newt-talk-patiently-in-cute-door-sleep-freely-through-funny-guinea-pig-flounder-patiently-of-bad-spider-fly", token)
+
+		decoded, err := DecodeToken(strings.ToUpper(token), key)
+		require.NoError(t, err)
+		assert.Equal(t, []byte("page=2"), decoded)
+	})
+
+	t.Run("should carry at least 64 tag bits at any cycle offset", func(t *testing.T) {
+		dict := GetDictionary()
+		for offset := 0; offset < len(wordClasses); offset++ {
+			bits := 0.0
+			for _, class := range cycleClasses(offset, tokenTagWords) {
+				bits += math.Log2(float64(len(dict.Words(class))))
+			}
+			assert.GreaterOrEqual(t, bits, 64.0, "offset %d", offset)
+		}
+	})
+
+	t.Run("should reject tampered tokens", func(t *testing.T) {
+		token, err := EncodeToken([]byte("page=2"), key)
+		require.NoError(t, err)
+
+		_, err = DecodeToken(token, []byte("other"))
+		assert.ErrorIs(t, err, ErrInvalidToken)
+
+		tampered := strings.Replace(token, "fresh", "cute", 1)
+		_, err = DecodeToken(tampered, key)
+		assert.ErrorIs(t, err, ErrInvalidToken)
+
+		_, err = DecodeToken("cute-rabbit", key)
+		assert.ErrorIs(t, err, ErrInvalidToken)
+
+		_, err = DecodeToken("not a token", key)
+		assert.ErrorIs(t, err, ErrInvalidToken)
+	})
+
+	t.Run("should validate inputs", func(t *testing.T) {
+		_, err := EncodeToken([]byte("x"), nil)
+		assert.Error(t, err)
+
+		_, err = EncodeToken(make([]byte, MaxTokenPayload+1), key)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,164 @@
+package memorable_ids
+
+import (
+	"slices"
+	"unicode/utf8"
+)
+
+/**
+ * Dictionary prefix index
+ *
+ * Completion, suggestion and segmentation query words by prefix or by
+ * edit distance. A rune trie per word class answers these without
+ * scanning every word: prefix queries walk to the prefix node, and fuzzy
+ * queries compute Levenshtein rows along trie paths, pruning subtrees
+ * whose best row value already exceeds the distance limit. Tries are
+ * built on first use and cached with the membership index.
+ */
+
+// wordTrie is a rune trie over the words of one class
+type wordTrie struct {
+	root trieNode
+}
+
+// trieNode is a trie node; position is the index of the word ending here, or -1
+type trieNode struct {
+	children map[rune]*trieNode
+	position int
+}
+
+// trieMatch is a word found by a trie query
+type trieMatch struct {
+	word     string
+	position int
+	distance int
+}
+
+// buildTrie indexes words, keeping the first position of duplicates
+func buildTrie(words []string) *wordTrie {
+	trie := &wordTrie{root: trieNode{position: -1}}
+	for position, word := range words {
+		node := &trie.root
+		for _, r := range word {
+			child, ok := node.children[r]
+			if !ok {
+				if node.children == nil {
+					node.children = make(map[rune]*trieNode)
+				}
+				child = &trieNode{position: -1}
+				node.children[r] = child
+			}
+			node = child
+		}
+		if node.position < 0 {
+			node.position = position
+		}
+	}
+	return trie
+}
+
+// classTries returns the per-class tries of the index, building them on first use
+func (x *dictionaryIndex) classTries(d Dictionary) *[6]*wordTrie {
+	x.triesOnce.Do(func() {
+		for i, class := range allWordClasses {
+			x.tries[i] = buildTrie(d.Words(class))
+		}
+	})
+	return &x.tries
+}
+
+// trieFor returns the trie of a class of the dictionary
+func (d Dictionary) trieFor(class WordClass) *wordTrie {
+	return indexFor(d).classTries(d)[class]
+}
+
+// withPrefix returns the words starting with prefix, in dictionary order
+func (t *wordTrie) withPrefix(words []string, prefix string) []trieMatch {
+	node := &t.root
+	for _, r := range prefix {
+		if node = node.children[r]; node == nil {
+			return nil
+		}
+	}
+
+	var matches []trieMatch
+	var collect func(node *trieNode)
+	collect = func(node *trieNode) {
+		if node.position >= 0 {
+			matches = append(matches, trieMatch{word: words[node.position], position: node.position})
+		}
+		for _, child := range node.children {
+			collect(child)
+		}
+	}
+	collect(node)
+	slices.SortFunc(matches, func(a, b trieMatch) int { return a.position - b.position })
+	return matches
+}
+
+// prefixLengths returns the byte lengths of the words that are prefixes
+// of s, longest first
+func (t *wordTrie) prefixLengths(s string) []int {
+	var lengths []int
+	node := &t.root
+	for offset, r := range s {
+		if node = node.children[r]; node == nil {
+			break
+		}
+		if node.position >= 0 {
+			lengths = append(lengths, offset+utf8.RuneLen(r))
+		}
+	}
+	slices.Reverse(lengths)
+	return lengths
+}
+
+// within returns the words within maxDistance edits of word, ordered by
+// distance and then dictionary order. Distances match levenshtein.
+func (t *wordTrie) within(words []string, word string, maxDistance int) []trieMatch {
+	target := []rune(word)
+	row := make([]int, len(target)+1)
+	for i := range row {
+		row[i] = i
+	}
+
+	var matches []trieMatch
+	var search func(node *trieNode, r rune, previous []int)
+	search = func(node *trieNode, r rune, previous []int) {
+		current := make([]int, len(previous))
+		current[0] = previous[0] + 1
+		best := current[0]
+		for i := 1; i < len(current); i++ {
+			cost := 1
+			if target[i-1] == r {
+				cost = 0
+			}
+			current[i] = min(previous[i]+1, current[i-1]+1, previous[i-1]+cost)
+			best = min(best, current[i])
+		}
+
+		if distance := current[len(current)-1]; node.position >= 0 && distance <= maxDistance {
+			matches = append(matches, trieMatch{word: words[node.position], position: node.position, distance: distance})
+		}
+		if best > maxDistance {
+			return
+		}
+		for r, child := range node.children {
+			search(child, r, current)
+		}
+	}
+
+	if t.root.position >= 0 && len(target) <= maxDistance {
+		matches = append(matches, trieMatch{word: words[t.root.position], position: t.root.position, distance: len(target)})
+	}
+	for r, child := range t.root.children {
+		search(child, r, row)
+	}
+	slices.SortFunc(matches, func(a, b trieMatch) int {
+		if a.distance != b.distance {
+			return a.distance - b.distance
+		}
+		return a.position - b.position
+	})
+	return matches
+}
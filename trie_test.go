@@ -0,0 +1,80 @@
+package memorable_ids
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWordTrie(t *testing.T) {
+	dict := GetDictionary()
+
+	t.Run("should find words by prefix in dictionary order", func(t *testing.T) {
+		var expected []string
+		for _, noun := range Nouns {
+			if len(noun) >= 2 && noun[:2] == "ra" {
+				expected = append(expected, noun)
+			}
+		}
+
+		var words []string
+		for _, match := range dict.trieFor(ClassNoun).withPrefix(Nouns, "ra") {
+			words = append(words, match.word)
+		}
+		assert.Equal(t, expected, words)
+		assert.Empty(t, dict.trieFor(ClassNoun).withPrefix(Nouns, "qx"))
+	})
+
+	t.Run("should agree with levenshtein for fuzzy lookups", func(t *testing.T) {
+		for _, class := range wordClasses {
+			words := dict.Words(class)
+			for _, typed := range []string{"cuet", "rabit", "swimm", "", "x", "quickyl"} {
+				var expected []string
+				for distance := 0; distance <= 2; distance++ {
+					for _, word := range words {
+						if levenshtein(typed, word) == distance && !slices.Contains(expected, word) {
+							expected = append(expected, word)
+						}
+					}
+				}
+
+				var found []string
+				for _, match := range dict.trieFor(class).within(words, typed, 2) {
+					assert.Equal(t, levenshtein(typed, match.word), match.distance)
+					found = append(found, match.word)
+				}
+				assert.Equal(t, expected, found, "%s %q", class, typed)
+			}
+		}
+	})
+
+	t.Run("should list word prefixes longest first", func(t *testing.T) {
+		custom := NewDictionary([]string{"red", "redo", "re"}, []string{"owl"}, nil, nil, nil)
+		assert.Equal(t, []int{4, 3, 2}, custom.trieFor(ClassAdjective).prefixLengths("redowl"))
+		assert.Empty(t, custom.trieFor(ClassAdjective).prefixLengths("owl"))
+	})
+
+	t.Run("should handle multi-byte words", func(t *testing.T) {
+		custom := NewDictionary([]string{"café", "cafés"}, []string{"crème"}, nil, nil, nil)
+		assert.Equal(t, []int{len("café")}, custom.trieFor(ClassAdjective).prefixLengths("cafécrème"))
+
+		matches := custom.trieFor(ClassNoun).within(custom.Nouns, "creme", 1)
+		assert.Equal(t, []trieMatch{{word: "crème", position: 0, distance: 1}}, matches)
+	})
+}
+
+func BenchmarkTrieWithin(b *testing.B) {
+	words := make([]string, 100000)
+	for i := range words {
+		words[i] = fmt.Sprintf("word%06d", i)
+	}
+	dict := NewDictionary(words, words, words, words, words)
+	trie := dict.trieFor(ClassNoun)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		trie.within(words, "wrod01234", 2)
+	}
+}
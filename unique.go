@@ -0,0 +1,129 @@
+package memorable_ids
+
+import (
+	"fmt"
+	"log/slog"
+	"math/rand"
+	"sync"
+)
+
+// UniqueConfig contains configuration for a UniqueGenerator
+type UniqueConfig struct {
+	// Options are the generation options of the first attempts
+	Options GenerateOptions
+	// Store remembers the issued IDs, see UniquenessStore
+	// (default: in-memory store)
+	Store UniquenessStore
+	// MaxAttempts is the number of draws per level before extending the
+	// ID or giving up (default: 100)
+	MaxAttempts int
+	// Extensions are suffix generators tried in turn, replacing
+	// Options.Suffix, once the current level keeps colliding
+	// (default: nil, fail with ErrSpaceExhausted)
+	Extensions []SuffixGenerator
+	// Logger receives retries, extensions and store errors
+	// (default: nil, no logging)
+	Logger *slog.Logger
+}
+
+// UniqueGenerator issues IDs that were never issued before by re-rolling
+// collisions and, when a configuration runs full, extending IDs with the
+// configured suffixes. A UniqueGenerator is safe for concurrent use.
+//
+// Example:
+//
+//	gen := NewUniqueGenerator(UniqueConfig{
+//	  Extensions: []SuffixGenerator{SuffixGenerators.Number, SuffixGenerators.Number4},
+//	})
+//	gen.Generate() // "cute-rabbit", nil
+//	// ... after thousands of IDs
+//	gen.Generate() // "large-fox-042", nil
+type UniqueGenerator struct {
+	config UniqueConfig
+	store  UniquenessStore
+	logger *slog.Logger
+
+	mu sync.Mutex
+	// level is the index of the options in use: 0 for Options, i for Extensions[i-1]
+	level int
+	// issued counts the IDs issued at each level
+	issued []int
+}
+
+// NewUniqueGenerator creates a UniqueGenerator for the given configuration
+func NewUniqueGenerator(config UniqueConfig) *UniqueGenerator {
+	store := config.Store
+	if store == nil {
+		store = NewMemoryStore()
+	}
+	return &UniqueGenerator{
+		config: config,
+		store:  store,
+		logger: loggerOr(config.Logger),
+		issued: make([]int, len(config.Extensions)+1),
+	}
+}
+
+// Store returns the store remembering the issued IDs
+func (g *UniqueGenerator) Store() UniquenessStore {
+	return g.store
+}
+
+// Generate creates an ID that was never issued before, or returns
+// ErrSpaceExhausted once every level keeps colliding
+func (g *UniqueGenerator) Generate() (string, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	for ; g.level <= len(g.config.Extensions); g.level++ {
+		options := g.options(g.level)
+
+		// Skip levels this generator alone has filled
+		if capacity, ok := batchCapacity(options); ok && g.issued[g.level] >= capacity {
+			continue
+		}
+
+		id, err := reserveUnique(g.logger, g.store, g.config.MaxAttempts, func() (string, error) {
+			return generate(GetDictionary(), options, rand.Intn)
+		})
+		if err == nil {
+			g.issued[g.level]++
+			return id, nil
+		}
+		if err != ErrSpaceExhausted {
+			return "", err
+		}
+		if g.level < len(g.config.Extensions) {
+			g.logger.Info("memorable ID space crowded, extending suffix", slog.Int("level", g.level+1))
+		}
+	}
+
+	g.level = len(g.config.Extensions)
+	return "", fmt.Errorf("%w: %d IDs issued", ErrSpaceExhausted, g.total())
+}
+
+// Issued returns the number of IDs issued by the generator
+func (g *UniqueGenerator) Issued() int {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.total()
+}
+
+// options returns the generation options of a level
+func (g *UniqueGenerator) options(level int) GenerateOptions {
+	options := g.config.Options
+	if level > 0 {
+		options.Suffix = g.config.Extensions[level-1]
+		options.SuffixProvider = nil
+	}
+	return options
+}
+
+// total returns the number of issued IDs; the caller must hold g.mu
+func (g *UniqueGenerator) total() int {
+	total := 0
+	for _, issued := range g.issued {
+		total += issued
+	}
+	return total
+}
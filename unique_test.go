@@ -0,0 +1,103 @@
+package memorable_ids
+
+import (
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUniqueGenerator(t *testing.T) {
+	tiny := NewDictionary([]string{"stellar", "cosmic"}, []string{"comet", "nebula", "quasar"}, nil, nil, nil)
+
+	t.Run("should never issue an ID twice", func(t *testing.T) {
+		gen := NewUniqueGenerator(UniqueConfig{Options: GenerateOptions{Dictionary: &tiny}})
+
+		seen := make(map[string]bool)
+		for range 6 {
+			id, err := gen.Generate()
+			require.NoError(t, err)
+			assert.False(t, seen[id], id)
+			seen[id] = true
+		}
+		assert.Equal(t, 6, gen.Issued())
+
+		_, err := gen.Generate()
+		assert.ErrorIs(t, err, ErrSpaceExhausted)
+	})
+
+	t.Run("should extend IDs with suffixes once the space runs full", func(t *testing.T) {
+		gen := NewUniqueGenerator(UniqueConfig{
+			Options:     GenerateOptions{Dictionary: &tiny},
+			Extensions:  []SuffixGenerator{SuffixGenerators.Letter},
+			MaxAttempts: 10000,
+		})
+
+		seen := make(map[string]bool)
+		for i := range 6 + 6*26 {
+			id, err := gen.Generate()
+			require.NoError(t, err)
+			assert.False(t, seen[id], id)
+			seen[id] = true
+			if i >= 6 {
+				assert.Len(t, strings.Split(id, "-"), 3, "Expected %q to be extended", id)
+			}
+		}
+
+		_, err := gen.Generate()
+		assert.ErrorIs(t, err, ErrSpaceExhausted)
+	})
+
+	t.Run("should respect IDs already in the store", func(t *testing.T) {
+		store := NewMemoryStore()
+		for _, id := range []string{"stellar-comet", "cosmic-comet", "stellar-nebula", "cosmic-nebula", "stellar-quasar"} {
+			_, err := store.Reserve(id)
+			require.NoError(t, err)
+		}
+
+		gen := NewUniqueGenerator(UniqueConfig{Options: GenerateOptions{Dictionary: &tiny}, Store: store})
+		assert.Same(t, store, gen.Store())
+
+		id, err := gen.Generate()
+		require.NoError(t, err)
+		assert.Equal(t, "cosmic-quasar", id)
+	})
+
+	t.Run("should be safe for concurrent use", func(t *testing.T) {
+		gen := NewUniqueGenerator(UniqueConfig{Options: GenerateOptions{Components: 3}})
+
+		var wg sync.WaitGroup
+		ids := make([][]string, 8)
+		for w := range ids {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for range 100 {
+					id, err := gen.Generate()
+					if assert.NoError(t, err) {
+						ids[w] = append(ids[w], id)
+					}
+				}
+			}()
+		}
+		wg.Wait()
+
+		seen := make(map[string]bool)
+		for _, worker := range ids {
+			for _, id := range worker {
+				assert.False(t, seen[id], id)
+				seen[id] = true
+			}
+		}
+		assert.Len(t, seen, 800)
+	})
+
+	t.Run("should return store errors", func(t *testing.T) {
+		gen := NewUniqueGenerator(UniqueConfig{Store: failingStore{errors.New("store down")}})
+		_, err := gen.Generate()
+		assert.ErrorContains(t, err, "store down")
+	})
+}
@@ -0,0 +1,123 @@
+package memorable_ids
+
+import "sync"
+
+// WordUsage counts how often each word appears in generated IDs, to verify
+// selection uniformity and spot bias introduced by filters or weighting.
+// Attach it to a Generator with Config.Usage, or record components
+// directly. The zero value is ready to use and safe for concurrent use.
+type WordUsage struct {
+	mu     sync.Mutex
+	ids    int
+	counts [ClassColor + 1]map[string]int
+}
+
+// WordUsageSnapshot is a point-in-time copy of a WordUsage, suitable for
+// JSON export
+type WordUsageSnapshot struct {
+	// IDs is the number of IDs recorded
+	IDs int `json:"ids"`
+	// Counts maps class name → word → occurrences
+	Counts map[string]map[string]int `json:"counts"`
+}
+
+// Record counts the components of one ID, the first being an adjective,
+// the second a noun, and so on
+func (u *WordUsage) Record(components []string) {
+	u.record(wordClasses, components)
+}
+
+// record counts the words of one ID, each in the class at its position
+func (u *WordUsage) record(classes []WordClass, words []string) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	u.ids++
+	for i, word := range words {
+		if i >= len(classes) {
+			break
+		}
+		class := classes[i]
+		if u.counts[class] == nil {
+			u.counts[class] = make(map[string]int)
+		}
+		u.counts[class][word]++
+	}
+}
+
+// Snapshot returns a copy of the current counts
+//
+// Example:
+//
+//	usage := &WordUsage{}
+//	gen := NewGenerator(Config{Usage: usage})
+//	gen.Generate() // "cute-rabbit"
+//	usage.Snapshot()
+//	// WordUsageSnapshot{IDs: 1, Counts: {"adjective": {"cute": 1}, "noun": {"rabbit": 1}}}
+func (u *WordUsage) Snapshot() WordUsageSnapshot {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	snapshot := WordUsageSnapshot{IDs: u.ids, Counts: make(map[string]map[string]int)}
+	for i, counts := range u.counts {
+		if counts == nil {
+			continue
+		}
+		copied := make(map[string]int, len(counts))
+		for word, count := range counts {
+			copied[word] = count
+		}
+		snapshot.Counts[WordClass(i).String()] = copied
+	}
+	return snapshot
+}
+
+// Reset clears all counts
+func (u *WordUsage) Reset() {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.ids = 0
+	u.counts = [ClassColor + 1]map[string]int{}
+}
+
+// ChiSquare returns Pearson's chi-square statistic of the class counts
+// against a uniform selection over the words of the class in dict, with
+// len(dict.Words(class))-1 degrees of freedom. Values far above the degrees
+// of freedom indicate biased selection; words absent from dict are
+// counted as deviations too.
+//
+// Example:
+//
+//	snapshot := usage.Snapshot()
+//	// With 72 nouns there are 71 degrees of freedom: ~71 is uniform,
+//	// values in the hundreds indicate bias
+//	snapshot.ChiSquare(GetDictionary(), ClassNoun)
+func (s WordUsageSnapshot) ChiSquare(dict Dictionary, class WordClass) float64 {
+	words := dict.Words(class)
+	counts := s.Counts[class.String()]
+
+	observed := 0
+	for _, count := range counts {
+		observed += count
+	}
+	if len(words) == 0 || observed == 0 {
+		return 0
+	}
+
+	expected := float64(observed) / float64(len(words))
+	statistic := 0.0
+	seen := 0
+	for _, word := range words {
+		count, ok := counts[word]
+		if ok {
+			seen += count
+		}
+		deviation := float64(count) - expected
+		statistic += deviation * deviation / expected
+	}
+	// Words outside the dictionary are never expected; add their squared count
+	if unexpected := observed - seen; unexpected > 0 {
+		statistic += float64(unexpected * unexpected)
+	}
+	return statistic
+}
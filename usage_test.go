@@ -0,0 +1,79 @@
+package memorable_ids
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWordUsage(t *testing.T) {
+	t.Run("should count words per class", func(t *testing.T) {
+		var usage WordUsage
+		usage.Record([]string{"cute", "rabbit"})
+		usage.Record([]string{"cute", "fox", "swim"})
+
+		snapshot := usage.Snapshot()
+		assert.Equal(t, 2, snapshot.IDs)
+		assert.Equal(t, map[string]map[string]int{
+			"adjective": {"cute": 2},
+			"noun":      {"rabbit": 1, "fox": 1},
+			"verb":      {"swim": 1},
+		}, snapshot.Counts)
+
+		usage.Reset()
+		assert.Equal(t, 0, usage.Snapshot().IDs)
+	})
+
+	t.Run("should not share state with snapshots", func(t *testing.T) {
+		var usage WordUsage
+		usage.Record([]string{"cute"})
+		snapshot := usage.Snapshot()
+		usage.Record([]string{"cute"})
+
+		assert.Equal(t, 1, snapshot.Counts["adjective"]["cute"])
+	})
+
+	t.Run("should track generator output", func(t *testing.T) {
+		usage := &WordUsage{}
+		gen := NewGenerator(Config{
+			Options: GenerateOptions{Components: 3, Suffix: SuffixGenerators.Number},
+			Usage:   usage,
+		})
+		for range 100 {
+			_, err := gen.Generate()
+			require.NoError(t, err)
+		}
+
+		snapshot := usage.Snapshot()
+		assert.Equal(t, 100, snapshot.IDs)
+		for _, class := range []string{"adjective", "noun", "verb"} {
+			total := 0
+			for _, count := range snapshot.Counts[class] {
+				total += count
+			}
+			assert.Equal(t, 100, total, class)
+		}
+		assert.NotContains(t, snapshot.Counts, "adverb")
+
+		data, err := json.Marshal(snapshot)
+		require.NoError(t, err)
+		assert.Contains(t, string(data), `"ids":100`)
+	})
+
+	t.Run("should measure deviation from uniform selection", func(t *testing.T) {
+		dict := NewDictionary([]string{"a", "b", "c", "d"}, []string{"n"}, []string{"v"}, []string{"x"}, []string{"p"})
+
+		uniform := WordUsageSnapshot{Counts: map[string]map[string]int{"adjective": {"a": 25, "b": 25, "c": 25, "d": 25}}}
+		assert.InDelta(t, 0, uniform.ChiSquare(dict, ClassAdjective), 1e-9)
+
+		biased := WordUsageSnapshot{Counts: map[string]map[string]int{"adjective": {"a": 100}}}
+		assert.InDelta(t, 300, biased.ChiSquare(dict, ClassAdjective), 1e-9)
+
+		unknown := WordUsageSnapshot{Counts: map[string]map[string]int{"adjective": {"a": 1, "b": 1, "c": 1, "d": 1, "zz": 4}}}
+		assert.Greater(t, unknown.ChiSquare(dict, ClassAdjective), 0.0)
+
+		assert.Equal(t, 0.0, WordUsageSnapshot{}.ChiSquare(dict, ClassNoun))
+	})
+}
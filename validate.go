@@ -0,0 +1,166 @@
+package memorable_ids
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrInvalidID is wrapped by the errors returned by Validate
+var ErrInvalidID = errors.New("invalid memorable ID")
+
+// ValidateOptions describes the format Validate expects
+type ValidateOptions struct {
+	// Components is the expected number of words (0-5, default: 0, any)
+	Components int
+	// Pattern is the word-order template the IDs were created with, see
+	// GenerateOptions.Pattern; it overrides Components (default: "", words
+	// in component order)
+	Pattern string
+	// Separator between parts (default: "-")
+	Separator string
+	// Suffix is the generator the IDs were created with; IDs must end with
+	// a suffix matching its spec. Without one IDs must not have a suffix
+	// (default: nil)
+	Suffix SuffixGenerator
+	// SuffixProvider is the provider the IDs were created with, used like
+	// Suffix; it overrides Suffix (default: nil)
+	SuffixProvider SuffixProvider
+	// Dictionary overrides the word lists (default: nil, the built-in dictionary)
+	Dictionary *Dictionary
+	// CaseInsensitive accepts IDs with any casing (default: false)
+	CaseInsensitive bool
+}
+
+// Validate checks that id is a well-formed memorable ID whose every word
+// belongs to the word class of its position (adjective, noun, verb, ...),
+// so services can reject forged or mistyped IDs at API boundaries. Errors
+// wrap ErrInvalidID, and ErrInputTooLong for inputs longer than
+// DefaultMaxParseLength.
+//
+// Example:
+//
+//	Validate("cute-rabbit", ValidateOptions{Components: 2}) // nil
+//	Validate("rabbit-cute", ValidateOptions{})
+//	// invalid memorable ID: word 1 "rabbit" is not a known adjective
+//	Validate("cute-rabbit-042", ValidateOptions{Suffix: SuffixGenerators.Hex})
+//	// invalid memorable ID: "042" is not a hex suffix
+//	Validate("rabbit-swim", ValidateOptions{Pattern: "noun-verb"}) // nil
+func Validate(id string, options ValidateOptions) error {
+	if len(id) > DefaultMaxParseLength {
+		return fmt.Errorf("%w: %w: %d bytes, limit %d", ErrInvalidID, ErrInputTooLong, len(id), DefaultMaxParseLength)
+	}
+	if options.Components < 0 || options.Components > len(wordClasses) {
+		return errors.New("components must be between 0 and 5")
+	}
+	classes, err := strictClasses(options.Pattern)
+	if err != nil {
+		return err
+	}
+	separator := options.Separator
+	if separator == "" {
+		separator = "-"
+	}
+	if options.CaseInsensitive {
+		id = Canonicalize(id)
+	}
+
+	if options.Suffix != nil || options.SuffixProvider != nil {
+		spec, ok := suffixSpecOf(options.SuffixProvider, options.Suffix)
+		if !ok {
+			return fmt.Errorf("%w: suffix generator has no spec, see SuffixProvider", ErrUnknownSuffixRange)
+		}
+		cut := strings.LastIndex(id, separator)
+		if cut < 0 {
+			return fmt.Errorf("%w: missing %s suffix", ErrInvalidID, spec.Name)
+		}
+		if suffix := id[cut+len(separator):]; !spec.Matches(suffix) {
+			return fmt.Errorf("%w: %q is not a %s suffix", ErrInvalidID, suffix, spec.Name)
+		}
+		id = id[:cut]
+	}
+
+	dict := GetDictionary()
+	if options.Dictionary != nil {
+		dict = *options.Dictionary
+	}
+	words, err := strictWords(dict, id, separator, classes)
+	if err != nil {
+		return err
+	}
+	if classes == nil && options.Components > 0 && len(words) != options.Components {
+		return fmt.Errorf("%w: %d words, expected %d", ErrInvalidID, len(words), options.Components)
+	}
+	return nil
+}
+
+// ParseStrict parses a memorable ID like ParseWithOptions, but fails with
+// ErrInvalidID unless every component is a dictionary word of the class
+// expected at its position: adjective, noun, verb, adverb, preposition,
+// or the classes of Pattern. Words containing the separator, such as
+// "guinea-pig", are rejoined.
+//
+// Example:
+//
+//	ParseStrict("cute-rabbit-042", ParseOptions{})
+//	// ParsedID{Components: ["cute", "rabbit"], Suffix: "042"}, nil
+//	ParseStrict("dapper-lamp-a3", ParseOptions{DetectSuffix: true})
+//	// ParsedID{Components: ["dapper", "lamp"], Suffix: "a3", SuffixKind: SuffixHex}, nil
+//	ParseStrict("rabbit-cute", ParseOptions{})
+//	// ParsedID{}, invalid memorable ID: word 1 "rabbit" is not a known adjective
+//	ParseStrict("rabbit-swim", ParseOptions{Pattern: "noun-verb"})
+//	// ParsedID{Components: ["rabbit", "swim"]}, nil
+func ParseStrict(id string, options ParseOptions) (ParsedID, error) {
+	classes, err := strictClasses(options.Pattern)
+	if err != nil {
+		return ParsedID{}, err
+	}
+	parsed := ParseWithOptions(id, options)
+	if len(parsed.Components) == 0 {
+		return ParsedID{}, fmt.Errorf("%w: no components", ErrInvalidID)
+	}
+
+	words, err := strictWords(options.dictionary(), strings.Join(parsed.Components, parsed.Separator), parsed.Separator, classes)
+	if err != nil {
+		return ParsedID{}, err
+	}
+	parsed.Components = words
+	return parsed, nil
+}
+
+// strictClasses returns the classes of pattern, or nil for words in
+// component order when pattern is empty
+func strictClasses(pattern string) ([]WordClass, error) {
+	if pattern == "" {
+		return nil, nil
+	}
+	return ParsePattern(pattern)
+}
+
+// strictWords splits the words of id on separator, rejoining words that
+// contain it, and checks that each is a dictionary word of the class
+// expected at its position: the given classes, or classes cycling in
+// component order when nil. Errors wrap ErrInvalidID.
+func strictWords(dict Dictionary, id, separator string, classes []WordClass) ([]string, error) {
+	if classes != nil {
+		words, err := splitClassWords(dict, id, separator, classes)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidID, err)
+		}
+		for i, word := range words {
+			if !dict.Contains(classes[i], word) {
+				return nil, fmt.Errorf("%w: word %d %q is not a known %s", ErrInvalidID, i+1, word, classes[i])
+			}
+		}
+		return words, nil
+	}
+
+	words, err := splitCycleWords(dict, id, separator)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidID, err)
+	}
+	if len(words) > len(wordClasses) {
+		return nil, fmt.Errorf("%w: %d words, at most %d", ErrInvalidID, len(words), len(wordClasses))
+	}
+	return words, nil
+}
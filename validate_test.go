@@ -0,0 +1,125 @@
+package memorable_ids
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidate(t *testing.T) {
+	t.Run("should accept generated IDs", func(t *testing.T) {
+		for components := 1; components <= 5; components++ {
+			for _, suffix := range []SuffixGenerator{nil, SuffixGenerators.Number, SuffixGenerators.Hex, SuffixGenerators.Letter} {
+				options := GenerateOptions{Components: components, Suffix: suffix, Separator: "_"}
+				id, err := Generate(options)
+				require.NoError(t, err)
+
+				assert.NoError(t, Validate(id, ValidateOptions{Components: components, Suffix: suffix, Separator: "_"}), id)
+			}
+		}
+	})
+
+	t.Run("should reject words in the wrong position", func(t *testing.T) {
+		err := Validate("rabbit-cute", ValidateOptions{})
+		assert.ErrorIs(t, err, ErrInvalidID)
+		assert.ErrorContains(t, err, `word 1 "rabbit" is not a known adjective`)
+
+		assert.ErrorIs(t, Validate("cute-rabit", ValidateOptions{}), ErrInvalidID)
+		assert.ErrorIs(t, Validate("", ValidateOptions{}), ErrInvalidID)
+	})
+
+	t.Run("should check the component count", func(t *testing.T) {
+		assert.NoError(t, Validate("cute-rabbit", ValidateOptions{}))
+		assert.ErrorContains(t, Validate("cute-rabbit", ValidateOptions{Components: 3}), "2 words, expected 3")
+	})
+
+	t.Run("should check suffixes", func(t *testing.T) {
+		assert.ErrorContains(t, Validate("cute-rabbit-042", ValidateOptions{Suffix: SuffixGenerators.Hex}), `"042" is not a hex suffix`)
+		assert.ErrorIs(t, Validate("cute-rabbit-042", ValidateOptions{}), ErrInvalidID, "unexpected suffix")
+		assert.ErrorIs(t, Validate("cute", ValidateOptions{Suffix: SuffixGenerators.Number}), ErrInvalidID)
+
+		custom := func() *string { return nil }
+		assert.ErrorIs(t, Validate("cute-rabbit-x", ValidateOptions{Suffix: custom}), ErrUnknownSuffixRange)
+	})
+
+	t.Run("should honor casing, dictionaries and limits", func(t *testing.T) {
+		assert.ErrorIs(t, Validate("Cute-Rabbit", ValidateOptions{}), ErrInvalidID)
+		assert.NoError(t, Validate(" Cute-Rabbit ", ValidateOptions{CaseInsensitive: true}))
+
+		space := NewDictionary([]string{"stellar"}, []string{"comet"}, nil, nil, nil)
+		assert.NoError(t, Validate("stellar-comet", ValidateOptions{Dictionary: &space}))
+		assert.ErrorIs(t, Validate("cute-rabbit", ValidateOptions{Dictionary: &space}), ErrInvalidID)
+
+		assert.ErrorIs(t, Validate(strings.Repeat("cute-", 100), ValidateOptions{}), ErrInputTooLong)
+		assert.Error(t, Validate("cute-rabbit", ValidateOptions{Components: 6}))
+	})
+}
+
+func TestParseStrict(t *testing.T) {
+	t.Run("should parse valid IDs like Parse", func(t *testing.T) {
+		parsed, err := ParseStrict("cute-rabbit-042", ParseOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"cute", "rabbit"}, parsed.Components)
+		require.NotNil(t, parsed.Suffix)
+		assert.Equal(t, "042", *parsed.Suffix)
+
+		id, err := Generate(GenerateOptions{Components: 5, Separator: "_"})
+		require.NoError(t, err)
+		parsed, err = ParseStrict(id, ParseOptions{Separator: "_"})
+		require.NoError(t, err)
+		assert.Equal(t, Parse(id, "_"), parsed)
+	})
+
+	t.Run("should recognize suffixes like ParseWithOptions", func(t *testing.T) {
+		parsed, err := ParseStrict("dapper-lamp-a3", ParseOptions{DetectSuffix: true})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"dapper", "lamp"}, parsed.Components)
+		require.NotNil(t, parsed.Suffix)
+		assert.Equal(t, "a3", *parsed.Suffix)
+		assert.Equal(t, SuffixHex, parsed.SuffixKind)
+
+		parsed, err = ParseStrict("dapper-lamp-q", ParseOptions{Suffix: SuffixGenerators.Letter})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"dapper", "lamp"}, parsed.Components)
+		assert.Equal(t, "q", *parsed.Suffix)
+
+		parsed, err = ParseStrict("FAIR-Guinea-Pig-ff", ParseOptions{Suffix: SuffixGenerators.Hex, CaseInsensitive: true})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"fair", "guinea-pig"}, parsed.Components)
+		assert.Equal(t, "ff", *parsed.Suffix)
+
+		_, err = ParseStrict("dapper-lamp-a3", ParseOptions{})
+		assert.ErrorContains(t, err, `word 3 "a3" is not a known verb`)
+	})
+
+	t.Run("should check words against the given dictionary", func(t *testing.T) {
+		space := NewDictionary([]string{"stellar"}, []string{"comet"}, []string{"orbit"}, nil, nil)
+		parsed, err := ParseStrict("stellar-comet-orbit", ParseOptions{Dictionary: &space})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"stellar", "comet", "orbit"}, parsed.Components)
+
+		_, err = ParseStrict("cute-rabbit", ParseOptions{Dictionary: &space})
+		assert.ErrorIs(t, err, ErrInvalidID)
+	})
+
+	t.Run("should reject words out of order or unknown", func(t *testing.T) {
+		_, err := ParseStrict("rabbit-cute", ParseOptions{})
+		assert.ErrorIs(t, err, ErrInvalidID)
+		assert.ErrorContains(t, err, `word 1 "rabbit" is not a known adjective`)
+
+		_, err = ParseStrict("cute-xyzzy-042", ParseOptions{})
+		assert.ErrorContains(t, err, `word 2 "xyzzy" is not a known noun`)
+
+		_, err = ParseStrict("042", ParseOptions{})
+		assert.ErrorIs(t, err, ErrInvalidID)
+	})
+
+	t.Run("should reject more than 5 words", func(t *testing.T) {
+		id, err := Generate(GenerateOptions{Components: 5})
+		require.NoError(t, err)
+		_, err = ParseStrict(id+"-"+Adjectives[0], ParseOptions{})
+		assert.ErrorContains(t, err, "at most 5")
+	})
+}
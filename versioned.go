@@ -0,0 +1,177 @@
+package memorable_ids
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// VersionTagSeparator separates the format tag from the ID, as in "m1:cute-rabbit"
+const VersionTagSeparator = ":"
+
+// ErrUnknownFormatVersion is returned when an ID is tagged with an unregistered format version
+var ErrUnknownFormatVersion = errors.New("unknown format version")
+
+// VersionedFormat is one version of an ID format
+type VersionedFormat struct {
+	// Version is the tag written before IDs of this format, e.g. "m1".
+	// The empty version matches untagged IDs, for formats that predate tags.
+	Version string
+	// Options are the generation and parsing options of the format
+	Options GenerateOptions
+	// Dictionary overrides the built-in dictionary (default: nil)
+	Dictionary *Dictionary
+}
+
+// VersionedID is an ID parsed by VersionedFormats.ParseVersioned
+type VersionedID struct {
+	ParsedID
+	// Version is the format version the ID was issued with
+	Version string
+}
+
+// VersionedFormats is a registry of ID format versions. IDs are issued
+// with the current version's tag, and ParseVersioned routes each ID to
+// the dictionary and options of the version it was issued with, so
+// long-lived systems can change formats without misreading old IDs. The
+// zero value is ready to use and safe for concurrent use.
+//
+// Example:
+//
+//	formats := &VersionedFormats{}
+//	formats.Register(VersionedFormat{Version: "m1"})
+//	formats.Register(VersionedFormat{Version: "m2", Options: GenerateOptions{Components: 3, Suffix: SuffixGenerators.Number}})
+//
+//	formats.Generate()                       // "m2:large-fox-swim-042", nil
+//	formats.ParseVersioned("m1:cute-rabbit") // VersionedID{Version: "m1", Components: ["cute", "rabbit"]}, nil
+type VersionedFormats struct {
+	mu      sync.RWMutex
+	formats map[string]VersionedFormat
+	current string
+}
+
+// Register adds a format version and makes it current. Versions must be
+// unique and made of letters and digits.
+func (f *VersionedFormats) Register(format VersionedFormat) error {
+	if strings.IndexFunc(format.Version, func(r rune) bool { return !isAlphanumeric(r) }) >= 0 {
+		return fmt.Errorf("format version %q must only contain letters and digits", format.Version)
+	}
+	if err := format.Options.Validate(); err != nil {
+		return fmt.Errorf("format version %q: %w", format.Version, err)
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if _, exists := f.formats[format.Version]; exists {
+		return fmt.Errorf("format version %q is already registered", format.Version)
+	}
+	if f.formats == nil {
+		f.formats = make(map[string]VersionedFormat)
+	}
+	f.formats[format.Version] = format
+	f.current = format.Version
+	return nil
+}
+
+// SetCurrent selects the registered version used by Generate
+func (f *VersionedFormats) SetCurrent(version string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if _, exists := f.formats[version]; !exists {
+		return fmt.Errorf("%w: %q", ErrUnknownFormatVersion, version)
+	}
+	f.current = version
+	return nil
+}
+
+// Versions returns the registered versions in sorted order
+func (f *VersionedFormats) Versions() []string {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	versions := make([]string, 0, len(f.formats))
+	for version := range f.formats {
+		versions = append(versions, version)
+	}
+	slices.Sort(versions)
+	return versions
+}
+
+// Generate creates an ID in the current format, tagged with its version
+func (f *VersionedFormats) Generate() (string, error) {
+	f.mu.RLock()
+	format, ok := f.formats[f.current]
+	f.mu.RUnlock()
+	if !ok {
+		return "", fmt.Errorf("%w: no format registered", ErrUnknownFormatVersion)
+	}
+
+	id, err := generate(format.dictionary(), format.Options, rand.Intn)
+	if err != nil || format.Version == "" {
+		return id, err
+	}
+	return format.Version + VersionTagSeparator + id, nil
+}
+
+// ParseVersioned parses an ID with the options of the version it is
+// tagged with, checking every component against that version's
+// dictionary. Untagged IDs use the empty version if registered.
+func (f *VersionedFormats) ParseVersioned(id string) (VersionedID, error) {
+	version, body, tagged := strings.Cut(id, VersionTagSeparator)
+	if !tagged {
+		version, body = "", id
+	}
+
+	f.mu.RLock()
+	format, ok := f.formats[version]
+	f.mu.RUnlock()
+	if !ok {
+		return VersionedID{}, fmt.Errorf("%w: %q", ErrUnknownFormatVersion, version)
+	}
+
+	parsed, err := ParseChecked(body, ParseOptions{
+		Separator:      format.Options.Separator,
+		Suffix:         format.Options.Suffix,
+		SuffixProvider: format.Options.SuffixProvider,
+	})
+	if err != nil {
+		return VersionedID{}, err
+	}
+
+	classes, err := format.Options.classes()
+	if err != nil {
+		return VersionedID{}, fmt.Errorf("format %q: %w", version, err)
+	}
+	if len(parsed.Components) < len(classes) {
+		return VersionedID{}, fmt.Errorf("format %q expects %d words, got %d", version, len(classes), len(parsed.Components))
+	}
+	if format.Options.hasSuffix() != (parsed.Suffix != nil) {
+		return VersionedID{}, fmt.Errorf("format %q: suffix mismatch", version)
+	}
+	// Rejoin words containing the separator, such as "guinea-pig"
+	dict := format.dictionary()
+	words, err := splitClassWords(dict, strings.Join(parsed.Components, parsed.Separator), parsed.Separator, classes)
+	if err != nil {
+		return VersionedID{}, fmt.Errorf("format %q: %w", version, err)
+	}
+	for i, word := range words {
+		if !dict.Contains(classes[i], word) {
+			return VersionedID{}, fmt.Errorf("format %q: %q is not a known %s", version, word, classes[i])
+		}
+	}
+	parsed.Components = words
+	return VersionedID{ParsedID: parsed, Version: version}, nil
+}
+
+// dictionary returns the dictionary of the format
+func (format VersionedFormat) dictionary() Dictionary {
+	if format.Dictionary != nil {
+		return *format.Dictionary
+	}
+	return GetDictionary()
+}
@@ -0,0 +1,90 @@
+package memorable_ids
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVersionedFormats(t *testing.T) {
+	space := NewDictionary([]string{"red", "dark"}, []string{"comet", "nebula"}, []string{"spin"}, nil, nil)
+
+	newFormats := func(t *testing.T) *VersionedFormats {
+		formats := &VersionedFormats{}
+		require.NoError(t, formats.Register(VersionedFormat{Version: ""}))
+		require.NoError(t, formats.Register(VersionedFormat{Version: "m1", Options: GenerateOptions{Suffix: SuffixGenerators.Number}}))
+		require.NoError(t, formats.Register(VersionedFormat{Version: "m2", Options: GenerateOptions{Components: 3, Separator: "_"}, Dictionary: &space}))
+		return formats
+	}
+
+	t.Run("should tag IDs with the current version", func(t *testing.T) {
+		formats := newFormats(t)
+
+		id, err := formats.Generate()
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(id, "m2:"), id)
+
+		require.NoError(t, formats.SetCurrent("m1"))
+		id, err = formats.Generate()
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(id, "m1:"), id)
+		assert.Equal(t, []string{"", "m1", "m2"}, formats.Versions())
+	})
+
+	t.Run("should route IDs to the options and dictionary of their version", func(t *testing.T) {
+		formats := newFormats(t)
+
+		parsed, err := formats.ParseVersioned("m1:cute-rabbit-042")
+		require.NoError(t, err)
+		assert.Equal(t, "m1", parsed.Version)
+		assert.Equal(t, []string{"cute", "rabbit"}, parsed.Components)
+		assert.Equal(t, "042", *parsed.Suffix)
+
+		parsed, err = formats.ParseVersioned("m2:dark_nebula_spin")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"dark", "nebula", "spin"}, parsed.Components)
+
+		parsed, err = formats.ParseVersioned("large-fox")
+		require.NoError(t, err)
+		assert.Equal(t, "", parsed.Version)
+	})
+
+	t.Run("should round-trip generated IDs of every version", func(t *testing.T) {
+		formats := newFormats(t)
+		for _, version := range formats.Versions() {
+			require.NoError(t, formats.SetCurrent(version))
+			for range 20 {
+				id, err := formats.Generate()
+				require.NoError(t, err)
+				parsed, err := formats.ParseVersioned(id)
+				require.NoError(t, err, id)
+				assert.Equal(t, version, parsed.Version)
+			}
+		}
+	})
+
+	t.Run("should reject IDs that don't match their version", func(t *testing.T) {
+		formats := newFormats(t)
+
+		_, err := formats.ParseVersioned("m9:cute-rabbit")
+		assert.ErrorIs(t, err, ErrUnknownFormatVersion)
+
+		for _, id := range []string{"m1:cute-rabbit", "m2:cute_rabbit_swim", "m2:dark_nebula", "cute-rabbit-042"} {
+			_, err := formats.ParseVersioned(id)
+			assert.Error(t, err, id)
+		}
+	})
+
+	t.Run("should reject invalid registrations", func(t *testing.T) {
+		formats := newFormats(t)
+		assert.Error(t, formats.Register(VersionedFormat{Version: "m1"}))
+		assert.Error(t, formats.Register(VersionedFormat{Version: "m:3"}))
+		assert.Error(t, formats.Register(VersionedFormat{Version: "m3", Options: GenerateOptions{Components: 7}}))
+		assert.ErrorIs(t, formats.SetCurrent("m3"), ErrUnknownFormatVersion)
+
+		_, err := (&VersionedFormats{}).Generate()
+		assert.ErrorIs(t, err, ErrUnknownFormatVersion)
+	})
+}